@@ -14,6 +14,7 @@ var Commands = []*Command{
 	cmdCopy,
 	cmdFix,
 	cmdFilerReplicate,
+	cmdFilerSync,
 	cmdServer,
 	cmdMaster,
 	cmdFiler,
@@ -24,6 +25,7 @@ var Commands = []*Command{
 	cmdShell,
 	cmdVersion,
 	cmdVolume,
+	cmdVolumeMirror,
 	cmdExport,
 	cmdMount,
 	cmdWebDav,
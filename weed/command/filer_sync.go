@@ -0,0 +1,563 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/replication/source"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	syncOptions FilerSyncOptions
+)
+
+type FilerSyncOptions struct {
+	filerA     *string
+	filerB     *string
+	nameA      *string
+	nameB      *string
+	include    *string
+	exclude    *string
+	checkpoint *string
+}
+
+func init() {
+	cmdFilerSync.Run = runFilerSync // break init cycle
+	syncOptions.filerA = cmdFilerSync.Flag.String("a", "", "filer of one cluster, <host>:<port>")
+	syncOptions.filerB = cmdFilerSync.Flag.String("b", "", "filer of the other cluster, <host>:<port>")
+	syncOptions.nameA = cmdFilerSync.Flag.String("a.name", "", "the name of the cluster of filer a, default to the filer address")
+	syncOptions.nameB = cmdFilerSync.Flag.String("b.name", "", "the name of the cluster of filer b, default to the filer address")
+	syncOptions.include = cmdFilerSync.Flag.String("include", "/", "comma-separated path prefixes to synchronize")
+	syncOptions.exclude = cmdFilerSync.Flag.String("exclude", "", "comma-separated path prefixes not to synchronize")
+	syncOptions.checkpoint = cmdFilerSync.Flag.String("checkpoint", "filer.sync.checkpoint", "the file to save the synchronized time of each filer")
+}
+
+var cmdFilerSync = &Command{
+	UsageLine: "filer.sync -a=<oneFilerHost>:<oneFilerPort> -b=<otherFilerHost>:<otherFilerPort>",
+	Short:     "continuously synchronize the files between two active-active clusters",
+	Long: `continuously synchronize the files between two active-active clusters
+
+	filer.sync follows the metadata changes of each filer, and applies them to the other filer,
+	copying the file content between the clusters.
+
+	The changes applied by filer.sync are tagged with the name of the cluster they came from,
+	so they are not sent back. When both clusters change the same entry, the change with the later
+	modification time wins.
+
+	The synchronized time of each filer is saved to the -checkpoint file, so a restart resumes from where it stopped.
+	The filers only keep the recent changes in memory. Start the filers with -metaLogDir to keep the changes
+	of the last days across the filer restarts. If the changes since the checkpoint are no longer kept,
+	or on the first run, filer.sync rescans the directories and copies the newer entries, but the deletions
+	during that time are not synchronized.
+
+	Only the changes made through the two filers are followed.
+
+  `,
+}
+
+const (
+	filerSyncRetryInterval      = 10 * time.Second
+	filerSyncCheckpointInterval = 10 * time.Second
+)
+
+func runFilerSync(cmd *Command, args []string) bool {
+
+	util.LoadConfiguration("security", false)
+	grpcDialOption := security.LoadClientTLS(viper.Sub("grpc"), "client")
+
+	if *syncOptions.filerA == "" || *syncOptions.filerB == "" {
+		return false
+	}
+
+	peerA, err := newFilerSyncPeer(*syncOptions.filerA, *syncOptions.nameA, grpcDialOption)
+	if err != nil {
+		glog.Fatalf("filer a: %v", err)
+	}
+	peerB, err := newFilerSyncPeer(*syncOptions.filerB, *syncOptions.nameB, grpcDialOption)
+	if err != nil {
+		glog.Fatalf("filer b: %v", err)
+	}
+	if peerA.name == peerB.name {
+		glog.Fatalf("the two clusters should have different names")
+	}
+
+	rules := newFilerSyncRules(*syncOptions.include, *syncOptions.exclude)
+
+	checkpoint := &filerSyncCheckpoint{fileName: *syncOptions.checkpoint, positions: make(map[string]int64)}
+	if err = checkpoint.load(); err != nil {
+		glog.Fatalf("load checkpoint %s: %v", checkpoint.fileName, err)
+	}
+
+	go (&filerSyncer{source: peerA, target: peerB, rules: rules, checkpoint: checkpoint}).run()
+	go (&filerSyncer{source: peerB, target: peerA, rules: rules, checkpoint: checkpoint}).run()
+
+	for range time.Tick(filerSyncCheckpointInterval) {
+		if err = checkpoint.save(); err != nil {
+			glog.Errorf("save checkpoint %s: %v", checkpoint.fileName, err)
+		}
+	}
+
+	return true
+}
+
+// filerSyncPeer is the filer of one cluster
+type filerSyncPeer struct {
+	name           string
+	grpcAddress    string
+	grpcDialOption grpc.DialOption
+	source         *source.FilerSource
+}
+
+func newFilerSyncPeer(address, name string, grpcDialOption grpc.DialOption) (*filerSyncPeer, error) {
+	grpcAddress, err := util.ParseServerToGrpcAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = address
+	}
+
+	sourceConfig := viper.New()
+	sourceConfig.Set("grpcAddress", grpcAddress)
+	sourceConfig.Set("directory", "/")
+	filerSource := &source.FilerSource{}
+	if err = filerSource.Initialize(sourceConfig); err != nil {
+		return nil, err
+	}
+
+	return &filerSyncPeer{
+		name:           name,
+		grpcAddress:    grpcAddress,
+		grpcDialOption: grpcDialOption,
+		source:         filerSource,
+	}, nil
+}
+
+func (p *filerSyncPeer) WithFilerClient(ctx context.Context, fn func(filer_pb.SeaweedFilerClient) error) error {
+	return util.WithCachedGrpcClient(ctx, func(grpcConnection *grpc.ClientConn) error {
+		return fn(filer_pb.NewSeaweedFilerClient(grpcConnection))
+	}, p.grpcAddress, p.grpcDialOption)
+}
+
+// filerSyncRules selects the paths to synchronize
+type filerSyncRules struct {
+	includes []string
+	excludes []string
+}
+
+func newFilerSyncRules(includes, excludes string) *filerSyncRules {
+	rules := &filerSyncRules{}
+	for _, prefix := range strings.Split(includes, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			rules.includes = append(rules.includes, prefix)
+		}
+	}
+	for _, prefix := range strings.Split(excludes, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			rules.excludes = append(rules.excludes, prefix)
+		}
+	}
+	return rules
+}
+
+func (rules *filerSyncRules) match(path string) bool {
+	for _, prefix := range rules.excludes {
+		if hasPathPrefix(path, prefix) {
+			return false
+		}
+	}
+	for _, prefix := range rules.includes {
+		if hasPathPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPathPrefix checks the path components, so "/a" is not a prefix of "/ab"
+func hasPathPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// isNewerEntry tells whether the incoming entry should overwrite the existing entry.
+// The later modification time wins. With the same modification time, the larger content etag wins,
+// so both clusters pick the same entry.
+func isNewerEntry(incoming, existing *filer_pb.Entry) bool {
+	incomingMtime, existingMtime := entryMtime(incoming), entryMtime(existing)
+	if incomingMtime != existingMtime {
+		return incomingMtime > existingMtime
+	}
+	if incoming.IsDirectory != existing.IsDirectory {
+		return incoming.IsDirectory
+	}
+	return filer2.ETag(incoming.Chunks) > filer2.ETag(existing.Chunks)
+}
+
+func entryMtime(entry *filer_pb.Entry) int64 {
+	if entry.Attributes == nil {
+		return 0
+	}
+	return entry.Attributes.Mtime
+}
+
+func hasOrigin(origins []string, name string) bool {
+	for _, origin := range origins {
+		if origin == name {
+			return true
+		}
+	}
+	return false
+}
+
+// filerSyncCheckpoint keeps the time of the last change applied from each filer
+type filerSyncCheckpoint struct {
+	fileName string
+	sync.Mutex
+	positions map[string]int64
+}
+
+func (c *filerSyncCheckpoint) get(name string) int64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.positions[name]
+}
+
+func (c *filerSyncCheckpoint) set(name string, tsNs int64) {
+	c.Lock()
+	defer c.Unlock()
+	c.positions[name] = tsNs
+}
+
+func (c *filerSyncCheckpoint) load() error {
+	data, err := ioutil.ReadFile(c.fileName)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return json.Unmarshal(data, &c.positions)
+}
+
+// save writes the positions to a temporary file, and renames it to the checkpoint file
+func (c *filerSyncCheckpoint) save() error {
+	c.Lock()
+	data, err := json.Marshal(c.positions)
+	c.Unlock()
+	if err != nil {
+		return err
+	}
+	tempFile := c.fileName + ".tmp"
+	if err = ioutil.WriteFile(tempFile, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempFile, c.fileName)
+}
+
+// filerSyncer applies the changes of the source filer to the target filer
+type filerSyncer struct {
+	source     *filerSyncPeer
+	target     *filerSyncPeer
+	rules      *filerSyncRules
+	checkpoint *filerSyncCheckpoint
+}
+
+func (s *filerSyncer) run() {
+	ctx := context.Background()
+	for {
+		sinceNs := s.checkpoint.get(s.source.name)
+		if sinceNs == 0 {
+			if err := s.rescan(ctx); err != nil {
+				glog.Errorf("rescan %s => %s: %v", s.source.name, s.target.name, err)
+				time.Sleep(filerSyncRetryInterval)
+				continue
+			}
+			sinceNs = s.checkpoint.get(s.source.name)
+		}
+
+		err := s.follow(ctx, sinceNs)
+		if status.Code(err) == codes.OutOfRange {
+			glog.V(0).Infof("the changes of %s since %d are no longer kept, rescan the directories", s.source.name, sinceNs)
+			s.checkpoint.set(s.source.name, 0)
+			continue
+		}
+		glog.Errorf("sync %s => %s: %v", s.source.name, s.target.name, err)
+		time.Sleep(filerSyncRetryInterval)
+	}
+}
+
+// rescan copies the newer entries under the included directories, and then sets the checkpoint to the start of the rescan
+func (s *filerSyncer) rescan(ctx context.Context) error {
+	startNs := time.Now().UnixNano()
+	glog.V(0).Infof("rescan %s => %s", s.source.name, s.target.name)
+
+	for _, prefix := range s.rules.includes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if prefix == "" {
+			if err := s.rescanDirectory(ctx, "/"); err != nil {
+				return err
+			}
+			continue
+		}
+		entry, err := filer2.GetEntry(ctx, s.source, prefix)
+		if err != nil {
+			return err
+		}
+		if entry == nil || !s.rules.match(prefix) {
+			continue
+		}
+		dir, _ := filer2.FullPath(prefix).DirAndName()
+		if err = s.applyCreate(ctx, dir, entry, []string{s.source.name}); err != nil {
+			return err
+		}
+		if entry.IsDirectory {
+			if err = s.rescanDirectory(ctx, prefix); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.checkpoint.set(s.source.name, startNs)
+	glog.V(0).Infof("rescan %s => %s finished", s.source.name, s.target.name)
+	return nil
+}
+
+func (s *filerSyncer) rescanDirectory(ctx context.Context, dir string) error {
+	var entries []*filer_pb.Entry
+	if err := filer2.ReadDirAllEntries(ctx, s.source, dir, func(entry *filer_pb.Entry) {
+		entries = append(entries, entry)
+	}); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		path := string(filer2.NewFullPath(dir, entry.Name))
+		if !s.rules.match(path) {
+			continue
+		}
+		if err := s.applyCreate(ctx, dir, entry, []string{s.source.name}); err != nil {
+			return err
+		}
+		if entry.IsDirectory {
+			if err := s.rescanDirectory(ctx, path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// follow applies the changes of the source filer since the time, until an error happens
+func (s *filerSyncer) follow(ctx context.Context, sinceNs int64) error {
+	glog.V(0).Infof("sync %s => %s since %s", s.source.name, s.target.name, time.Unix(0, sinceNs))
+
+	return s.source.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		stream, err := client.SubscribeMetadata(ctx, &filer_pb.SubscribeMetadataRequest{
+			ClientName: "filer.sync to " + s.target.name,
+			SinceNs:    sinceNs,
+		})
+		if err != nil {
+			return err
+		}
+
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				return fmt.Errorf("%s stopped sending the changes", s.source.name)
+			}
+			if err != nil {
+				return err
+			}
+			if err = s.applyEvent(ctx, event); err != nil {
+				return err
+			}
+			s.checkpoint.set(s.source.name, event.TsNs)
+		}
+
+	})
+}
+
+func (s *filerSyncer) applyEvent(ctx context.Context, event *filer_pb.SubscribeMetadataResponse) error {
+	notification := event.EventNotification
+
+	// the change came from the target cluster
+	if hasOrigin(notification.Origins, s.target.name) {
+		return nil
+	}
+	origins := append(append([]string{}, notification.Origins...), s.source.name)
+
+	var oldPath, newPath string
+	if notification.OldEntry != nil {
+		oldPath = string(filer2.NewFullPath(event.Directory, notification.OldEntry.Name))
+	}
+	if notification.NewEntry != nil {
+		newPath = string(filer2.NewFullPath(notification.NewParentPath, notification.NewEntry.Name))
+	}
+
+	if oldPath != "" && oldPath != newPath && s.rules.match(oldPath) {
+		if err := s.applyDelete(ctx, oldPath, notification.OldEntry, origins); err != nil {
+			return err
+		}
+	}
+	if newPath != "" && s.rules.match(newPath) {
+		if err := s.applyCreate(ctx, notification.NewParentPath, notification.NewEntry, origins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyDelete deletes the target entry, unless the target entry is changed after the deleted entry
+func (s *filerSyncer) applyDelete(ctx context.Context, path string, oldEntry *filer_pb.Entry, origins []string) error {
+	existing, err := filer2.GetEntry(ctx, s.target, path)
+	if err != nil {
+		return fmt.Errorf("lookup %s on %s: %v", path, s.target.name, err)
+	}
+	if existing == nil {
+		return nil
+	}
+	if entryMtime(existing) > entryMtime(oldEntry) {
+		glog.V(1).Infof("keep %s on %s, which is changed after the deletion", path, s.target.name)
+		return nil
+	}
+
+	dir, name := filer2.FullPath(path).DirAndName()
+	glog.V(1).Infof("delete %s on %s", path, s.target.name)
+	return s.target.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.DeleteEntry(ctx, &filer_pb.DeleteEntryRequest{
+			Directory:    dir,
+			Name:         name,
+			IsDeleteData: true,
+			IsRecursive:  true,
+			Origins:      origins,
+		})
+		if err != nil {
+			return fmt.Errorf("delete %s on %s: %v", path, s.target.name, err)
+		}
+		return nil
+	})
+}
+
+// applyCreate creates or updates the target entry, unless the target entry is newer.
+// The file content is copied to the target cluster, unless the target entry has the same content.
+func (s *filerSyncer) applyCreate(ctx context.Context, dir string, entry *filer_pb.Entry, origins []string) error {
+	path := string(filer2.NewFullPath(dir, entry.Name))
+
+	existing, err := filer2.GetEntry(ctx, s.target, path)
+	if err != nil {
+		return fmt.Errorf("lookup %s on %s: %v", path, s.target.name, err)
+	}
+	if existing != nil && !isNewerEntry(entry, existing) {
+		return nil
+	}
+
+	var chunks []*filer_pb.FileChunk
+	sameType := existing != nil && existing.IsDirectory == entry.IsDirectory
+	if sameType && filer2.ETag(existing.Chunks) == filer2.ETag(entry.Chunks) {
+		// only the attributes are changed
+		chunks = existing.Chunks
+	} else if chunks, err = s.copyChunks(ctx, entry); err != nil {
+		return fmt.Errorf("copy %s from %s to %s: %v", path, s.source.name, s.target.name, err)
+	}
+
+	if existing != nil && !sameType {
+		// a file is replaced by a directory, or the other way around
+		if err = s.applyDelete(ctx, path, entry, origins); err != nil {
+			return err
+		}
+	}
+
+	glog.V(1).Infof("sync %s from %s to %s", path, s.source.name, s.target.name)
+	return s.target.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+			Directory: dir,
+			Entry: &filer_pb.Entry{
+				Name:        entry.Name,
+				IsDirectory: entry.IsDirectory,
+				Attributes:  entry.Attributes,
+				Chunks:      chunks,
+				Extended:    entry.Extended,
+			},
+			Origins: origins,
+		})
+		if err != nil {
+			return fmt.Errorf("create %s on %s: %v", path, s.target.name, err)
+		}
+		return nil
+	})
+}
+
+// copyChunks reads each chunk from the source cluster, and writes it to a volume assigned by the target filer
+func (s *filerSyncer) copyChunks(ctx context.Context, entry *filer_pb.Entry) (chunks []*filer_pb.FileChunk, err error) {
+	for _, chunk := range entry.Chunks {
+		fileId, err := s.copyChunk(ctx, entry, chunk)
+		if err != nil {
+			return nil, fmt.Errorf("copy chunk %s: %v", chunk.GetFileIdString(), err)
+		}
+		chunks = append(chunks, &filer_pb.FileChunk{
+			FileId:       fileId,
+			Offset:       chunk.Offset,
+			Size:         chunk.Size,
+			Mtime:        chunk.Mtime,
+			ETag:         chunk.ETag,
+			SourceFileId: chunk.GetFileIdString(),
+		})
+	}
+	return chunks, nil
+}
+
+func (s *filerSyncer) copyChunk(ctx context.Context, entry *filer_pb.Entry, chunk *filer_pb.FileChunk) (fileId string, err error) {
+	filename, header, readCloser, err := s.source.source.ReadPart(ctx, chunk.GetFileIdString())
+	if err != nil {
+		return "", err
+	}
+	defer readCloser.Close()
+
+	request := &filer_pb.AssignVolumeRequest{
+		Count: 1,
+	}
+	if entry.Attributes != nil {
+		request.Collection = entry.Attributes.Collection
+		request.TtlSec = entry.Attributes.TtlSec
+	}
+
+	var assignResult *filer_pb.AssignVolumeResponse
+	if err = s.target.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		assignResult, err = client.AssignVolume(ctx, request)
+		return err
+	}); err != nil {
+		return "", fmt.Errorf("assign volume: %v", err)
+	}
+
+	fileUrl := fmt.Sprintf("http://%s/%s", assignResult.Url, assignResult.FileId)
+	uploadResult, err := operation.Upload(fileUrl, filename, readCloser,
+		"gzip" == header.Get("Content-Encoding"), header.Get("Content-Type"), nil, security.EncodedJwt(assignResult.Auth))
+	if err != nil {
+		return "", fmt.Errorf("upload to %s: %v", fileUrl, err)
+	}
+	if uploadResult.Error != "" {
+		return "", fmt.Errorf("upload to %s: %v", fileUrl, uploadResult.Error)
+	}
+
+	return assignResult.FileId, nil
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestFilerSyncRules(t *testing.T) {
+	rules := newFilerSyncRules("/buckets/, /home", "/buckets/tmp,/home/cache/")
+
+	tests := []struct {
+		path    string
+		matched bool
+	}{
+		{"/buckets", true},
+		{"/buckets/photos/a.jpg", true},
+		{"/bucketsx", false},
+		{"/home/chris/a.txt", true},
+		{"/buckets/tmp", false},
+		{"/buckets/tmp/a.txt", false},
+		{"/buckets/tmpx", true},
+		{"/home/cache/a", false},
+		{"/etc/hosts", false},
+	}
+	for _, tt := range tests {
+		if matched := rules.match(tt.path); matched != tt.matched {
+			t.Errorf("%s matched: %v", tt.path, matched)
+		}
+	}
+
+	all := newFilerSyncRules("/", "")
+	if !all.match("/a/b") || !all.match("/") {
+		t.Errorf("/ should include all paths")
+	}
+}
+
+func TestIsNewerEntry(t *testing.T) {
+	entry := func(mtime int64, etag string) *filer_pb.Entry {
+		return &filer_pb.Entry{
+			Attributes: &filer_pb.FuseAttributes{Mtime: mtime},
+			Chunks:     []*filer_pb.FileChunk{{FileId: "3,01637037d6", Size: 1, ETag: etag}},
+		}
+	}
+
+	if !isNewerEntry(entry(2, "a"), entry(1, "b")) {
+		t.Errorf("the later entry should win")
+	}
+	if isNewerEntry(entry(1, "b"), entry(2, "a")) {
+		t.Errorf("the earlier entry should lose")
+	}
+	if isNewerEntry(entry(1, "a"), entry(1, "a")) {
+		t.Errorf("the same entry should not be applied again")
+	}
+	// both clusters should pick the same entry with the same mtime
+	if isNewerEntry(entry(1, "a"), entry(1, "b")) == isNewerEntry(entry(1, "b"), entry(1, "a")) {
+		t.Errorf("the tie break should be deterministic")
+	}
+}
+
+func TestFilerSyncCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filersync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "checkpoint")
+	c := &filerSyncCheckpoint{fileName: fileName, positions: make(map[string]int64)}
+	if err = c.load(); err != nil {
+		t.Fatalf("load missing checkpoint: %v", err)
+	}
+	c.set("a", 100)
+	c.set("b", 200)
+	if err = c.save(); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	loaded := &filerSyncCheckpoint{fileName: fileName, positions: make(map[string]int64)}
+	if err = loaded.load(); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if loaded.get("a") != 100 || loaded.get("b") != 200 || loaded.get("c") != 0 {
+		t.Errorf("loaded checkpoint: %v", loaded.positions)
+	}
+}
@@ -28,6 +28,7 @@ type MasterOptions struct {
 	ipBind             *string
 	metaFolder         *string
 	peers              *string
+	join               *bool
 	volumeSizeLimitMB  *uint
 	volumePreallocate  *bool
 	pulseSeconds       *int
@@ -46,6 +47,7 @@ func init() {
 	m.ipBind = cmdMaster.Flag.String("ip.bind", "0.0.0.0", "ip address to bind to")
 	m.metaFolder = cmdMaster.Flag.String("mdir", os.TempDir(), "data directory to store meta data")
 	m.peers = cmdMaster.Flag.String("peers", "", "all master nodes in comma separated ip:port list, example: 127.0.0.1:9093,127.0.0.1:9094")
+	m.join = cmdMaster.Flag.Bool("join", false, "join the existing cluster of the -peers masters, and wait to be added by cluster.raft.add")
 	m.volumeSizeLimitMB = cmdMaster.Flag.Uint("volumeSizeLimitMB", 30*1000, "Master stops directing writes to oversized volumes.")
 	m.volumePreallocate = cmdMaster.Flag.Bool("volumePreallocate", false, "Preallocate disk space for volumes.")
 	m.pulseSeconds = cmdMaster.Flag.Int("pulseSeconds", 5, "number of seconds between heartbeats")
@@ -108,9 +110,9 @@ func runMaster(cmd *Command, args []string) bool {
 
 	go func() {
 		// start raftServer
-		myMasterAddress, peers := checkPeers(*m.ip, *m.port, *m.peers)
+		myMasterAddress, peers := checkPeers(*m.ip, *m.port, *m.peers, *m.join)
 		raftServer := weed_server.NewRaftServer(security.LoadClientTLS(viper.Sub("grpc"), "master"),
-			peers, myMasterAddress, *m.metaFolder, ms.Topo, *m.pulseSeconds)
+			peers, *m.join, myMasterAddress, *m.metaFolder, ms.Topo, *m.pulseSeconds)
 		if raftServer == nil {
 			glog.Fatalf("please verify %s is writable, see https://github.com/chrislusf/seaweedfs/issues/717", *m.metaFolder)
 		}
@@ -142,7 +144,7 @@ func runMaster(cmd *Command, args []string) bool {
 	return true
 }
 
-func checkPeers(masterIp string, masterPort int, peers string) (masterAddress string, cleanedPeers []string) {
+func checkPeers(masterIp string, masterPort int, peers string, join bool) (masterAddress string, cleanedPeers []string) {
 	masterAddress = masterIp + ":" + strconv.Itoa(masterPort)
 	if peers != "" {
 		cleanedPeers = strings.Split(peers, ",")
@@ -160,6 +162,13 @@ func checkPeers(masterIp string, masterPort int, peers string) (masterAddress st
 	if !hasSelf {
 		peerCount += 1
 	}
+	if join {
+		// the cluster can have an even number of masters while they are added one by one
+		if peerCount <= 1 {
+			glog.Fatalf("-join needs -peers listing the current masters")
+		}
+		return
+	}
 	if peerCount%2 == 0 {
 		glog.Fatalf("Only odd number of masters are supported!")
 	}
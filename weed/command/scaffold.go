@@ -145,6 +145,22 @@ addresses = [
 ]
 password = ""
 
+####################################################
+# content-addressed chunk deduplication
+####################################################
+[dedup]
+# reuse existing chunks with the same content for files uploaded through the filer HTTP API.
+# The dedup index is kept in the filer store, and chunks are freed when the last reference is gone.
+# Reference counts are kept by one filer process, so do not enable it with multiple filers on a shared store.
+enabled = false
+# only deduplicate files under these directories or in these collections, everything if both are empty
+directories = [
+#   "/buckets/artifacts",
+]
+collections = [
+#   "artifacts",
+]
+
 `
 
 	NOTIFICATION_TOML_EXAMPLE = `
@@ -336,5 +352,31 @@ scripts = """
 """
 sleep_minutes = 17          # sleep minutes between each script execution
 
+[master.repair]
+# re-replicate volumes and rebuild ec volumes after a volume server is lost
+# pause or resume it from 'weed shell' with volume.repair -pause or -resume
+enabled = false
+grace_period_minutes = 30   # wait for the lost volume server to come back
+max_concurrent_tasks = 1    # volume copies or ec rebuilds running at the same time
+# the copying speed is also limited by the volume server's -compactionMBps option
+
+[master.sequencer]
+# the file id sequencer, one of "raft", "snowflake", or "memory"
+# memory: the default, the ids start after the largest ones reported by the volume servers
+# raft: reserve the file id ranges through the raft log, so the ids are not reused after restarts or leader changes
+#       the largest reserved id is also saved in the master's -mdir folder
+# snowflake: generate the file ids from the time and the master's snowflake_id, without coordination
+type = "memory"
+raft_step = 10000     # the number of file ids reserved each time
+snowflake_id = 0      # unique for each master, within [1, 1023]. 0 derives it from the host name and port
+
+[master.volume_growth]
+# how to pick the volume servers for new volumes, one of "random", "free_slots", or "weighted"
+# random: pick randomly, more likely the ones with more free volume slots
+# free_slots: pick by the free volume slots, also honoring the racks and data centers with more free slots
+# weighted: pick by the free disk bytes, less likely the ones with heavy writes or recent failures
+strategy = "random"
+write_load_reference_mbps = 10    # for "weighted", the weight is halved at this write throughput
+
 `
 )
@@ -78,6 +78,7 @@ func init() {
 	masterOptions.port = cmdServer.Flag.Int("master.port", 9333, "master server http listen port")
 	masterOptions.metaFolder = cmdServer.Flag.String("master.dir", "", "data directory to store meta data, default to same as -dir specified")
 	masterOptions.peers = cmdServer.Flag.String("master.peers", "", "all master nodes in comma separated ip:masterPort list")
+	masterOptions.join = cmdServer.Flag.Bool("master.join", false, "join the existing cluster of the -master.peers masters, and wait to be added by cluster.raft.add")
 	masterOptions.volumeSizeLimitMB = cmdServer.Flag.Uint("master.volumeSizeLimitMB", 30*1000, "Master stops directing writes to oversized volumes.")
 	masterOptions.volumePreallocate = cmdServer.Flag.Bool("master.volumePreallocate", false, "Preallocate disk space for volumes.")
 	masterOptions.defaultReplication = cmdServer.Flag.String("master.defaultReplication", "000", "Default replication type if not specified.")
@@ -93,6 +94,7 @@ func init() {
 	filerOptions.disableDirListing = cmdServer.Flag.Bool("filer.disableDirListing", false, "turn off directory listing")
 	filerOptions.maxMB = cmdServer.Flag.Int("filer.maxMB", 32, "split files larger than the limit")
 	filerOptions.dirListingLimit = cmdServer.Flag.Int("filer.dirListLimit", 1000, "limit sub dir listing size")
+	filerOptions.metaLogDir = cmdServer.Flag.String("filer.metaLogDir", "", "the directory to keep the metadata changes of the last 7 days for filer.sync, empty to keep the recent changes in memory only")
 
 	serverOptions.v.port = cmdServer.Flag.Int("volume.port", 8080, "volume server http listen port")
 	serverOptions.v.publicPort = cmdServer.Flag.Int("volume.port.public", 0, "volume server public port")
@@ -100,6 +102,11 @@ func init() {
 	serverOptions.v.fixJpgOrientation = cmdServer.Flag.Bool("volume.images.fix.orientation", false, "Adjust jpg orientation when uploading.")
 	serverOptions.v.readRedirect = cmdServer.Flag.Bool("volume.read.redirect", true, "Redirect moved or non-local volumes.")
 	serverOptions.v.compactionMBPerSecond = cmdServer.Flag.Int("volume.compactionMBps", 0, "limit compaction speed in mega bytes per second")
+	serverOptions.v.minFreeSpacePercent = cmdServer.Flag.String("volume.minFreeSpacePercent", "1", "minimum free disk space in percent, below which the volumes are read only, percent[,percent]...")
+	serverOptions.v.writeQuorum = cmdServer.Flag.Int("volume.writeQuorum", 0, "number of copies to write before a replicated write succeeds, 0 for all copies")
+	serverOptions.v.ecRecoveryCacheSizeMB = cmdServer.Flag.Int("volume.ec.recoveryCacheSizeMB", 64, "cache size in mega bytes of the reconstructed ec shard data")
+	serverOptions.v.ecRecoveryTimeout = cmdServer.Flag.Duration("volume.ec.recoveryTimeout", 5*time.Second, "time limit to fetch other ec shards to reconstruct a missing shard")
+	serverOptions.v.labels = cmdServer.Flag.String("volume.labels", "", "labels of the volume server for the volume placement, as key1=value1,key2=value2")
 	serverOptions.v.publicUrl = cmdServer.Flag.String("volume.publicUrl", "", "publicly accessible address")
 
 	s3Options.filerBucketsPath = cmdServer.Flag.String("s3.filer.dir.buckets", "/buckets", "folder on filer to store all buckets")
@@ -214,9 +221,9 @@ func runServer(cmd *Command, args []string) bool {
 
 		go func() {
 			// start raftServer
-			myMasterAddress, peers := checkPeers(*serverIp, *masterOptions.port, *masterOptions.peers)
+			myMasterAddress, peers := checkPeers(*serverIp, *masterOptions.port, *masterOptions.peers, *masterOptions.join)
 			raftServer := weed_server.NewRaftServer(security.LoadClientTLS(viper.Sub("grpc"), "master"),
-				peers, myMasterAddress, *masterOptions.metaFolder, ms.Topo, *masterOptions.pulseSeconds)
+				peers, *masterOptions.join, myMasterAddress, *masterOptions.metaFolder, ms.Topo, *masterOptions.pulseSeconds)
 			ms.SetRaftServer(raftServer)
 			r.HandleFunc("/cluster/status", raftServer.StatusHandler).Methods("GET")
 
@@ -1,6 +1,10 @@
 package command
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/shell"
 	"github.com/chrislusf/seaweedfs/weed/util"
@@ -9,23 +13,39 @@ import (
 
 var (
 	shellOptions shell.ShellOptions
+	shellScript  *string
 )
 
 func init() {
 	cmdShell.Run = runShell // break init cycle
 	shellOptions.Masters = cmdShell.Flag.String("master", "localhost:9333", "comma-separated master servers")
+	shellScript = cmdShell.Flag.String("c", "", "run the script and exit, instead of the interactive shell")
+	cmdShell.Flag.BoolVar(&shellOptions.JsonOutput, "json", false, "print the output of all commands as json")
 }
 
 var cmdShell = &Command{
-	UsageLine: "shell",
+	UsageLine: "shell [-c <script>] [-json] [<script file>]",
 	Short:     "run interactive administrative commands",
 	Long: `run interactive administrative commands.
 
+  With -c or a script file, the script runs without the interactive shell,
+  and exits with status 1 at the first failed command.
+
+    weed shell -c "volume.list"
+    weed shell -c 'for v in volume.list; echo $v $v.server; end'
+    weed shell -json maintenance.sh
+
+  The scripts can set variables, and loop over the records of volume.list, collection.list or fs.find:
+
+    set collection pictures
+    for c in collection.list
+      echo $c.name $c.volumeCount
+    end
+    exit 0
+
   `,
 }
 
-var ()
-
 func runShell(command *Command, args []string) bool {
 
 	util.LoadConfiguration("security", false)
@@ -35,7 +55,28 @@ func runShell(command *Command, args []string) bool {
 	shellOptions.FilerPort = 8888
 	shellOptions.Directory = "/"
 
-	shell.RunShell(shellOptions)
+	script := *shellScript
+	if script == "" && len(args) > 0 {
+		data, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "read script %s: %v\n", args[0], err)
+			os.Exit(1)
+		}
+		script = string(data)
+	}
+
+	if script == "" {
+		shell.RunShell(shellOptions)
+		return true
+	}
+
+	if err := shell.RunBatch(shellOptions, script, os.Stdout); err != nil {
+		if exitErr, ok := err.(*shell.ScriptExitError); ok {
+			os.Exit(exitErr.Code)
+		}
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
 
 	return true
 
@@ -29,6 +29,8 @@ type VolumeServerOptions struct {
 	publicPort            *int
 	folders               []string
 	folderMaxLimits       []int
+	minFreeSpacePercent   *string
+	folderMinFreeSpaces   []float32
 	ip                    *string
 	publicUrl             *string
 	bindIp                *string
@@ -44,6 +46,10 @@ type VolumeServerOptions struct {
 	cpuProfile            *string
 	memProfile            *string
 	compactionMBPerSecond *int
+	writeQuorum           *int
+	ecRecoveryCacheSizeMB *int
+	ecRecoveryTimeout     *time.Duration
+	labels                *string
 }
 
 func init() {
@@ -64,6 +70,11 @@ func init() {
 	v.cpuProfile = cmdVolume.Flag.String("cpuprofile", "", "cpu profile output file")
 	v.memProfile = cmdVolume.Flag.String("memprofile", "", "memory profile output file")
 	v.compactionMBPerSecond = cmdVolume.Flag.Int("compactionMBps", 0, "limit background compaction or copying speed in mega bytes per second")
+	v.writeQuorum = cmdVolume.Flag.Int("writeQuorum", 0, "number of copies, including the local one, to write before a replicated write succeeds, 0 for all copies. The missed replicas get the writes later.")
+	v.ecRecoveryCacheSizeMB = cmdVolume.Flag.Int("ec.recoveryCacheSizeMB", 64, "cache size in mega bytes of the ec shard data reconstructed from other shards, 0 to disable")
+	v.ecRecoveryTimeout = cmdVolume.Flag.Duration("ec.recoveryTimeout", 5*time.Second, "time limit to fetch other ec shards to reconstruct a missing shard, skipping slow volume servers")
+	v.labels = cmdVolume.Flag.String("labels", "", "labels of this volume server for the volume placement, as key1=value1,key2=value2")
+	v.minFreeSpacePercent = cmdVolume.Flag.String("minFreeSpacePercent", "1", "minimum free disk space in percent, below which the volumes are read only, percent[,percent]...")
 }
 
 var cmdVolume = &Command{
@@ -107,6 +118,23 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 	if len(v.folders) != len(v.folderMaxLimits) {
 		glog.Fatalf("%d directories by -dir, but only %d max is set by -max", len(v.folders), len(v.folderMaxLimits))
 	}
+	minFreeSpaceStrings := strings.Split(*v.minFreeSpacePercent, ",")
+	for _, minFreeString := range minFreeSpaceStrings {
+		if minFree, e := strconv.ParseFloat(minFreeString, 32); e == nil && minFree >= 0 && minFree < 100 {
+			v.folderMinFreeSpaces = append(v.folderMinFreeSpaces, float32(minFree))
+		} else {
+			glog.Fatalf("The percent specified in -minFreeSpacePercent not a valid number %s", minFreeString)
+		}
+	}
+	if len(v.folderMinFreeSpaces) == 1 {
+		// the same minimum for all directories
+		for len(v.folderMinFreeSpaces) < len(v.folders) {
+			v.folderMinFreeSpaces = append(v.folderMinFreeSpaces, v.folderMinFreeSpaces[0])
+		}
+	}
+	if len(v.folders) != len(v.folderMinFreeSpaces) {
+		glog.Fatalf("%d directories by -dir, but %d percents are set by -minFreeSpacePercent", len(v.folders), len(v.folderMinFreeSpaces))
+	}
 	for _, folder := range v.folders {
 		if err := util.TestFolderWritable(folder); err != nil {
 			glog.Fatalf("Check Data Folder(-dir) Writable %s : %s", folder, err)
@@ -146,16 +174,24 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 		volumeNeedleMapKind = storage.NeedleMapLevelDbLarge
 	}
 
+	labels, err := util.ParseLabels(*v.labels)
+	if err != nil {
+		glog.Fatalf("The labels specified in -labels are not valid: %v", err)
+	}
+
 	masters := *v.masters
 
 	volumeServer := weed_server.NewVolumeServer(volumeMux, publicVolumeMux,
 		*v.ip, *v.port, *v.publicUrl,
-		v.folders, v.folderMaxLimits,
+		v.folders, v.folderMaxLimits, v.folderMinFreeSpaces,
 		volumeNeedleMapKind,
 		strings.Split(masters, ","), *v.pulseSeconds, *v.dataCenter, *v.rack,
 		v.whiteList,
 		*v.fixJpgOrientation, *v.readRedirect,
 		*v.compactionMBPerSecond,
+		*v.writeQuorum,
+		*v.ecRecoveryCacheSizeMB, *v.ecRecoveryTimeout,
+		labels,
 	)
 
 	listeningAddress := *v.bindIp + ":" + strconv.Itoa(*v.port)
@@ -0,0 +1,678 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+var (
+	mirrorOptions VolumeMirrorOptions
+)
+
+type VolumeMirrorOptions struct {
+	sourceMaster  *string
+	targetMaster  *string
+	collection    *string
+	checkpoint    *string
+	scanSeconds   *int
+	reportSeconds *int
+}
+
+func init() {
+	cmdVolumeMirror.Run = runVolumeMirror // break init cycle
+	mirrorOptions.sourceMaster = cmdVolumeMirror.Flag.String("source", "", "comma-separated master servers of the source cluster")
+	mirrorOptions.targetMaster = cmdVolumeMirror.Flag.String("target", "", "comma-separated master servers of the target cluster")
+	mirrorOptions.collection = cmdVolumeMirror.Flag.String("collection", "", "comma-separated collections to mirror, empty for all collections")
+	mirrorOptions.checkpoint = cmdVolumeMirror.Flag.String("checkpoint", "volume.mirror.checkpoint", "the file to save the mirrored position of each volume")
+	mirrorOptions.scanSeconds = cmdVolumeMirror.Flag.Int("scanSeconds", 60, "seconds between the scans for new volumes")
+	mirrorOptions.reportSeconds = cmdVolumeMirror.Flag.Int("reportSeconds", 60, "seconds between the lag reports, 0 to disable")
+}
+
+var cmdVolumeMirror = &Command{
+	UsageLine: "volume.mirror -source=localhost:9333 -target=localhost:9334 [-collection=c1,c2]",
+	Short:     "continuously mirror the volumes of one cluster to another cluster",
+	Long: `continuously mirror the volumes of one cluster to another cluster
+
+	volume.mirror follows every volume of the selected collections on the source cluster,
+	and applies the appended and deleted needles to the volumes with the same ids on the target cluster.
+	The new volumes on the source cluster are picked up every -scanSeconds. The missing volumes
+	are created on the target cluster with the same collection, replication and ttl.
+
+	The target cluster is a hot standby copy. It should not take any writes, since its volume ids
+	are the same as the source cluster. The mirrored volumes are marked read only on the target cluster,
+	and the max volume id of the target cluster is raised to the one of the source cluster.
+
+	Each volume follows one source replica, since each replica stamps the needles with its own append time.
+	If that replica is gone or keeps failing, the volume is mirrored again from the beginning of another replica.
+
+	The position of each volume is saved to the -checkpoint file, so a restart resumes from where it stopped.
+	The lag of each volume is printed every -reportSeconds.
+
+	Only the volumes with version 3 can be followed. The ec volumes are skipped.
+	If a target replica is added or replaced later, run "volume.check.disk" on the target cluster to fill it up.
+
+  `,
+}
+
+const (
+	mirrorRetryInterval      = 10 * time.Second
+	mirrorCheckpointInterval = 10 * time.Second
+	mirrorFailoverAttempts   = 3 // the failed attempts on one source replica before switching to another one
+)
+
+func runVolumeMirror(cmd *Command, args []string) bool {
+
+	util.LoadConfiguration("security", false)
+	grpcDialOption := security.LoadClientTLS(viper.Sub("grpc"), "client")
+
+	if *mirrorOptions.sourceMaster == "" || *mirrorOptions.targetMaster == "" {
+		return false
+	}
+	if *mirrorOptions.scanSeconds <= 0 {
+		glog.Fatalf("invalid -scanSeconds %d", *mirrorOptions.scanSeconds)
+	}
+	if *mirrorOptions.sourceMaster == *mirrorOptions.targetMaster {
+		glog.Fatalf("the source and target clusters should be different")
+	}
+
+	m := newVolumeMirror(grpcDialOption, *mirrorOptions.sourceMaster, *mirrorOptions.targetMaster, *mirrorOptions.collection, *mirrorOptions.checkpoint)
+	if err := m.loadCheckpoint(); err != nil {
+		glog.Fatalf("load checkpoint %s: %v", m.checkpointFile, err)
+	}
+
+	m.source.WaitUntilConnected()
+	m.target.WaitUntilConnected()
+
+	scanTicker := time.NewTicker(time.Duration(*mirrorOptions.scanSeconds) * time.Second)
+	checkpointTicker := time.NewTicker(mirrorCheckpointInterval)
+	var reportTicker <-chan time.Time
+	if *mirrorOptions.reportSeconds > 0 {
+		reportTicker = time.Tick(time.Duration(*mirrorOptions.reportSeconds) * time.Second)
+	}
+
+	for {
+		if err := m.scan(); err != nil {
+			glog.Errorf("scan volumes: %v", err)
+		}
+		for waiting := true; waiting; {
+			select {
+			case <-scanTicker.C:
+				waiting = false
+			case <-checkpointTicker.C:
+				if err := m.saveCheckpoint(); err != nil {
+					glog.Errorf("save checkpoint %s: %v", m.checkpointFile, err)
+				}
+			case <-reportTicker:
+				m.report(os.Stdout)
+			}
+		}
+	}
+
+}
+
+type volumeMirror struct {
+	grpcDialOption grpc.DialOption
+	source         *wdclient.MasterClient
+	target         *wdclient.MasterClient
+	collections    map[string]bool
+	checkpointFile string
+
+	sync.Mutex
+	followers       map[needle.VolumeId]*volumeFollower
+	checkpoint      map[needle.VolumeId]mirrorPosition
+	raisedMaxVolume needle.VolumeId // the max volume id raised on the target cluster
+}
+
+// mirrorPosition is the append time of the last applied needle, on the source replica it is read from
+type mirrorPosition struct {
+	SourceServer string `json:"source"`
+	SinceNs      uint64 `json:"sinceNs"`
+}
+
+// volumeFollower tails one volume on the source cluster, and applies the needles to all the target replicas
+type volumeFollower struct {
+	vid        needle.VolumeId
+	collection string
+	version    needle.Version
+	cancel     context.CancelFunc
+
+	sync.Mutex
+	sourceServers []string
+	targetServers []string
+	// the followed source replica, and the append time on it of the last needle applied to all the target replicas
+	sourceServer string
+	sinceNs      uint64
+	caughtUp     bool
+	appliedCount int64
+	lastErr      error
+}
+
+func newVolumeMirror(grpcDialOption grpc.DialOption, sourceMasters, targetMasters, collections, checkpointFile string) *volumeMirror {
+	m := &volumeMirror{
+		grpcDialOption: grpcDialOption,
+		source:         wdclient.NewMasterClient(context.Background(), grpcDialOption, "volume.mirror", strings.Split(sourceMasters, ",")),
+		target:         wdclient.NewMasterClient(context.Background(), grpcDialOption, "volume.mirror", strings.Split(targetMasters, ",")),
+		collections:    make(map[string]bool),
+		checkpointFile: checkpointFile,
+		followers:      make(map[needle.VolumeId]*volumeFollower),
+		checkpoint:     make(map[needle.VolumeId]mirrorPosition),
+	}
+	for _, c := range strings.Split(collections, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			m.collections[c] = true
+		}
+	}
+	go m.source.KeepConnectedToMaster()
+	go m.target.KeepConnectedToMaster()
+	return m
+}
+
+type mirrorVolumeLocation struct {
+	info            *master_pb.VolumeInformationMessage
+	servers         []string
+	writableServers []string
+}
+
+// scan starts following the new volumes, updates the locations of the followed volumes,
+// and stops following the volumes removed from the source cluster
+func (m *volumeMirror) scan() error {
+
+	sourceVolumes, sourceNodes, err := m.listVolumes(m.source)
+	if err != nil {
+		return fmt.Errorf("list source volumes: %v", err)
+	}
+	targetVolumes, targetNodes, err := m.listVolumes(m.target)
+	if err != nil {
+		return fmt.Errorf("list target volumes: %v", err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	// the volumes created on the target cluster, e.g., after a failover, should not reuse the source volume ids
+	if err = m.raiseTargetMaxVolumeId(maxVolumeId(sourceNodes)); err != nil {
+		return fmt.Errorf("raise the max volume id of the target cluster: %v", err)
+	}
+
+	for vid, f := range m.followers {
+		if _, found := sourceVolumes[vid]; !found {
+			glog.V(0).Infof("volume %d is removed from the source cluster, stop mirroring it", vid)
+			f.cancel()
+			delete(m.followers, vid)
+			delete(m.checkpoint, vid)
+		}
+	}
+
+	for vid, source := range sourceVolumes {
+		if len(m.collections) > 0 && !m.collections[source.info.Collection] {
+			continue
+		}
+
+		target, found := targetVolumes[vid]
+		if found && target.info.Collection != source.info.Collection {
+			glog.Errorf("volume %d is in collection %q on the source cluster, but %q on the target cluster",
+				vid, source.info.Collection, target.info.Collection)
+			continue
+		}
+
+		if f, following := m.followers[vid]; following {
+			f.Lock()
+			f.sourceServers = source.servers
+			if found {
+				f.targetServers = target.servers
+			}
+			f.Unlock()
+			if found {
+				// the read only state is not kept after the target volume server restarts
+				m.markTargetReadonly(vid, target.writableServers)
+			}
+			continue
+		}
+
+		if source.info.Version != uint32(needle.Version3) {
+			glog.V(1).Infof("skip volume %d with version %d, only version %d can be followed", vid, source.info.Version, needle.Version3)
+			continue
+		}
+
+		if !found {
+			servers, err := m.createTargetVolume(source.info, targetNodes)
+			if err != nil {
+				glog.Errorf("create volume %d on the target cluster: %v", vid, err)
+				continue
+			}
+			target = &mirrorVolumeLocation{info: source.info, servers: servers, writableServers: servers}
+			// the new target volume is empty, whatever the checkpoint says
+			delete(m.checkpoint, vid)
+		}
+		m.markTargetReadonly(vid, target.writableServers)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		f := &volumeFollower{
+			vid:           vid,
+			collection:    source.info.Collection,
+			version:       needle.Version(source.info.Version),
+			cancel:        cancel,
+			sourceServers: source.servers,
+			targetServers: target.servers,
+			sourceServer:  m.checkpoint[vid].SourceServer,
+			sinceNs:       m.checkpoint[vid].SinceNs,
+		}
+		m.followers[vid] = f
+		glog.V(0).Infof("start mirroring volume %d from %v to %v since %d on %s", vid, f.sourceServers, f.targetServers, f.sinceNs, f.sourceServer)
+		go m.follow(ctx, f)
+	}
+
+	return nil
+}
+
+// listVolumes returns the locations of the normal volumes, and the data nodes of the cluster
+func (m *volumeMirror) listVolumes(masterClient *wdclient.MasterClient) (volumes map[needle.VolumeId]*mirrorVolumeLocation, nodes []*master_pb.DataNodeInfo, err error) {
+	var resp *master_pb.VolumeListResponse
+	ctx := context.Background()
+	err = masterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volumes = make(map[needle.VolumeId]*mirrorVolumeLocation)
+	for _, dc := range resp.TopologyInfo.DataCenterInfos {
+		for _, rack := range dc.RackInfos {
+			for _, dn := range rack.DataNodeInfos {
+				nodes = append(nodes, dn)
+				for _, v := range dn.VolumeInfos {
+					vid := needle.VolumeId(v.Id)
+					if volumes[vid] == nil {
+						volumes[vid] = &mirrorVolumeLocation{info: v}
+					}
+					volumes[vid].servers = append(volumes[vid].servers, dn.Id)
+					if !v.ReadOnly {
+						volumes[vid].writableServers = append(volumes[vid].writableServers, dn.Id)
+					}
+				}
+			}
+		}
+	}
+	return volumes, nodes, nil
+}
+
+// createTargetVolume allocates the volume on the target data nodes with the most free slots, one node for each copy
+func (m *volumeMirror) createTargetVolume(info *master_pb.VolumeInformationMessage, nodes []*master_pb.DataNodeInfo) (servers []string, err error) {
+	replication, err := storage.NewReplicaPlacementFromByte(byte(info.ReplicaPlacement))
+	if err != nil {
+		return nil, err
+	}
+	ttl := needle.LoadTTLFromUint32(info.Ttl)
+
+	servers = pickMirrorTargetServers(nodes, replication.GetCopyCount())
+	if len(servers) < replication.GetCopyCount() {
+		return nil, fmt.Errorf("only %d data nodes have free slots for %d copies", len(servers), replication.GetCopyCount())
+	}
+
+	for _, server := range servers {
+		err = operation.WithVolumeServerClient(server, m.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			_, allocateErr := client.AllocateVolume(context.Background(), &volume_server_pb.AllocateVolumeRequest{
+				VolumeId:    info.Id,
+				Collection:  info.Collection,
+				Replication: replication.String(),
+				Ttl:         ttl.String(),
+			})
+			return allocateErr
+		})
+		if err != nil {
+			return nil, fmt.Errorf("allocate volume %d on %s: %v", info.Id, server, err)
+		}
+		// count the new volume before the next heartbeat of the data node
+		for _, dn := range nodes {
+			if dn.Id == server {
+				dn.FreeVolumeCount--
+			}
+		}
+	}
+	glog.V(0).Infof("created volume %d collection:%q replication:%s ttl:%s on %v", info.Id, info.Collection, replication, ttl, servers)
+
+	return servers, nil
+}
+
+// maxVolumeId is the largest id of the normal and ec volumes on the data nodes
+func maxVolumeId(nodes []*master_pb.DataNodeInfo) (max needle.VolumeId) {
+	for _, dn := range nodes {
+		for _, v := range dn.VolumeInfos {
+			if vid := needle.VolumeId(v.Id); vid > max {
+				max = vid
+			}
+		}
+		for _, ecShards := range dn.EcShardInfos {
+			if vid := needle.VolumeId(ecShards.Id); vid > max {
+				max = vid
+			}
+		}
+	}
+	return
+}
+
+func (m *volumeMirror) raiseTargetMaxVolumeId(vid needle.VolumeId) error {
+	if vid <= m.raisedMaxVolume {
+		return nil
+	}
+	ctx := context.Background()
+	err := m.target.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err := client.MaxVolumeIdRaise(ctx, &master_pb.MaxVolumeIdRaiseRequest{
+			MaxVolumeId: uint32(vid),
+		})
+		if err != nil {
+			return err
+		}
+		glog.V(1).Infof("the max volume id of the target cluster is %d", resp.MaxVolumeId)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	m.raisedMaxVolume = vid
+	return nil
+}
+
+// markTargetReadonly keeps the target cluster from assigning writes to the mirrored volume
+func (m *volumeMirror) markTargetReadonly(vid needle.VolumeId, servers []string) {
+	for _, server := range servers {
+		err := operation.WithVolumeServerClient(server, m.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			_, err := client.VolumeMarkReadonly(context.Background(), &volume_server_pb.VolumeMarkReadonlyRequest{
+				VolumeId: uint32(vid),
+			})
+			return err
+		})
+		if err != nil {
+			glog.Errorf("mark volume %d read only on %s: %v", vid, server, err)
+		}
+	}
+}
+
+func pickMirrorTargetServers(nodes []*master_pb.DataNodeInfo, count int) (servers []string) {
+	var candidates []*master_pb.DataNodeInfo
+	for _, dn := range nodes {
+		if dn.FreeVolumeCount > 0 {
+			candidates = append(candidates, dn)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].FreeVolumeCount > candidates[j].FreeVolumeCount
+	})
+	for i := 0; i < len(candidates) && i < count; i++ {
+		servers = append(servers, candidates[i].Id)
+	}
+	return
+}
+
+// follow tails the volume until it is cancelled, restarting from the last applied needle after any error
+func (m *volumeMirror) follow(ctx context.Context, f *volumeFollower) {
+	failures := 0
+	for {
+		f.Lock()
+		appliedCount := f.appliedCount
+		f.Unlock()
+
+		err := m.tail(ctx, f, failures)
+		if ctx.Err() != nil {
+			glog.V(0).Infof("stop mirroring volume %d", f.vid)
+			return
+		}
+		f.Lock()
+		if f.caughtUp || f.appliedCount > appliedCount {
+			failures = 0
+		} else {
+			failures++
+		}
+		f.lastErr, f.caughtUp = err, false
+		f.Unlock()
+		glog.Errorf("mirror volume %d: %v", f.vid, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(mirrorRetryInterval):
+		}
+	}
+}
+
+// chooseSource keeps the followed source replica, since the append times differ on each replica.
+// If the replica is gone or keeps failing, it switches to another replica, and starts from the beginning.
+func (f *volumeFollower) chooseSource(failures int) (sourceServer string, sinceNs uint64, err error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(f.sourceServers) == 0 {
+		return "", 0, fmt.Errorf("no source replicas")
+	}
+	current := -1
+	for i, server := range f.sourceServers {
+		if server == f.sourceServer {
+			current = i
+		}
+	}
+	if current < 0 || failures >= mirrorFailoverAttempts {
+		next := f.sourceServers[(current+1)%len(f.sourceServers)]
+		if next != f.sourceServer {
+			if f.sinceNs > 0 {
+				glog.V(0).Infof("volume %d switches from %q to %s, and is mirrored from the beginning", f.vid, f.sourceServer, next)
+			}
+			f.sourceServer, f.sinceNs = next, 0
+		}
+	}
+	return f.sourceServer, f.sinceNs, nil
+}
+
+func (m *volumeMirror) tail(ctx context.Context, f *volumeFollower, failures int) error {
+
+	sourceServer, sinceNs, err := f.chooseSource(failures)
+	if err != nil {
+		return err
+	}
+
+	return operation.WithVolumeServerClient(sourceServer, m.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		stream, err := client.VolumeTailSender(ctx, &volume_server_pb.VolumeTailSenderRequest{
+			VolumeId: uint32(f.vid),
+			SinceNs:  sinceNs,
+		})
+		if err != nil {
+			return fmt.Errorf("tail %s: %v", sourceServer, err)
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return fmt.Errorf("tail %s: stopped", sourceServer)
+			}
+			if err != nil {
+				return fmt.Errorf("tail %s: %v", sourceServer, err)
+			}
+
+			// an empty header is the heartbeat when there are no newer needles
+			if len(resp.NeedleHeader) == 0 {
+				f.Lock()
+				f.caughtUp, f.lastErr = true, nil
+				f.Unlock()
+				continue
+			}
+
+			needleHeader, needleBody := resp.NeedleHeader, resp.NeedleBody
+			for !resp.IsLastChunk {
+				if resp, err = stream.Recv(); err != nil {
+					return fmt.Errorf("tail %s: %v", sourceServer, err)
+				}
+				needleBody = append(needleBody, resp.NeedleBody...)
+			}
+
+			n := new(needle.Needle)
+			n.ParseNeedleHeader(needleHeader)
+			if err = n.ReadNeedleBodyBytes(needleBody, f.version); err != nil {
+				return fmt.Errorf("read needle %d: %v", n.Id, err)
+			}
+
+			if err = m.applyNeedle(f, n, append(needleHeader, needleBody...)); err != nil {
+				return err
+			}
+
+			f.Lock()
+			f.sinceNs = n.AppendAtNs
+			f.caughtUp = false
+			f.appliedCount++
+			f.Unlock()
+		}
+	})
+}
+
+// applyNeedle writes or deletes the needle on all the target replicas.
+// A needle without data in the .dat file is a deletion.
+func (m *volumeMirror) applyNeedle(f *volumeFollower, n *needle.Needle, needleBlob []byte) error {
+
+	f.Lock()
+	targetServers := f.targetServers
+	f.Unlock()
+
+	for _, server := range targetServers {
+		err := operation.WithVolumeServerClient(server, m.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			if n.Size > 0 {
+				_, err := client.WriteNeedleBlob(context.Background(), &volume_server_pb.WriteNeedleBlobRequest{
+					VolumeId:       uint32(f.vid),
+					NeedleId:       uint64(n.Id),
+					NeedleBlob:     needleBlob,
+					Version:        uint32(f.version),
+					IgnoreReadOnly: true,
+				})
+				return err
+			}
+			resp, err := client.BatchDelete(context.Background(), &volume_server_pb.BatchDeleteRequest{
+				FileIds:         []string{needle.NewFileId(f.vid, uint64(n.Id), uint32(n.Cookie)).String()},
+				SkipCookieCheck: true,
+				IgnoreReadOnly:  true,
+			})
+			if err != nil {
+				return err
+			}
+			for _, result := range resp.Results {
+				if result.Status != http.StatusAccepted && result.Status != http.StatusNotFound {
+					return fmt.Errorf("delete %s: %s", result.FileId, result.Error)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("apply needle %d to %s: %v", n.Id, server, err)
+		}
+	}
+
+	return nil
+}
+
+// lag is zero when the target has all the source needles, otherwise the time since the last applied needle was appended
+func (f *volumeFollower) lag(now time.Time) time.Duration {
+	if f.caughtUp {
+		return 0
+	}
+	if f.sinceNs == 0 {
+		return -1
+	}
+	return now.Sub(time.Unix(0, int64(f.sinceNs)))
+}
+
+func (m *volumeMirror) report(writer io.Writer) {
+
+	m.Lock()
+	var followers []*volumeFollower
+	for _, f := range m.followers {
+		followers = append(followers, f)
+	}
+	m.Unlock()
+
+	sort.Slice(followers, func(i, j int) bool {
+		return followers[i].vid < followers[j].vid
+	})
+
+	now := time.Now()
+	fmt.Fprintf(writer, "%s mirroring %d volumes\n", now.Format(time.RFC3339), len(followers))
+	for _, f := range followers {
+		f.Lock()
+		lag := "unknown"
+		if d := f.lag(now); d >= 0 {
+			lag = d.Round(time.Second).String()
+		}
+		fmt.Fprintf(writer, "  volume %d collection:%q source:%s of %v target:%v applied:%d lag:%s", f.vid, f.collection, f.sourceServer, f.sourceServers, f.targetServers, f.appliedCount, lag)
+		if f.lastErr != nil {
+			fmt.Fprintf(writer, " error:%v", f.lastErr)
+		}
+		fmt.Fprintln(writer)
+		f.Unlock()
+	}
+}
+
+func (m *volumeMirror) loadCheckpoint() error {
+	data, err := ioutil.ReadFile(m.checkpointFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	positions := make(map[string]mirrorPosition)
+	if err = json.Unmarshal(data, &positions); err != nil {
+		return err
+	}
+	for key, position := range positions {
+		vid, err := needle.NewVolumeId(key)
+		if err != nil {
+			return err
+		}
+		m.checkpoint[vid] = position
+	}
+	return nil
+}
+
+// saveCheckpoint writes the position of each volume to a temporary file, and renames it to the checkpoint file
+func (m *volumeMirror) saveCheckpoint() error {
+	m.Lock()
+	for vid, f := range m.followers {
+		f.Lock()
+		m.checkpoint[vid] = mirrorPosition{SourceServer: f.sourceServer, SinceNs: f.sinceNs}
+		f.Unlock()
+	}
+	positions := make(map[string]mirrorPosition)
+	for vid, position := range m.checkpoint {
+		positions[strconv.FormatUint(uint64(vid), 10)] = position
+	}
+	m.Unlock()
+
+	data, err := json.Marshal(positions)
+	if err != nil {
+		return err
+	}
+	tempFile := m.checkpointFile + ".tmp"
+	if err = ioutil.WriteFile(tempFile, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempFile, m.checkpointFile)
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestPickMirrorTargetServers(t *testing.T) {
+	nodes := []*master_pb.DataNodeInfo{
+		{Id: "dn1", FreeVolumeCount: 1},
+		{Id: "dn2", FreeVolumeCount: 0},
+		{Id: "dn3", FreeVolumeCount: 5},
+		{Id: "dn4", FreeVolumeCount: 3},
+	}
+	if servers := pickMirrorTargetServers(nodes, 2); !reflect.DeepEqual(servers, []string{"dn3", "dn4"}) {
+		t.Errorf("2 copies: %v", servers)
+	}
+	if servers := pickMirrorTargetServers(nodes, 4); !reflect.DeepEqual(servers, []string{"dn3", "dn4", "dn1"}) {
+		t.Errorf("4 copies: %v", servers)
+	}
+}
+
+func TestMirrorCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mirror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := &volumeMirror{
+		checkpointFile: filepath.Join(dir, "checkpoint"),
+		followers: map[needle.VolumeId]*volumeFollower{
+			3: {vid: 3, sourceServer: "dn1", sinceNs: 300},
+		},
+		checkpoint: map[needle.VolumeId]mirrorPosition{
+			5: {SourceServer: "dn2", SinceNs: 500},
+		},
+	}
+	if err = m.loadCheckpoint(); err != nil {
+		t.Fatalf("load missing checkpoint: %v", err)
+	}
+	if err = m.saveCheckpoint(); err != nil {
+		t.Fatalf("save checkpoint: %v", err)
+	}
+
+	loaded := &volumeMirror{checkpointFile: m.checkpointFile, checkpoint: make(map[needle.VolumeId]mirrorPosition)}
+	if err = loaded.loadCheckpoint(); err != nil {
+		t.Fatalf("load checkpoint: %v", err)
+	}
+	expected := map[needle.VolumeId]mirrorPosition{
+		3: {SourceServer: "dn1", SinceNs: 300},
+		5: {SourceServer: "dn2", SinceNs: 500},
+	}
+	if !reflect.DeepEqual(loaded.checkpoint, expected) {
+		t.Errorf("loaded checkpoint %v, expected %v", loaded.checkpoint, expected)
+	}
+}
+
+func TestChooseMirrorSource(t *testing.T) {
+	f := &volumeFollower{vid: 1, sourceServers: []string{"dn1", "dn2"}, sourceServer: "dn2", sinceNs: 200}
+
+	// keep following the same replica after a few failures
+	if server, sinceNs, _ := f.chooseSource(mirrorFailoverAttempts - 1); server != "dn2" || sinceNs != 200 {
+		t.Errorf("chose %s since %d, expected dn2 since 200", server, sinceNs)
+	}
+
+	// the append times of another replica are different, start from the beginning
+	if server, sinceNs, _ := f.chooseSource(mirrorFailoverAttempts); server != "dn1" || sinceNs != 0 {
+		t.Errorf("chose %s since %d, expected dn1 since 0", server, sinceNs)
+	}
+
+	// the followed replica is gone
+	f.sinceNs = 100
+	f.sourceServers = []string{"dn3"}
+	if server, sinceNs, _ := f.chooseSource(0); server != "dn3" || sinceNs != 0 {
+		t.Errorf("chose %s since %d, expected dn3 since 0", server, sinceNs)
+	}
+
+	// the only replica is kept even if it keeps failing
+	f.sinceNs = 300
+	if server, sinceNs, _ := f.chooseSource(mirrorFailoverAttempts); server != "dn3" || sinceNs != 300 {
+		t.Errorf("chose %s since %d, expected dn3 since 300", server, sinceNs)
+	}
+
+	f.sourceServers = nil
+	if _, _, err := f.chooseSource(0); err == nil {
+		t.Errorf("expected an error without source replicas")
+	}
+}
+
+func TestMirrorMaxVolumeId(t *testing.T) {
+	nodes := []*master_pb.DataNodeInfo{
+		{Id: "dn1", VolumeInfos: []*master_pb.VolumeInformationMessage{{Id: 3}, {Id: 7}}},
+		{Id: "dn2", EcShardInfos: []*master_pb.VolumeEcShardInformationMessage{{Id: 9}}},
+	}
+	if max := maxVolumeId(nodes); max != 9 {
+		t.Errorf("max volume id %d, expected 9", max)
+	}
+}
@@ -24,6 +24,7 @@ func (f *Filer) LoadConfiguration(config *viper.Viper) {
 			}
 			f.SetStore(store)
 			glog.V(0).Infof("Configure filer for %s", store.GetName())
+			f.loadDedupConfiguration(config.Sub("dedup"))
 			return
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -21,19 +22,24 @@ var (
 )
 
 type Filer struct {
-	store              *FilerStoreWrapper
-	directoryCache     *ccache.Cache
-	MasterClient       *wdclient.MasterClient
-	fileIdDeletionChan chan string
-	GrpcDialOption     grpc.DialOption
+	store               *FilerStoreWrapper
+	directoryCache      *ccache.Cache
+	MasterClient        *wdclient.MasterClient
+	fileIdDeletionChan  chan string
+	GrpcDialOption      grpc.DialOption
+	dedup               *DedupOption
+	dedupLock           sync.Mutex
+	recentlyFreedChunks *ccache.Cache
+	MetaLog             *MetaLog
 }
 
 func NewFiler(masters []string, grpcDialOption grpc.DialOption) *Filer {
 	f := &Filer{
-		directoryCache:     ccache.New(ccache.Configure().MaxSize(1000).ItemsToPrune(100)),
-		MasterClient:       wdclient.NewMasterClient(context.Background(), grpcDialOption, "filer", masters),
-		fileIdDeletionChan: make(chan string, 4096),
-		GrpcDialOption:     grpcDialOption,
+		directoryCache:      ccache.New(ccache.Configure().MaxSize(1000).ItemsToPrune(100)),
+		MasterClient:        wdclient.NewMasterClient(context.Background(), grpcDialOption, "filer", masters),
+		fileIdDeletionChan:  make(chan string, 4096),
+		GrpcDialOption:      grpcDialOption,
+		recentlyFreedChunks: ccache.New(ccache.Configure().MaxSize(100000).ItemsToPrune(1000)),
 	}
 
 	go f.loopProcessingDeletion()
@@ -120,7 +126,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 					return fmt.Errorf("mkdir %s: %v", dirPath, mkdirErr)
 				}
 			} else {
-				f.NotifyUpdateEvent(nil, dirEntry, false)
+				f.NotifyUpdateEvent(ctx, nil, dirEntry, false)
 			}
 
 		} else if !dirEntry.IsDirectory() {
@@ -152,7 +158,12 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 	oldEntry, _ := f.FindEntry(ctx, entry.FullPath)
 
 	if oldEntry == nil {
+		acquired, _, err := f.acquireDedupChunks(ctx, nil, entry)
+		if err != nil {
+			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
+		}
 		if err := f.store.InsertEntry(ctx, entry); err != nil {
+			f.DeleteChunks(entry.FullPath, acquired)
 			glog.Errorf("insert entry %s: %v", entry.FullPath, err)
 			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
 		}
@@ -163,7 +174,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 		}
 	}
 
-	f.NotifyUpdateEvent(oldEntry, entry, true)
+	f.NotifyUpdateEvent(ctx, oldEntry, entry, true)
 
 	f.deleteChunksIfNotNew(oldEntry, entry)
 
@@ -181,7 +192,17 @@ func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err er
 			return fmt.Errorf("existing %s is a file", entry.FullPath)
 		}
 	}
-	return f.store.UpdateEntry(ctx, entry)
+	acquired, released, err := f.acquireDedupChunks(ctx, oldEntry, entry)
+	if err != nil {
+		return err
+	}
+	if err = f.store.UpdateEntry(ctx, entry); err != nil {
+		f.DeleteChunks(entry.FullPath, acquired)
+		return err
+	}
+	// the deduplicated chunks are released here by their reference counts, not by the caller
+	f.DeleteChunks(entry.FullPath, released)
+	return nil
 }
 
 func (f *Filer) FindEntry(ctx context.Context, p FullPath) (entry *Entry, err error) {
@@ -252,6 +273,9 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecurs
 
 	if shouldDeleteChunks {
 		f.DeleteChunks(p, entry.Chunks)
+	} else {
+		// the data is kept, but this entry no longer references the deduplicated chunks
+		f.DeleteChunks(p, dedupChunks(entry.Chunks))
 	}
 
 	if p == "/" {
@@ -259,7 +283,7 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecurs
 	}
 	glog.V(3).Infof("deleting entry %v", p)
 
-	f.NotifyUpdateEvent(entry, nil, shouldDeleteChunks)
+	f.NotifyUpdateEvent(ctx, entry, nil, shouldDeleteChunks)
 
 	return f.store.DeleteEntry(ctx, p)
 }
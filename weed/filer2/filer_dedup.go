@@ -0,0 +1,309 @@
+package filer2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/spf13/viper"
+)
+
+// DedupIndexDirectory holds one entry per deduplicated chunk, keyed by the chunk content hash.
+// The index entries are written to the filer store directly, so they do not show up in directory listings.
+const DedupIndexDirectory = "/.dedup"
+
+const dedupRefCountKey = "refcount"
+
+type DedupOption struct {
+	Enabled     bool
+	Directories []string
+	Collections []string
+}
+
+func (f *Filer) loadDedupConfiguration(config *viper.Viper) {
+	if config == nil || !config.GetBool("enabled") {
+		return
+	}
+	f.dedup = &DedupOption{
+		Enabled:     true,
+		Directories: config.GetStringSlice("directories"),
+		Collections: config.GetStringSlice("collections"),
+	}
+	glog.V(0).Infof("chunk deduplication enabled for directories %v collections %v", f.dedup.Directories, f.dedup.Collections)
+}
+
+// IsDedupEnabled tells whether new chunks for the path in the collection should be deduplicated.
+// With no directories and no collections configured, everything is deduplicated.
+func (f *Filer) IsDedupEnabled(p FullPath, collection string) bool {
+	if f.dedup == nil || !f.dedup.Enabled {
+		return false
+	}
+	if len(f.dedup.Directories) == 0 && len(f.dedup.Collections) == 0 {
+		return true
+	}
+	for _, dir := range f.dedup.Directories {
+		dir = strings.TrimSuffix(dir, "/")
+		if string(p) == dir || strings.HasPrefix(string(p), dir+"/") {
+			return true
+		}
+	}
+	for _, c := range f.dedup.Collections {
+		if c == collection {
+			return true
+		}
+	}
+	return false
+}
+
+// DedupChunkHash computes the content hash of a chunk. The collection is part of the hash,
+// so chunks are only shared within the same collection.
+func DedupChunkHash(collection string, data []byte) string {
+	h := sha256.New()
+	h.Write([]byte(collection))
+	h.Write([]byte{0})
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func dedupIndexPath(hash string) FullPath {
+	return FullPath(DedupIndexDirectory + "/" + hash[:2] + "/" + hash)
+}
+
+// NonDedupChunks filters out deduplicated chunks, whose needles are freed by reference counting.
+func NonDedupChunks(chunks []*filer_pb.FileChunk) (nonDedupChunks []*filer_pb.FileChunk) {
+	for _, chunk := range chunks {
+		if chunk.ContentHash == "" {
+			nonDedupChunks = append(nonDedupChunks, chunk)
+		}
+	}
+	return
+}
+
+func dedupChunks(chunks []*filer_pb.FileChunk) (deduped []*filer_pb.FileChunk) {
+	for _, chunk := range chunks {
+		if chunk.ContentHash != "" {
+			deduped = append(deduped, chunk)
+		}
+	}
+	return
+}
+
+// FindDedupChunk returns an already stored chunk with the same content hash, or nil.
+// The returned chunk is only referenced after the entry using it is saved.
+func (f *Filer) FindDedupChunk(ctx context.Context, hash string) *filer_pb.FileChunk {
+	indexEntry, err := f.store.FindEntry(ctx, dedupIndexPath(hash))
+	if err != nil || len(indexEntry.Chunks) == 0 {
+		return nil
+	}
+	existing := indexEntry.Chunks[0]
+	if f.recentlyFreedChunks.Get(existing.GetFileIdString()) != nil {
+		return nil
+	}
+	return &filer_pb.FileChunk{
+		FileId:      existing.GetFileIdString(),
+		Size:        existing.Size,
+		ETag:        existing.ETag,
+		ContentHash: hash,
+	}
+}
+
+// PinDedupChunk is FindDedupChunk with one more reference to the found chunk, so the chunk is not freed
+// before the entry using it is saved. The pinned chunk should be released with DeleteChunks afterwards.
+// It returns nil if the chunk is not found, or is being deleted, and the content should be uploaded again.
+func (f *Filer) PinDedupChunk(ctx context.Context, hash string) *filer_pb.FileChunk {
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	chunk := f.FindDedupChunk(ctx, hash)
+	if chunk == nil {
+		return nil
+	}
+	if indexed, err := f.acquireDedupChunk(ctx, chunk); err != nil || !indexed {
+		glog.V(1).Infof("pin deduplicated chunk %s: %v", chunk.FileId, err)
+		return nil
+	}
+	return chunk
+}
+
+// dedupChunkDelta compares the references to the deduplicated chunks in the old and new chunk lists.
+// A file can reference the same chunk more than once, and each reference is counted.
+// It returns one chunk for each reference to acquire, and one chunk for each reference to release.
+func dedupChunkDelta(oldChunks, newChunks []*filer_pb.FileChunk) (toAcquire, toRelease []*filer_pb.FileChunk) {
+	counts := make(map[string]int)
+	for _, chunk := range dedupChunks(newChunks) {
+		counts[chunk.GetFileIdString()]++
+	}
+	for _, chunk := range dedupChunks(oldChunks) {
+		counts[chunk.GetFileIdString()]--
+	}
+	for _, chunk := range dedupChunks(newChunks) {
+		if fileId := chunk.GetFileIdString(); counts[fileId] > 0 {
+			toAcquire = append(toAcquire, chunk)
+			counts[fileId]--
+		}
+	}
+	for _, chunk := range dedupChunks(oldChunks) {
+		if fileId := chunk.GetFileIdString(); counts[fileId] < 0 {
+			toRelease = append(toRelease, chunk)
+			counts[fileId]++
+		}
+	}
+	return
+}
+
+// acquireDedupChunks adds the references to the deduplicated chunks that the new entry has more than the old one.
+// A chunk seen for the first time is added to the dedup index.
+// The returned released chunks are the references dropped by the new entry, to release after the entry is saved.
+func (f *Filer) acquireDedupChunks(ctx context.Context, oldEntry, entry *Entry) (acquired, released []*filer_pb.FileChunk, err error) {
+
+	var oldChunks []*filer_pb.FileChunk
+	if oldEntry != nil {
+		oldChunks = oldEntry.Chunks
+	}
+	toAcquire, released := dedupChunkDelta(oldChunks, entry.Chunks)
+	if len(toAcquire) == 0 {
+		return nil, released, nil
+	}
+
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	notIndexed := make(map[string]bool)
+	for _, chunk := range toAcquire {
+		indexed, acquireErr := f.acquireDedupChunk(ctx, chunk)
+		if acquireErr != nil {
+			f.releaseDedupChunksLocked(ctx, acquired)
+			return nil, nil, acquireErr
+		}
+		if indexed {
+			acquired = append(acquired, chunk)
+		} else {
+			notIndexed[chunk.GetFileIdString()] = true
+		}
+	}
+
+	// the same content was uploaded concurrently, keep all the references to this copy as plain chunks
+	for _, chunk := range entry.Chunks {
+		if notIndexed[chunk.GetFileIdString()] {
+			chunk.ContentHash = ""
+		}
+	}
+
+	return acquired, released, nil
+}
+
+// acquireDedupChunk adds one reference to the chunk, or indexes it for the first time.
+// It returns false if the content is indexed with another chunk.
+func (f *Filer) acquireDedupChunk(ctx context.Context, chunk *filer_pb.FileChunk) (indexed bool, err error) {
+
+	fileId := chunk.GetFileIdString()
+	indexPath := dedupIndexPath(chunk.ContentHash)
+
+	indexEntry, err := f.store.FindEntry(ctx, indexPath)
+	if err == ErrNotFound {
+		if f.recentlyFreedChunks.Get(fileId) != nil {
+			return false, fmt.Errorf("deduplicated chunk %s is being deleted", fileId)
+		}
+		now := time.Now()
+		return true, f.store.InsertEntry(ctx, &Entry{
+			FullPath: indexPath,
+			Attr: Attr{
+				Mtime:  now,
+				Crtime: now,
+				Mode:   0600,
+			},
+			Chunks: []*filer_pb.FileChunk{{
+				FileId: fileId,
+				Size:   chunk.Size,
+				Mtime:  chunk.Mtime,
+				ETag:   chunk.ETag,
+			}},
+			Extended: map[string][]byte{
+				dedupRefCountKey: dedupRefCountBytes(1),
+			},
+		})
+	}
+	if err != nil {
+		return false, fmt.Errorf("find dedup index %s: %v", indexPath, err)
+	}
+
+	if len(indexEntry.Chunks) == 0 || indexEntry.Chunks[0].GetFileIdString() != fileId {
+		glog.V(1).Infof("chunk %s duplicates %s, not deduplicated", fileId, chunk.ContentHash)
+		return false, nil
+	}
+
+	indexEntry.Extended[dedupRefCountKey] = dedupRefCountBytes(dedupRefCount(indexEntry) + 1)
+	return true, f.store.UpdateEntry(ctx, indexEntry)
+}
+
+// releaseDedupChunks drops one reference from each deduplicated chunk,
+// and returns the chunks whose needles are no longer referenced and can be deleted.
+func (f *Filer) releaseDedupChunks(ctx context.Context, chunks []*filer_pb.FileChunk) (toDelete []*filer_pb.FileChunk) {
+
+	deduped := dedupChunks(chunks)
+	if len(deduped) == 0 {
+		return chunks
+	}
+
+	f.dedupLock.Lock()
+	defer f.dedupLock.Unlock()
+
+	return append(NonDedupChunks(chunks), f.releaseDedupChunksLocked(ctx, deduped)...)
+}
+
+func (f *Filer) releaseDedupChunksLocked(ctx context.Context, chunks []*filer_pb.FileChunk) (toDelete []*filer_pb.FileChunk) {
+
+	for _, chunk := range chunks {
+
+		fileId := chunk.GetFileIdString()
+		indexPath := dedupIndexPath(chunk.ContentHash)
+
+		indexEntry, err := f.store.FindEntry(ctx, indexPath)
+		if err != nil || len(indexEntry.Chunks) == 0 || indexEntry.Chunks[0].GetFileIdString() != fileId {
+			// never indexed, the needle is only used by this chunk
+			toDelete = append(toDelete, chunk)
+			continue
+		}
+
+		refCount := dedupRefCount(indexEntry) - 1
+		if refCount > 0 {
+			indexEntry.Extended[dedupRefCountKey] = dedupRefCountBytes(refCount)
+			if err = f.store.UpdateEntry(ctx, indexEntry); err != nil {
+				glog.Errorf("update dedup index %s: %v", indexPath, err)
+			}
+			continue
+		}
+
+		glog.V(3).Infof("last reference to deduplicated chunk %s is gone", fileId)
+		if err = f.store.DeleteEntry(ctx, indexPath); err != nil {
+			glog.Errorf("delete dedup index %s: %v", indexPath, err)
+			continue
+		}
+		f.recentlyFreedChunks.Set(fileId, true, 10*time.Minute)
+		toDelete = append(toDelete, chunk)
+	}
+
+	return
+}
+
+func dedupRefCount(indexEntry *Entry) int64 {
+	if indexEntry.Extended == nil {
+		indexEntry.Extended = make(map[string][]byte)
+	}
+	b := indexEntry.Extended[dedupRefCountKey]
+	if len(b) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func dedupRefCountBytes(refCount int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(refCount))
+	return b
+}
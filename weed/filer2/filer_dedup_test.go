@@ -0,0 +1,70 @@
+package filer2
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestIsDedupEnabled(t *testing.T) {
+
+	f := &Filer{}
+	if f.IsDedupEnabled("/a/b", "") {
+		t.Errorf("dedup should be disabled by default")
+	}
+
+	f.dedup = &DedupOption{Enabled: true}
+	if !f.IsDedupEnabled("/a/b", "") {
+		t.Errorf("dedup should apply to everything")
+	}
+
+	f.dedup = &DedupOption{
+		Enabled:     true,
+		Directories: []string{"/artifacts/"},
+		Collections: []string{"builds"},
+	}
+
+	tests := []struct {
+		path       FullPath
+		collection string
+		expected   bool
+	}{
+		{"/artifacts/x.tar", "", true},
+		{"/artifacts", "", true},
+		{"/artifacts2/x.tar", "", false},
+		{"/other/x.tar", "builds", true},
+		{"/other/x.tar", "", false},
+	}
+	for _, test := range tests {
+		if f.IsDedupEnabled(test.path, test.collection) != test.expected {
+			t.Errorf("dedup %s in collection %q: expected %v", test.path, test.collection, test.expected)
+		}
+	}
+
+}
+
+func TestDedupChunkHash(t *testing.T) {
+	data := []byte("some chunk content")
+	if DedupChunkHash("", data) != DedupChunkHash("", data) {
+		t.Errorf("hash should be stable")
+	}
+	if DedupChunkHash("a", data) == DedupChunkHash("b", data) {
+		t.Errorf("chunks should not be shared across collections")
+	}
+}
+
+func TestDedupChunkDelta(t *testing.T) {
+	chunk := func(fileId string, hash string) *filer_pb.FileChunk {
+		return &filer_pb.FileChunk{FileId: fileId, ContentHash: hash}
+	}
+	oldChunks := []*filer_pb.FileChunk{chunk("1,a", "x"), chunk("1,b", "y"), chunk("1,b", "y"), chunk("1,c", "")}
+	newChunks := []*filer_pb.FileChunk{chunk("1,a", "x"), chunk("1,a", "x"), chunk("1,a", "x"), chunk("1,b", "y"), chunk("1,d", "")}
+
+	toAcquire, toRelease := dedupChunkDelta(oldChunks, newChunks)
+	if len(toAcquire) != 2 || toAcquire[0].FileId != "1,a" || toAcquire[1].FileId != "1,a" {
+		t.Errorf("acquire %v, expected 1,a twice", toAcquire)
+	}
+	if len(toRelease) != 1 || toRelease[0].FileId != "1,b" {
+		t.Errorf("release %v, expected 1,b once", toRelease)
+	}
+}
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"context"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -51,7 +52,10 @@ func (f *Filer) loopProcessingDeletion() {
 	}
 }
 
+// DeleteChunks deletes chunks no longer referenced by the entry at fullpath.
+// Deduplicated chunks are only deleted when their last reference is gone.
 func (f *Filer) DeleteChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
+	chunks = f.releaseDedupChunks(context.Background(), chunks)
 	for _, chunk := range chunks {
 		glog.V(3).Infof("deleting %s chunk %s", fullpath, chunk.String())
 		f.fileIdDeletionChan <- chunk.GetFileIdString()
@@ -79,7 +83,8 @@ func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
 		newChunkIds[newChunk.GetFileIdString()] = true
 	}
 
-	for _, oldChunk := range oldEntry.Chunks {
+	// the deduplicated chunks are released in UpdateEntry
+	for _, oldChunk := range NonDedupChunks(oldEntry.Chunks) {
 		if _, found := newChunkIds[oldChunk.GetFileIdString()]; !found {
 			toDelete = append(toDelete, oldChunk)
 		}
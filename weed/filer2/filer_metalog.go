@@ -0,0 +1,338 @@
+package filer2
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// the recent changes kept without a log directory
+	metaLogMemoryEvents = 10000
+	// the days of the log files kept in the log directory
+	metaLogRetentionDays = 7
+	metaLogFileSuffix    = ".log"
+	metaLogReadBatch     = 1024
+)
+
+// ErrMetaLogTruncated is returned when the changes since the requested time are no longer in the log
+var ErrMetaLogTruncated = errors.New("metadata log is truncated")
+
+// MetaLog keeps the recent metadata changes, so the subscribers can follow the changes since a point in time.
+// Without a directory, the changes are kept in memory. With a directory, the changes are appended to one log file per day,
+// and survive the filer restarts.
+type MetaLog struct {
+	dir string
+
+	sync.Mutex
+	lastTsNs int64
+	// the log has all the changes after this time
+	startTsNs int64
+	// closed and replaced when a change is appended
+	appended chan struct{}
+
+	// the changes in memory, without a directory
+	events []*filer_pb.SubscribeMetadataResponse
+
+	// the current log file, with a directory
+	file     *os.File
+	fileName string
+}
+
+func NewMetaLog(dir string) (*MetaLog, error) {
+	l := &MetaLog{
+		dir:       dir,
+		startTsNs: time.Now().UnixNano(),
+		appended:  make(chan struct{}),
+	}
+	l.lastTsNs = l.startTsNs
+	if dir == "" {
+		return l, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := l.pruneLogFiles(time.Now()); err != nil {
+		return nil, err
+	}
+	names, err := l.listLogFiles()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) > 0 {
+		// the oldest change kept, and the last change to continue with
+		if _, err = readMetaLogFile(filepath.Join(dir, names[0]), 0, func(event *filer_pb.SubscribeMetadataResponse) bool {
+			l.startTsNs = event.TsNs - 1
+			return false
+		}); err != nil {
+			return nil, err
+		}
+		if _, err = readMetaLogFile(filepath.Join(dir, names[len(names)-1]), 0, func(event *filer_pb.SubscribeMetadataResponse) bool {
+			l.lastTsNs = event.TsNs
+			return true
+		}); err != nil {
+			return nil, err
+		}
+		if l.lastTsNs < l.startTsNs {
+			l.lastTsNs = l.startTsNs
+		}
+	}
+	return l, nil
+}
+
+// Append adds the change to the log, with a timestamp later than all the previous changes
+func (l *MetaLog) Append(directory string, eventNotification *filer_pb.EventNotification) {
+	l.Lock()
+	defer l.Unlock()
+
+	tsNs := time.Now().UnixNano()
+	if tsNs <= l.lastTsNs {
+		tsNs = l.lastTsNs + 1
+	}
+	event := &filer_pb.SubscribeMetadataResponse{
+		Directory:         directory,
+		EventNotification: eventNotification,
+		TsNs:              tsNs,
+	}
+
+	if l.dir == "" {
+		l.events = append(l.events, event)
+		if len(l.events) > metaLogMemoryEvents {
+			dropped := len(l.events) - metaLogMemoryEvents
+			l.startTsNs = l.events[dropped-1].TsNs
+			l.events = append(l.events[:0:0], l.events[dropped:]...)
+		}
+	} else if err := l.appendToFile(event); err != nil {
+		glog.Errorf("append metadata log %s: %v", l.fileName, err)
+		return
+	}
+
+	l.lastTsNs = tsNs
+	close(l.appended)
+	l.appended = make(chan struct{})
+}
+
+func (l *MetaLog) appendToFile(event *filer_pb.SubscribeMetadataResponse) error {
+	now := time.Unix(0, event.TsNs).UTC()
+	fileName := now.Format("2006-01-02") + metaLogFileSuffix
+	if fileName != l.fileName {
+		if l.file != nil {
+			l.file.Close()
+			l.file = nil
+		}
+		if err := l.pruneLogFiles(now); err != nil {
+			glog.Errorf("prune metadata logs in %s: %v", l.dir, err)
+		}
+		file, err := os.OpenFile(filepath.Join(l.dir, fileName), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		l.file, l.fileName = file, fileName
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+	_, err = l.file.Write(record)
+	return err
+}
+
+// Subscribe calls fn with each change after sinceNs, and waits for the new changes until the context is done.
+// A zero sinceNs starts from the oldest change in the log.
+func (l *MetaLog) Subscribe(ctx context.Context, sinceNs int64, fn func(event *filer_pb.SubscribeMetadataResponse) error) error {
+
+	var position metaLogPosition
+	for {
+		l.Lock()
+		startTsNs, lastTsNs, appended := l.startTsNs, l.lastTsNs, l.appended
+		l.Unlock()
+
+		if sinceNs != 0 && sinceNs < startTsNs {
+			glog.V(0).Infof("the metadata changes since %d are requested, but the log starts from %d", sinceNs, startTsNs)
+			return ErrMetaLogTruncated
+		}
+
+		if sinceNs < lastTsNs {
+			events, err := l.read(&position, sinceNs)
+			if err != nil {
+				return err
+			}
+			for _, event := range events {
+				if err = fn(event); err != nil {
+					return err
+				}
+				sinceNs = event.TsNs
+			}
+			if len(events) > 0 {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-appended:
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// metaLogPosition is where a subscriber reads the log files next
+type metaLogPosition struct {
+	fileName string
+	offset   int64
+}
+
+// read returns the next batch of the changes after sinceNs
+func (l *MetaLog) read(position *metaLogPosition, sinceNs int64) (events []*filer_pb.SubscribeMetadataResponse, err error) {
+
+	if l.dir == "" {
+		l.Lock()
+		defer l.Unlock()
+		i := sort.Search(len(l.events), func(i int) bool {
+			return l.events[i].TsNs > sinceNs
+		})
+		for ; i < len(l.events) && len(events) < metaLogReadBatch; i++ {
+			events = append(events, l.events[i])
+		}
+		return events, nil
+	}
+
+	names, err := l.listLogFiles()
+	if err != nil {
+		return nil, err
+	}
+	if position.fileName == "" {
+		// start from the log file of the day of sinceNs
+		sinceName := time.Unix(0, sinceNs).UTC().Format("2006-01-02") + metaLogFileSuffix
+		for _, name := range names {
+			if name >= sinceName {
+				position.fileName = name
+				break
+			}
+		}
+	}
+
+	for _, name := range names {
+		if name < position.fileName {
+			continue
+		}
+		if name > position.fileName {
+			position.fileName, position.offset = name, 0
+		}
+		position.offset, err = readMetaLogFile(filepath.Join(l.dir, name), position.offset, func(event *filer_pb.SubscribeMetadataResponse) bool {
+			if event.TsNs > sinceNs {
+				events = append(events, event)
+			}
+			return len(events) < metaLogReadBatch
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 {
+			return events, nil
+		}
+	}
+	return nil, nil
+}
+
+// readMetaLogFile reads the complete changes from the offset until fn returns false,
+// and returns the offset after the last change read
+func readMetaLogFile(fileName string, offset int64, fn func(event *filer_pb.SubscribeMetadataResponse) bool) (int64, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return offset, nil
+		}
+		return offset, err
+	}
+	defer file.Close()
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return offset, err
+	}
+	reader := bufio.NewReader(file)
+
+	sizeBuf := make([]byte, 4)
+	for {
+		// an incomplete change is still being written
+		if _, err = io.ReadFull(reader, sizeBuf); err != nil {
+			break
+		}
+		data := make([]byte, binary.BigEndian.Uint32(sizeBuf))
+		if _, err = io.ReadFull(reader, data); err != nil {
+			break
+		}
+		event := &filer_pb.SubscribeMetadataResponse{}
+		if err = proto.Unmarshal(data, event); err != nil {
+			return offset, fmt.Errorf("read %s at offset %d: %v", fileName, offset, err)
+		}
+		offset += int64(4 + len(data))
+		if !fn(event) {
+			break
+		}
+	}
+	return offset, nil
+}
+
+func (l *MetaLog) listLogFiles() (names []string, err error) {
+	fileInfos, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileInfo := range fileInfos {
+		if !fileInfo.IsDir() && strings.HasSuffix(fileInfo.Name(), metaLogFileSuffix) {
+			names = append(names, fileInfo.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// pruneLogFiles removes the log files older than the retention days, and moves the start of the log
+func (l *MetaLog) pruneLogFiles(now time.Time) error {
+	names, err := l.listLogFiles()
+	if err != nil {
+		return err
+	}
+	oldestName := now.UTC().AddDate(0, 0, -metaLogRetentionDays).Format("2006-01-02") + metaLogFileSuffix
+	for _, name := range names {
+		if name >= oldestName {
+			break
+		}
+		var lastTsNs int64
+		if _, err = readMetaLogFile(filepath.Join(l.dir, name), 0, func(event *filer_pb.SubscribeMetadataResponse) bool {
+			lastTsNs = event.TsNs
+			return true
+		}); err != nil {
+			return err
+		}
+		if err = os.Remove(filepath.Join(l.dir, name)); err != nil {
+			return err
+		}
+		if lastTsNs > l.startTsNs {
+			l.startTsNs = lastTsNs
+		}
+		glog.V(0).Infof("removed metadata log %s", name)
+	}
+	return nil
+}
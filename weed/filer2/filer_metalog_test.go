@@ -0,0 +1,105 @@
+package filer2
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func appendMetaLogEvents(l *MetaLog, names ...string) {
+	for _, name := range names {
+		l.Append("/dir", &filer_pb.EventNotification{
+			NewEntry: &filer_pb.Entry{Name: name},
+		})
+	}
+}
+
+// subscribeMetaLog returns the names of the count changes after sinceNs
+func subscribeMetaLog(t *testing.T, l *MetaLog, sinceNs int64, count int) (names []string, tsNs []int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := l.Subscribe(ctx, sinceNs, func(event *filer_pb.SubscribeMetadataResponse) error {
+		names = append(names, event.EventNotification.NewEntry.Name)
+		tsNs = append(tsNs, event.TsNs)
+		if len(names) == count {
+			cancel()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("subscribe since %d: %v", sinceNs, err)
+	}
+	return
+}
+
+func TestMetaLogInMemory(t *testing.T) {
+	l, err := NewMetaLog("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	startTsNs := l.startTsNs
+
+	appendMetaLogEvents(l, "a", "b", "c")
+
+	names, tsNs := subscribeMetaLog(t, l, 0, 3)
+	if len(names) != 3 || names[0] != "a" || names[2] != "c" {
+		t.Fatalf("subscribe all: %v", names)
+	}
+	if tsNs[0] >= tsNs[1] || tsNs[1] >= tsNs[2] {
+		t.Errorf("timestamps should increase: %v", tsNs)
+	}
+
+	names, _ = subscribeMetaLog(t, l, tsNs[0], 2)
+	if len(names) != 2 || names[0] != "b" {
+		t.Errorf("subscribe since the first change: %v", names)
+	}
+
+	// the oldest changes are dropped
+	for i := 0; i < metaLogMemoryEvents; i++ {
+		appendMetaLogEvents(l, "x")
+	}
+	if err = l.Subscribe(context.Background(), startTsNs, nil); err != ErrMetaLogTruncated {
+		t.Errorf("subscribe since dropped changes: %v", err)
+	}
+}
+
+func TestMetaLogInDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metalog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l, err := NewMetaLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendMetaLogEvents(l, "a", "b", "c")
+	_, tsNs := subscribeMetaLog(t, l, 0, 3)
+	l.file.Close()
+
+	// the changes are kept after restart
+	reloaded, err := NewMetaLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.lastTsNs != tsNs[2] {
+		t.Errorf("reloaded last change %d, expected %d", reloaded.lastTsNs, tsNs[2])
+	}
+	if err = reloaded.Subscribe(context.Background(), tsNs[0]-1000, nil); err != ErrMetaLogTruncated {
+		t.Errorf("subscribe before the log: %v", err)
+	}
+
+	appendMetaLogEvents(reloaded, "d")
+	names, newTsNs := subscribeMetaLog(t, reloaded, tsNs[0], 3)
+	if len(names) != 3 || names[0] != "b" || names[2] != "d" {
+		t.Errorf("subscribe after restart: %v", names)
+	}
+	if newTsNs[2] <= tsNs[2] {
+		t.Errorf("the new change %d should be after the old changes %d", newTsNs[2], tsNs[2])
+	}
+	reloaded.file.Close()
+}
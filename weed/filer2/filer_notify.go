@@ -1,12 +1,29 @@
 package filer2
 
 import (
+	"context"
+
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/notification"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 )
 
-func (f *Filer) NotifyUpdateEvent(oldEntry, newEntry *Entry, deleteChunks bool) {
+type originsKey struct{}
+
+// WithOrigins attaches the clusters the change came from, which are recorded in the change notifications
+func WithOrigins(ctx context.Context, origins []string) context.Context {
+	if len(origins) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, originsKey{}, origins)
+}
+
+func originsFromContext(ctx context.Context) []string {
+	origins, _ := ctx.Value(originsKey{}).([]string)
+	return origins
+}
+
+func (f *Filer) NotifyUpdateEvent(ctx context.Context, oldEntry, newEntry *Entry, deleteChunks bool) {
 	var key string
 	if oldEntry != nil {
 		key = string(oldEntry.FullPath)
@@ -16,24 +33,33 @@ func (f *Filer) NotifyUpdateEvent(oldEntry, newEntry *Entry, deleteChunks bool)
 		return
 	}
 
+	if notification.Queue == nil && f.MetaLog == nil {
+		return
+	}
+
+	newParentPath := ""
+	if newEntry != nil {
+		newParentPath, _ = newEntry.FullPath.DirAndName()
+	}
+
+	eventNotification := &filer_pb.EventNotification{
+		OldEntry:      oldEntry.ToProtoEntry(),
+		NewEntry:      newEntry.ToProtoEntry(),
+		DeleteChunks:  deleteChunks,
+		NewParentPath: newParentPath,
+		Origins:       originsFromContext(ctx),
+	}
+
 	if notification.Queue != nil {
 
 		glog.V(3).Infof("notifying entry update %v", key)
 
-		newParentPath := ""
-		if newEntry != nil {
-			newParentPath, _ = newEntry.FullPath.DirAndName()
-		}
-
-		notification.Queue.SendMessage(
-			key,
-			&filer_pb.EventNotification{
-				OldEntry:      oldEntry.ToProtoEntry(),
-				NewEntry:      newEntry.ToProtoEntry(),
-				DeleteChunks:  deleteChunks,
-				NewParentPath: newParentPath,
-			},
-		)
+		notification.Queue.SendMessage(key, eventNotification)
+
+	}
 
+	if f.MetaLog != nil {
+		directory, _ := FullPath(key).DirAndName()
+		f.MetaLog.Append(directory, eventNotification)
 	}
 }
@@ -0,0 +1,154 @@
+package memdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestDedupChunkReferences(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	hash := filer2.DedupChunkHash("", []byte("same content"))
+
+	newEntry := func(p string) *filer2.Entry {
+		return &filer2.Entry{
+			FullPath: filer2.FullPath(p),
+			Attr: filer2.Attr{
+				Mode: 0660,
+			},
+			Chunks: []*filer_pb.FileChunk{{
+				FileId:      "3,01637037d6",
+				Size:        12,
+				ContentHash: hash,
+			}},
+		}
+	}
+
+	if filer.FindDedupChunk(ctx, hash) != nil {
+		t.Fatalf("chunk should not be indexed yet")
+	}
+
+	if err := filer.CreateEntry(ctx, newEntry("/a/file1")); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+	if err := filer.CreateEntry(ctx, newEntry("/b/file2")); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+
+	chunk := filer.FindDedupChunk(ctx, hash)
+	if chunk == nil || chunk.FileId != "3,01637037d6" {
+		t.Fatalf("chunk should be indexed: %+v", chunk)
+	}
+
+	// renaming keeps the chunk referenced
+	if err := filer.CreateEntry(ctx, newEntry("/b/file3")); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+	filer.DeleteEntryMetaAndData(ctx, "/b/file2", false, false)
+
+	filer.DeleteEntryMetaAndData(ctx, "/a/file1", false, true)
+	if filer.FindDedupChunk(ctx, hash) == nil {
+		t.Fatalf("chunk is still referenced by /b/file3")
+	}
+
+	filer.DeleteEntryMetaAndData(ctx, "/b/file3", false, true)
+	if filer.FindDedupChunk(ctx, hash) != nil {
+		t.Fatalf("chunk should be freed after the last reference is gone")
+	}
+
+}
+
+func TestDedupRepeatedChunkReferences(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	hash := filer2.DedupChunkHash("", []byte("repeated content"))
+	fileId := "3,01637037d6"
+
+	newEntry := func(p string, count int) *filer2.Entry {
+		entry := &filer2.Entry{
+			FullPath: filer2.FullPath(p),
+			Attr: filer2.Attr{
+				Mode: 0660,
+			},
+		}
+		for i := 0; i < count; i++ {
+			entry.Chunks = append(entry.Chunks, &filer_pb.FileChunk{
+				FileId:      fileId,
+				Offset:      int64(i) * 12,
+				Size:        12,
+				ContentHash: hash,
+			})
+		}
+		return entry
+	}
+
+	if err := filer.CreateEntry(ctx, newEntry("/a/file1", 1)); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+	if err := filer.CreateEntry(ctx, newEntry("/b/file2", 1)); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+
+	// the updated file references the same chunk twice
+	if err := filer.CreateEntry(ctx, newEntry("/a/file1", 2)); err != nil {
+		t.Fatalf("update entry: %v", err)
+	}
+	filer.DeleteEntryMetaAndData(ctx, "/a/file1", false, true)
+	if filer.FindDedupChunk(ctx, hash) == nil {
+		t.Fatalf("chunk is still referenced by /b/file2")
+	}
+
+	// the updated file references the same chunk once, instead of twice
+	if err := filer.CreateEntry(ctx, newEntry("/c/file3", 2)); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+	if err := filer.CreateEntry(ctx, newEntry("/c/file3", 1)); err != nil {
+		t.Fatalf("update entry: %v", err)
+	}
+	filer.DeleteEntryMetaAndData(ctx, "/b/file2", false, true)
+	if filer.FindDedupChunk(ctx, hash) == nil {
+		t.Fatalf("chunk is still referenced by /c/file3")
+	}
+	filer.DeleteEntryMetaAndData(ctx, "/c/file3", false, true)
+	if filer.FindDedupChunk(ctx, hash) != nil {
+		t.Fatalf("chunk should be freed after the last reference is gone")
+	}
+
+	// a pinned chunk is not freed before the entry using it is saved
+	fileId = "3,02637037d6"
+	if err := filer.CreateEntry(ctx, newEntry("/d/file4", 1)); err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+	pinned := filer.PinDedupChunk(ctx, hash)
+	if pinned == nil {
+		t.Fatalf("chunk should be pinned")
+	}
+	filer.DeleteEntryMetaAndData(ctx, "/d/file4", false, true)
+	if err := filer.CreateEntry(ctx, newEntry("/e/file5", 1)); err != nil {
+		t.Fatalf("create entry with the pinned chunk: %v", err)
+	}
+	filer.DeleteChunks("/e/file5", []*filer_pb.FileChunk{pinned})
+	if filer.FindDedupChunk(ctx, hash) == nil {
+		t.Fatalf("chunk is still referenced by /e/file5")
+	}
+	filer.DeleteEntryMetaAndData(ctx, "/e/file5", false, true)
+	if filer.PinDedupChunk(ctx, hash) != nil {
+		t.Fatalf("a freed chunk should not be pinned, and should be uploaded again")
+	}
+
+}
@@ -10,7 +10,10 @@ import (
 	"google.golang.org/grpc"
 )
 
+// deleteFileChunks deletes the chunks from the volume servers.
+// The deduplicated chunks are skipped, since other files may use them, and the filer releases them.
 func (wfs *WFS) deleteFileChunks(ctx context.Context, chunks []*filer_pb.FileChunk) {
+	chunks = filer2.NonDedupChunks(chunks)
 	if len(chunks) == 0 {
 		return
 	}
@@ -18,6 +18,9 @@ type VolumeAssignRequest struct {
 	DataCenter  string
 	Rack        string
 	DataNode    string
+
+	RequiredLabels     map[string]string
+	AntiAffinityLabels []string
 }
 
 type AssignResult struct {
@@ -53,6 +56,9 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 				DataCenter:  primaryRequest.DataCenter,
 				Rack:        primaryRequest.Rack,
 				DataNode:    primaryRequest.DataNode,
+
+				RequiredLabels:     primaryRequest.RequiredLabels,
+				AntiAffinityLabels: primaryRequest.AntiAffinityLabels,
 			}
 			resp, grpcErr := masterClient.Assign(context.Background(), req)
 			if grpcErr != nil {
@@ -39,6 +39,8 @@ It has these top-level messages:
 	StatisticsResponse
 	GetFilerConfigurationRequest
 	GetFilerConfigurationResponse
+	SubscribeMetadataRequest
+	SubscribeMetadataResponse
 */
 package filer_pb
 
@@ -239,10 +241,11 @@ func (m *FullEntry) GetEntry() *Entry {
 }
 
 type EventNotification struct {
-	OldEntry      *Entry `protobuf:"bytes,1,opt,name=old_entry,json=oldEntry" json:"old_entry,omitempty"`
-	NewEntry      *Entry `protobuf:"bytes,2,opt,name=new_entry,json=newEntry" json:"new_entry,omitempty"`
-	DeleteChunks  bool   `protobuf:"varint,3,opt,name=delete_chunks,json=deleteChunks" json:"delete_chunks,omitempty"`
-	NewParentPath string `protobuf:"bytes,4,opt,name=new_parent_path,json=newParentPath" json:"new_parent_path,omitempty"`
+	OldEntry      *Entry   `protobuf:"bytes,1,opt,name=old_entry,json=oldEntry" json:"old_entry,omitempty"`
+	NewEntry      *Entry   `protobuf:"bytes,2,opt,name=new_entry,json=newEntry" json:"new_entry,omitempty"`
+	DeleteChunks  bool     `protobuf:"varint,3,opt,name=delete_chunks,json=deleteChunks" json:"delete_chunks,omitempty"`
+	NewParentPath string   `protobuf:"bytes,4,opt,name=new_parent_path,json=newParentPath" json:"new_parent_path,omitempty"`
+	Origins       []string `protobuf:"bytes,5,rep,name=origins" json:"origins,omitempty"`
 }
 
 func (m *EventNotification) Reset()                    { *m = EventNotification{} }
@@ -278,6 +281,13 @@ func (m *EventNotification) GetNewParentPath() string {
 	return ""
 }
 
+func (m *EventNotification) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
 type FileChunk struct {
 	FileId       string  `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
 	Offset       int64   `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
@@ -287,6 +297,7 @@ type FileChunk struct {
 	SourceFileId string  `protobuf:"bytes,6,opt,name=source_file_id,json=sourceFileId" json:"source_file_id,omitempty"`
 	Fid          *FileId `protobuf:"bytes,7,opt,name=fid" json:"fid,omitempty"`
 	SourceFid    *FileId `protobuf:"bytes,8,opt,name=source_fid,json=sourceFid" json:"source_fid,omitempty"`
+	ContentHash  string  `protobuf:"bytes,9,opt,name=content_hash,json=contentHash" json:"content_hash,omitempty"`
 }
 
 func (m *FileChunk) Reset()                    { *m = FileChunk{} }
@@ -350,6 +361,13 @@ func (m *FileChunk) GetSourceFid() *FileId {
 	return nil
 }
 
+func (m *FileChunk) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 type FileId struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	FileKey  uint64 `protobuf:"varint,2,opt,name=file_key,json=fileKey" json:"file_key,omitempty"`
@@ -497,6 +515,8 @@ func (m *FuseAttributes) GetSymlinkTarget() string {
 type CreateEntryRequest struct {
 	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
 	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	// the clusters the change came from, so filer.sync does not send the change back
+	Origins []string `protobuf:"bytes,3,rep,name=origins" json:"origins,omitempty"`
 }
 
 func (m *CreateEntryRequest) Reset()                    { *m = CreateEntryRequest{} }
@@ -518,6 +538,13 @@ func (m *CreateEntryRequest) GetEntry() *Entry {
 	return nil
 }
 
+func (m *CreateEntryRequest) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
 type CreateEntryResponse struct {
 }
 
@@ -529,6 +556,8 @@ func (*CreateEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor
 type UpdateEntryRequest struct {
 	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
 	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	// the clusters the change came from, so filer.sync does not send the change back
+	Origins []string `protobuf:"bytes,3,rep,name=origins" json:"origins,omitempty"`
 }
 
 func (m *UpdateEntryRequest) Reset()                    { *m = UpdateEntryRequest{} }
@@ -550,6 +579,13 @@ func (m *UpdateEntryRequest) GetEntry() *Entry {
 	return nil
 }
 
+func (m *UpdateEntryRequest) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
 type UpdateEntryResponse struct {
 }
 
@@ -564,6 +600,8 @@ type DeleteEntryRequest struct {
 	// bool is_directory = 3;
 	IsDeleteData bool `protobuf:"varint,4,opt,name=is_delete_data,json=isDeleteData" json:"is_delete_data,omitempty"`
 	IsRecursive  bool `protobuf:"varint,5,opt,name=is_recursive,json=isRecursive" json:"is_recursive,omitempty"`
+	// the clusters the change came from, so filer.sync does not send the change back
+	Origins []string `protobuf:"bytes,6,rep,name=origins" json:"origins,omitempty"`
 }
 
 func (m *DeleteEntryRequest) Reset()                    { *m = DeleteEntryRequest{} }
@@ -599,6 +637,13 @@ func (m *DeleteEntryRequest) GetIsRecursive() bool {
 	return false
 }
 
+func (m *DeleteEntryRequest) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
 type DeleteEntryResponse struct {
 }
 
@@ -983,6 +1028,71 @@ func (m *GetFilerConfigurationResponse) GetMaxMb() uint32 {
 	return 0
 }
 
+type SubscribeMetadataRequest struct {
+	ClientName string `protobuf:"bytes,1,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
+	PathPrefix string `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix" json:"path_prefix,omitempty"`
+	// the changes after this time, in unix nanoseconds
+	SinceNs int64 `protobuf:"varint,3,opt,name=since_ns,json=sinceNs" json:"since_ns,omitempty"`
+}
+
+func (m *SubscribeMetadataRequest) Reset()                    { *m = SubscribeMetadataRequest{} }
+func (m *SubscribeMetadataRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeMetadataRequest) ProtoMessage()               {}
+func (*SubscribeMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *SubscribeMetadataRequest) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+func (m *SubscribeMetadataRequest) GetPathPrefix() string {
+	if m != nil {
+		return m.PathPrefix
+	}
+	return ""
+}
+
+func (m *SubscribeMetadataRequest) GetSinceNs() int64 {
+	if m != nil {
+		return m.SinceNs
+	}
+	return 0
+}
+
+type SubscribeMetadataResponse struct {
+	Directory         string             `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	EventNotification *EventNotification `protobuf:"bytes,2,opt,name=event_notification,json=eventNotification" json:"event_notification,omitempty"`
+	TsNs              int64              `protobuf:"varint,3,opt,name=ts_ns,json=tsNs" json:"ts_ns,omitempty"`
+}
+
+func (m *SubscribeMetadataResponse) Reset()                    { *m = SubscribeMetadataResponse{} }
+func (m *SubscribeMetadataResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeMetadataResponse) ProtoMessage()               {}
+func (*SubscribeMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *SubscribeMetadataResponse) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *SubscribeMetadataResponse) GetEventNotification() *EventNotification {
+	if m != nil {
+		return m.EventNotification
+	}
+	return nil
+}
+
+func (m *SubscribeMetadataResponse) GetTsNs() int64 {
+	if m != nil {
+		return m.TsNs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LookupDirectoryEntryRequest)(nil), "filer_pb.LookupDirectoryEntryRequest")
 	proto.RegisterType((*LookupDirectoryEntryResponse)(nil), "filer_pb.LookupDirectoryEntryResponse")
@@ -1014,6 +1124,8 @@ func init() {
 	proto.RegisterType((*StatisticsResponse)(nil), "filer_pb.StatisticsResponse")
 	proto.RegisterType((*GetFilerConfigurationRequest)(nil), "filer_pb.GetFilerConfigurationRequest")
 	proto.RegisterType((*GetFilerConfigurationResponse)(nil), "filer_pb.GetFilerConfigurationResponse")
+	proto.RegisterType((*SubscribeMetadataRequest)(nil), "filer_pb.SubscribeMetadataRequest")
+	proto.RegisterType((*SubscribeMetadataResponse)(nil), "filer_pb.SubscribeMetadataResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1038,6 +1150,8 @@ type SeaweedFilerClient interface {
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
 	GetFilerConfiguration(ctx context.Context, in *GetFilerConfigurationRequest, opts ...grpc.CallOption) (*GetFilerConfigurationResponse, error)
+	// follow the metadata changes since a point in time, used by filer.sync
+	SubscribeMetadata(ctx context.Context, in *SubscribeMetadataRequest, opts ...grpc.CallOption) (SeaweedFiler_SubscribeMetadataClient, error)
 }
 
 type seaweedFilerClient struct {
@@ -1147,6 +1261,38 @@ func (c *seaweedFilerClient) GetFilerConfiguration(ctx context.Context, in *GetF
 	return out, nil
 }
 
+func (c *seaweedFilerClient) SubscribeMetadata(ctx context.Context, in *SubscribeMetadataRequest, opts ...grpc.CallOption) (SeaweedFiler_SubscribeMetadataClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[0], c.cc, "/filer_pb.SeaweedFiler/SubscribeMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerSubscribeMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_SubscribeMetadataClient interface {
+	Recv() (*SubscribeMetadataResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerSubscribeMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerSubscribeMetadataClient) Recv() (*SubscribeMetadataResponse, error) {
+	m := new(SubscribeMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for SeaweedFiler service
 
 type SeaweedFilerServer interface {
@@ -1161,6 +1307,8 @@ type SeaweedFilerServer interface {
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
 	GetFilerConfiguration(context.Context, *GetFilerConfigurationRequest) (*GetFilerConfigurationResponse, error)
+	// follow the metadata changes since a point in time, used by filer.sync
+	SubscribeMetadata(*SubscribeMetadataRequest, SeaweedFiler_SubscribeMetadataServer) error
 }
 
 func RegisterSeaweedFilerServer(s *grpc.Server, srv SeaweedFilerServer) {
@@ -1365,6 +1513,27 @@ func _SeaweedFiler_GetFilerConfiguration_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_SubscribeMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedFilerServer).SubscribeMetadata(m, &seaweedFilerSubscribeMetadataServer{stream})
+}
+
+type SeaweedFiler_SubscribeMetadataServer interface {
+	Send(*SubscribeMetadataResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerSubscribeMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerSubscribeMetadataServer) Send(m *SubscribeMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "filer_pb.SeaweedFiler",
 	HandlerType: (*SeaweedFilerServer)(nil),
@@ -1414,111 +1583,127 @@ var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 			Handler:    _SeaweedFiler_GetFilerConfiguration_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeMetadata",
+			Handler:       _SeaweedFiler_SubscribeMetadata_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "filer.proto",
 }
 
 func init() { proto.RegisterFile("filer.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0xdb, 0x6f, 0xdc, 0x44,
-	0x17, 0xaf, 0xf7, 0xee, 0xb3, 0xbb, 0x6d, 0x32, 0x49, 0xbf, 0xba, 0x9b, 0xcb, 0x97, 0x3a, 0x5f,
-	0xfb, 0xa5, 0xa2, 0x0a, 0x55, 0xe1, 0xa1, 0xa5, 0x42, 0xa2, 0xcd, 0x05, 0x45, 0xa4, 0x17, 0x39,
-	0x2d, 0x02, 0x21, 0x61, 0x39, 0xf6, 0xec, 0x66, 0x88, 0xed, 0x59, 0xec, 0x71, 0x92, 0xf2, 0x27,
-	0xf0, 0x82, 0xc4, 0x23, 0x12, 0xcf, 0xfc, 0x13, 0x88, 0x17, 0xc4, 0xbf, 0xc3, 0x23, 0xcf, 0x68,
-	0x2e, 0xf6, 0x8e, 0xd7, 0x9b, 0xa4, 0x08, 0xf5, 0xcd, 0x73, 0xae, 0xbf, 0x73, 0xe6, 0x5c, 0x66,
-	0x17, 0xba, 0x43, 0x12, 0xe2, 0x64, 0x73, 0x9c, 0x50, 0x46, 0x51, 0x47, 0x1c, 0xdc, 0xf1, 0xa1,
-	0xfd, 0x02, 0x96, 0xf6, 0x29, 0x3d, 0xce, 0xc6, 0xdb, 0x24, 0xc1, 0x3e, 0xa3, 0xc9, 0x9b, 0x9d,
-	0x98, 0x25, 0x6f, 0x1c, 0xfc, 0x6d, 0x86, 0x53, 0x86, 0x96, 0xc1, 0x0c, 0x72, 0x86, 0x65, 0xac,
-	0x19, 0x1b, 0xa6, 0x33, 0x21, 0x20, 0x04, 0x8d, 0xd8, 0x8b, 0xb0, 0x55, 0x13, 0x0c, 0xf1, 0x6d,
-	0xef, 0xc0, 0xf2, 0x6c, 0x83, 0xe9, 0x98, 0xc6, 0x29, 0x46, 0xb7, 0xa1, 0x89, 0x39, 0x41, 0x58,
-	0xeb, 0x3e, 0xb8, 0xb6, 0x99, 0x43, 0xd9, 0x94, 0x72, 0x92, 0x6b, 0xff, 0x66, 0x00, 0xda, 0x27,
-	0x29, 0xe3, 0x44, 0x82, 0xd3, 0xb7, 0xc3, 0xf3, 0x1f, 0x68, 0x8d, 0x13, 0x3c, 0x24, 0x67, 0x0a,
-	0x91, 0x3a, 0xa1, 0x7b, 0x30, 0x9f, 0x32, 0x2f, 0x61, 0xbb, 0x09, 0x8d, 0x76, 0x49, 0x88, 0x9f,
-	0x73, 0xd0, 0x75, 0x21, 0x52, 0x65, 0xa0, 0x4d, 0x40, 0x24, 0xf6, 0xc3, 0x2c, 0x25, 0x27, 0xf8,
-	0x20, 0xe7, 0x5a, 0x8d, 0x35, 0x63, 0xa3, 0xe3, 0xcc, 0xe0, 0xa0, 0x45, 0x68, 0x86, 0x24, 0x22,
-	0xcc, 0x6a, 0xae, 0x19, 0x1b, 0x7d, 0x47, 0x1e, 0xec, 0x4f, 0x60, 0xa1, 0x84, 0x5f, 0x85, 0x7f,
-	0x17, 0xda, 0x58, 0x92, 0x2c, 0x63, 0xad, 0x3e, 0x2b, 0x01, 0x39, 0xdf, 0xfe, 0xb9, 0x06, 0x4d,
-	0x41, 0x2a, 0xf2, 0x6c, 0x4c, 0xf2, 0x8c, 0x6e, 0x41, 0x8f, 0xa4, 0xee, 0x24, 0x19, 0x35, 0x81,
-	0xaf, 0x4b, 0xd2, 0x22, 0xef, 0xe8, 0x3d, 0x68, 0xf9, 0x47, 0x59, 0x7c, 0x9c, 0x5a, 0x75, 0xe1,
-	0x6a, 0x61, 0xe2, 0x8a, 0x07, 0xbb, 0xc5, 0x79, 0x8e, 0x12, 0x41, 0x0f, 0x01, 0x3c, 0xc6, 0x12,
-	0x72, 0x98, 0x31, 0x9c, 0x8a, 0x68, 0xbb, 0x0f, 0x2c, 0x4d, 0x21, 0x4b, 0xf1, 0x93, 0x82, 0xef,
-	0x68, 0xb2, 0xe8, 0x11, 0x74, 0xf0, 0x19, 0xc3, 0x71, 0x80, 0x03, 0xab, 0x29, 0x1c, 0xad, 0x4c,
-	0xc5, 0xb4, 0xb9, 0xa3, 0xf8, 0x32, 0xc2, 0x42, 0x7c, 0xf0, 0x18, 0xfa, 0x25, 0x16, 0x9a, 0x83,
-	0xfa, 0x31, 0xce, 0x6f, 0x96, 0x7f, 0xf2, 0xec, 0x9e, 0x78, 0x61, 0x26, 0x8b, 0xac, 0xe7, 0xc8,
-	0xc3, 0x47, 0xb5, 0x87, 0x86, 0xbd, 0x0d, 0xe6, 0x6e, 0x16, 0x86, 0x85, 0x62, 0x40, 0x92, 0x5c,
-	0x31, 0x20, 0xc9, 0xa4, 0xd0, 0x6a, 0x17, 0x16, 0xda, 0xaf, 0x06, 0xcc, 0xef, 0x9c, 0xe0, 0x98,
-	0x3d, 0xa7, 0x8c, 0x0c, 0x89, 0xef, 0x31, 0x42, 0x63, 0x74, 0x0f, 0x4c, 0x1a, 0x06, 0xee, 0x85,
-	0x95, 0xda, 0xa1, 0xa1, 0x42, 0x7d, 0x0f, 0xcc, 0x18, 0x9f, 0xba, 0x17, 0xba, 0xeb, 0xc4, 0xf8,
-	0x54, 0x4a, 0xaf, 0x43, 0x3f, 0xc0, 0x21, 0x66, 0xd8, 0x2d, 0x6e, 0x87, 0x5f, 0x5d, 0x4f, 0x12,
-	0xb7, 0xe4, 0x75, 0xdc, 0x81, 0x6b, 0xdc, 0xe4, 0xd8, 0x4b, 0x70, 0xcc, 0xdc, 0xb1, 0xc7, 0x8e,
-	0xc4, 0x9d, 0x98, 0x4e, 0x3f, 0xc6, 0xa7, 0x2f, 0x05, 0xf5, 0xa5, 0xc7, 0x8e, 0xec, 0xbf, 0x0c,
-	0x30, 0x8b, 0xcb, 0x44, 0x37, 0xa0, 0xcd, 0xdd, 0xba, 0x24, 0x50, 0x99, 0x68, 0xf1, 0xe3, 0x5e,
-	0xc0, 0x3b, 0x83, 0x0e, 0x87, 0x29, 0x66, 0x02, 0x5e, 0xdd, 0x51, 0x27, 0x5e, 0x59, 0x29, 0xf9,
-	0x4e, 0x36, 0x43, 0xc3, 0x11, 0xdf, 0x3c, 0xe3, 0x11, 0x23, 0x11, 0x16, 0x0e, 0xeb, 0x8e, 0x3c,
-	0xa0, 0x05, 0x68, 0x62, 0x97, 0x79, 0x23, 0x51, 0xe5, 0xa6, 0xd3, 0xc0, 0xaf, 0xbc, 0x11, 0xfa,
-	0x1f, 0x5c, 0x4d, 0x69, 0x96, 0xf8, 0xd8, 0xcd, 0xdd, 0xb6, 0x04, 0xb7, 0x27, 0xa9, 0xbb, 0xd2,
-	0xb9, 0x0d, 0xf5, 0x21, 0x09, 0xac, 0xb6, 0x48, 0xcc, 0x5c, 0xb9, 0x08, 0xf7, 0x02, 0x87, 0x33,
-	0xd1, 0xfb, 0x00, 0x85, 0xa5, 0xc0, 0xea, 0x9c, 0x23, 0x6a, 0xe6, 0x76, 0x03, 0xfb, 0x0b, 0x68,
-	0x29, 0xf3, 0x4b, 0x60, 0x9e, 0xd0, 0x30, 0x8b, 0x8a, 0xb0, 0xfb, 0x4e, 0x47, 0x12, 0xf6, 0x02,
-	0x74, 0x13, 0xc4, 0xac, 0x73, 0x79, 0x55, 0xd5, 0x44, 0x90, 0x22, 0x43, 0x9f, 0x61, 0x31, 0x2d,
-	0x7c, 0x4a, 0x8f, 0x89, 0x8c, 0xbe, 0xed, 0xa8, 0x93, 0xfd, 0x67, 0x0d, 0xae, 0x96, 0xcb, 0x9d,
-	0xbb, 0x10, 0x56, 0x44, 0xae, 0x0c, 0x61, 0x46, 0x98, 0x3d, 0x28, 0xe5, 0xab, 0xa6, 0xe7, 0x2b,
-	0x57, 0x89, 0x68, 0x20, 0x1d, 0xf4, 0xa5, 0xca, 0x33, 0x1a, 0x60, 0x5e, 0xad, 0x19, 0x09, 0x44,
-	0x82, 0xfb, 0x0e, 0xff, 0xe4, 0x94, 0x11, 0x09, 0xd4, 0x08, 0xe1, 0x9f, 0x02, 0x5e, 0x22, 0xec,
-	0xb6, 0xe4, 0x95, 0xc9, 0x13, 0xbf, 0xb2, 0x88, 0x53, 0xdb, 0xf2, 0x1e, 0xf8, 0x37, 0x5a, 0x83,
-	0x6e, 0x82, 0xc7, 0xa1, 0xaa, 0x5e, 0x91, 0x3e, 0xd3, 0xd1, 0x49, 0x68, 0x15, 0xc0, 0xa7, 0x61,
-	0x88, 0x7d, 0x21, 0x60, 0x0a, 0x01, 0x8d, 0xc2, 0x2b, 0x87, 0xb1, 0xd0, 0x4d, 0xb1, 0x6f, 0xc1,
-	0x9a, 0xb1, 0xd1, 0x74, 0x5a, 0x8c, 0x85, 0x07, 0xd8, 0xe7, 0x71, 0x64, 0x29, 0x4e, 0x5c, 0x31,
-	0x80, 0xba, 0x42, 0xaf, 0xc3, 0x09, 0x62, 0x54, 0xae, 0x00, 0x8c, 0x12, 0x9a, 0x8d, 0x25, 0xb7,
-	0xb7, 0x56, 0xe7, 0xf3, 0x58, 0x50, 0x04, 0xfb, 0x36, 0x5c, 0x4d, 0xdf, 0x44, 0x21, 0x89, 0x8f,
-	0x5d, 0xe6, 0x25, 0x23, 0xcc, 0xac, 0xbe, 0xac, 0x61, 0x45, 0x7d, 0x25, 0x88, 0xf6, 0x97, 0x80,
-	0xb6, 0x12, 0xec, 0x31, 0xfc, 0x0f, 0x56, 0xcf, 0x5b, 0x76, 0xf7, 0x75, 0x58, 0x28, 0x99, 0x96,
-	0x53, 0x98, 0x7b, 0x7c, 0x3d, 0x0e, 0xde, 0x95, 0xc7, 0x92, 0x69, 0xe5, 0xf1, 0x07, 0x03, 0xd0,
-	0xb6, 0x68, 0xf0, 0x7f, 0xb7, 0x5f, 0x79, 0xcb, 0xf1, 0xb9, 0x2f, 0x07, 0x48, 0xe0, 0x31, 0x4f,
-	0x6d, 0xa6, 0x1e, 0x49, 0xa5, 0xfd, 0x6d, 0x8f, 0x79, 0x6a, 0x3b, 0x24, 0xd8, 0xcf, 0x12, 0xbe,
-	0xac, 0x44, 0x5d, 0x89, 0xed, 0xe0, 0xe4, 0x24, 0x0e, 0xb4, 0x04, 0x48, 0x01, 0xfd, 0xc9, 0x00,
-	0xeb, 0x09, 0xa3, 0x11, 0xf1, 0x1d, 0xcc, 0x1d, 0x96, 0xe0, 0xae, 0x43, 0x9f, 0x8f, 0xc5, 0x69,
-	0xc8, 0x3d, 0x1a, 0x06, 0x93, 0xb5, 0x73, 0x13, 0xf8, 0x64, 0x74, 0x35, 0xe4, 0x6d, 0x1a, 0x06,
-	0xa2, 0x20, 0xd6, 0x81, 0x8f, 0x2f, 0x4d, 0x5f, 0x2e, 0xe1, 0x5e, 0x8c, 0x4f, 0x4b, 0xfa, 0x5c,
-	0x48, 0xe8, 0xcb, 0x99, 0xd7, 0x8e, 0xf1, 0x29, 0xd7, 0xb7, 0x97, 0xe0, 0xe6, 0x0c, 0x6c, 0x0a,
-	0xf9, 0x2f, 0x06, 0x2c, 0x3c, 0x49, 0x53, 0x32, 0x8a, 0x3f, 0x17, 0xdd, 0x9f, 0x83, 0x5e, 0x84,
-	0xa6, 0x4f, 0xb3, 0x98, 0x09, 0xb0, 0x4d, 0x47, 0x1e, 0xa6, 0x1a, 0xa2, 0x56, 0x69, 0x88, 0xa9,
-	0x96, 0xaa, 0x57, 0x5b, 0x4a, 0x6b, 0x99, 0x46, 0xa9, 0x65, 0xfe, 0x0b, 0x5d, 0x7e, 0x31, 0xae,
-	0x8f, 0x63, 0x86, 0x13, 0x35, 0x30, 0x81, 0x93, 0xb6, 0x04, 0xc5, 0xfe, 0xde, 0x80, 0xc5, 0x32,
-	0x52, 0xf5, 0x3a, 0x38, 0x77, 0x7e, 0xf3, 0x81, 0x91, 0x84, 0x0a, 0x26, 0xff, 0xe4, 0xad, 0x37,
-	0xce, 0x0e, 0x43, 0xe2, 0xbb, 0x9c, 0x21, 0xe1, 0x99, 0x92, 0xf2, 0x3a, 0x09, 0x27, 0x41, 0x37,
-	0xf4, 0xa0, 0x11, 0x34, 0xbc, 0x8c, 0x1d, 0xe5, 0x33, 0x9c, 0x7f, 0xdb, 0x1f, 0xc2, 0x82, 0x7c,
-	0xb0, 0x95, 0xb3, 0xb6, 0x02, 0x50, 0x4c, 0x55, 0xf9, 0x56, 0x31, 0x1d, 0x33, 0x1f, 0xab, 0xa9,
-	0xfd, 0x31, 0x98, 0xfb, 0x54, 0x26, 0x22, 0x45, 0xf7, 0xc1, 0x0c, 0xf3, 0x83, 0x7a, 0xd6, 0xa0,
-	0x49, 0x7b, 0xe4, 0x72, 0xce, 0x44, 0xc8, 0x7e, 0x0c, 0x9d, 0x9c, 0x9c, 0xc7, 0x66, 0x9c, 0x17,
-	0x5b, 0x6d, 0x2a, 0x36, 0xfb, 0x77, 0x03, 0x16, 0xcb, 0x90, 0x55, 0xfa, 0x5e, 0x43, 0xbf, 0x70,
-	0xe1, 0x46, 0xde, 0x58, 0x61, 0xb9, 0xaf, 0x63, 0xa9, 0xaa, 0x15, 0x00, 0xd3, 0x67, 0xde, 0x58,
-	0x96, 0x54, 0x2f, 0xd4, 0x48, 0x83, 0x57, 0x30, 0x5f, 0x11, 0x99, 0xf1, 0x52, 0xb9, 0xab, 0xbf,
-	0x54, 0x4a, 0xaf, 0xad, 0x42, 0x5b, 0x7f, 0xbe, 0x3c, 0x82, 0x1b, 0xb2, 0xff, 0xb6, 0x8a, 0xa2,
-	0xcb, 0x73, 0x5f, 0xae, 0x4d, 0x63, 0xba, 0x36, 0xed, 0x01, 0x58, 0x55, 0x55, 0xd5, 0x05, 0x23,
-	0x98, 0x3f, 0x60, 0x1e, 0x23, 0x29, 0x23, 0x7e, 0xf1, 0x6c, 0x9e, 0x2a, 0x66, 0xe3, 0xb2, 0xfd,
-	0x50, 0x6d, 0x87, 0x39, 0xa8, 0x33, 0x96, 0xd7, 0x19, 0xff, 0xe4, 0xb7, 0x80, 0x74, 0x4f, 0xea,
-	0x0e, 0xde, 0x81, 0x2b, 0x5e, 0x0f, 0x8c, 0x32, 0x2f, 0x94, 0xfb, 0xb7, 0x21, 0xf6, 0xaf, 0x29,
-	0x28, 0x62, 0x01, 0xcb, 0x15, 0x15, 0x48, 0x6e, 0x53, 0x6e, 0x67, 0x4e, 0x10, 0xcc, 0x15, 0x00,
-	0xd1, 0x52, 0xb2, 0x1b, 0x5a, 0x52, 0x97, 0x53, 0xb6, 0x38, 0xc1, 0x5e, 0x85, 0xe5, 0x4f, 0x31,
-	0xe3, 0x2f, 0x89, 0x64, 0x8b, 0xc6, 0x43, 0x32, 0xca, 0x12, 0x4f, 0xbb, 0x0a, 0xfb, 0x47, 0x03,
-	0x56, 0xce, 0x11, 0x50, 0x01, 0x5b, 0xd0, 0x8e, 0xbc, 0x94, 0xe1, 0x24, 0xef, 0x92, 0xfc, 0x38,
-	0x9d, 0x8a, 0xda, 0x65, 0xa9, 0xa8, 0x57, 0x52, 0x71, 0x1d, 0x5a, 0x91, 0x77, 0xe6, 0x46, 0x87,
-	0xea, 0xa9, 0xd0, 0x8c, 0xbc, 0xb3, 0x67, 0x87, 0x0f, 0xfe, 0x68, 0x43, 0xef, 0x00, 0x7b, 0xa7,
-	0x18, 0x07, 0x02, 0x18, 0x1a, 0xe5, 0x0d, 0x51, 0xfe, 0xd1, 0x85, 0x6e, 0x4f, 0x57, 0xfe, 0xcc,
-	0x5f, 0x79, 0x83, 0x3b, 0x97, 0x89, 0xa9, 0xda, 0xba, 0x82, 0xf6, 0xa1, 0xab, 0xfd, 0xaa, 0x41,
-	0xcb, 0x9a, 0x62, 0xe5, 0xc7, 0xda, 0x60, 0xe5, 0x1c, 0xae, 0x6e, 0x4d, 0xdb, 0xce, 0xba, 0xb5,
-	0xea, 0x7b, 0x40, 0xb7, 0x36, 0x6b, 0xa5, 0x0b, 0x6b, 0xda, 0xe6, 0xd5, 0xad, 0x55, 0x77, 0xbd,
-	0x6e, 0x6d, 0xd6, 0xba, 0x16, 0xd6, 0xb4, 0xf5, 0xa8, 0x5b, 0xab, 0xae, 0x71, 0xdd, 0xda, 0xac,
-	0x9d, 0x7a, 0x05, 0x7d, 0x0d, 0xf3, 0x95, 0xc5, 0x85, 0xec, 0x89, 0xd6, 0x79, 0x1b, 0x77, 0xb0,
-	0x7e, 0xa1, 0x4c, 0x61, 0xff, 0x05, 0xf4, 0xf4, 0x85, 0x82, 0x34, 0x40, 0x33, 0x56, 0xe2, 0x60,
-	0xf5, 0x3c, 0xb6, 0x6e, 0x50, 0x9f, 0x95, 0xba, 0xc1, 0x19, 0xdb, 0x42, 0x37, 0x38, 0x6b, 0xc4,
-	0xda, 0x57, 0xd0, 0x57, 0x30, 0x37, 0x3d, 0xb3, 0xd0, 0xad, 0xe9, 0xb4, 0x55, 0x46, 0xe1, 0xc0,
-	0xbe, 0x48, 0xa4, 0x30, 0xbe, 0x07, 0x30, 0x19, 0x45, 0x68, 0x69, 0xa2, 0x53, 0x19, 0x85, 0x83,
-	0xe5, 0xd9, 0xcc, 0xc2, 0xd4, 0x37, 0x70, 0x7d, 0x66, 0xbf, 0x23, 0xad, 0x49, 0x2e, 0x9a, 0x18,
-	0x83, 0xff, 0x5f, 0x2a, 0x97, 0xfb, 0x7a, 0xba, 0x0a, 0x73, 0xa9, 0x6c, 0xe3, 0x61, 0xba, 0xe9,
-	0x87, 0x04, 0xc7, 0xec, 0x29, 0x08, 0x8d, 0x97, 0x09, 0x65, 0xf4, 0xb0, 0x25, 0xfe, 0xad, 0xf9,
-	0xe0, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xec, 0xb0, 0x56, 0xbc, 0x11, 0x00, 0x00,
+	// 1733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x18, 0xdb, 0x6e, 0xdc, 0x4c,
+	0x19, 0xef, 0xd9, 0xdf, 0xee, 0xf6, 0x4f, 0x26, 0x29, 0x75, 0x36, 0xd9, 0x34, 0x75, 0x68, 0x49,
+	0x45, 0x15, 0xaa, 0xc2, 0x45, 0x4b, 0x85, 0x44, 0x9b, 0x03, 0x04, 0x92, 0x34, 0x72, 0x5a, 0x84,
+	0x84, 0x84, 0xf1, 0xda, 0xb3, 0x9b, 0x21, 0x5e, 0x7b, 0xf1, 0x8c, 0x93, 0x94, 0x47, 0xe0, 0x92,
+	0x3b, 0x90, 0xb8, 0xae, 0x78, 0x09, 0x6e, 0x78, 0x07, 0x1e, 0x83, 0x67, 0x40, 0x73, 0xb0, 0x77,
+	0xbc, 0xde, 0xdd, 0x14, 0x21, 0xb8, 0x9b, 0xf9, 0xbe, 0x6f, 0xbe, 0xf3, 0xc9, 0x86, 0xf6, 0x90,
+	0x84, 0x38, 0xd9, 0x9f, 0x24, 0x31, 0x8b, 0x51, 0x4b, 0x5c, 0xdc, 0xc9, 0xc0, 0xfe, 0x00, 0x9b,
+	0xa7, 0x71, 0x7c, 0x9d, 0x4e, 0x0e, 0x49, 0x82, 0x7d, 0x16, 0x27, 0x9f, 0x8f, 0x22, 0x96, 0x7c,
+	0x76, 0xf0, 0xef, 0x53, 0x4c, 0x19, 0xda, 0x02, 0x33, 0xc8, 0x10, 0x96, 0xb1, 0x63, 0xec, 0x99,
+	0xce, 0x14, 0x80, 0x10, 0xd4, 0x22, 0x6f, 0x8c, 0xad, 0x8a, 0x40, 0x88, 0xb3, 0x7d, 0x04, 0x5b,
+	0xf3, 0x19, 0xd2, 0x49, 0x1c, 0x51, 0x8c, 0x9e, 0x42, 0x1d, 0x47, 0x4c, 0x71, 0x6b, 0xbf, 0xfa,
+	0x66, 0x3f, 0x53, 0x65, 0x5f, 0xd2, 0x49, 0xac, 0xfd, 0x77, 0x03, 0xd0, 0x29, 0xa1, 0x8c, 0x03,
+	0x09, 0xa6, 0x5f, 0xa7, 0xcf, 0xb7, 0xa1, 0x31, 0x49, 0xf0, 0x90, 0xdc, 0x29, 0x8d, 0xd4, 0x0d,
+	0xbd, 0x80, 0x55, 0xca, 0xbc, 0x84, 0x1d, 0x27, 0xf1, 0xf8, 0x98, 0x84, 0xf8, 0x9c, 0x2b, 0x5d,
+	0x15, 0x24, 0x65, 0x04, 0xda, 0x07, 0x44, 0x22, 0x3f, 0x4c, 0x29, 0xb9, 0xc1, 0x97, 0x19, 0xd6,
+	0xaa, 0xed, 0x18, 0x7b, 0x2d, 0x67, 0x0e, 0x06, 0xad, 0x43, 0x3d, 0x24, 0x63, 0xc2, 0xac, 0xfa,
+	0x8e, 0xb1, 0xd7, 0x75, 0xe4, 0xc5, 0xfe, 0x09, 0xac, 0x15, 0xf4, 0x57, 0xe6, 0x3f, 0x87, 0x26,
+	0x96, 0x20, 0xcb, 0xd8, 0xa9, 0xce, 0x73, 0x40, 0x86, 0xb7, 0xff, 0x5a, 0x81, 0xba, 0x00, 0xe5,
+	0x7e, 0x36, 0xa6, 0x7e, 0x46, 0x4f, 0xa0, 0x43, 0xa8, 0x3b, 0x75, 0x46, 0x45, 0xe8, 0xd7, 0x26,
+	0x34, 0xf7, 0x3b, 0xfa, 0x1e, 0x34, 0xfc, 0xab, 0x34, 0xba, 0xa6, 0x56, 0x55, 0x88, 0x5a, 0x9b,
+	0x8a, 0xe2, 0xc6, 0x1e, 0x70, 0x9c, 0xa3, 0x48, 0xd0, 0x6b, 0x00, 0x8f, 0xb1, 0x84, 0x0c, 0x52,
+	0x86, 0xa9, 0xb0, 0xb6, 0xfd, 0xca, 0xd2, 0x1e, 0xa4, 0x14, 0xbf, 0xcb, 0xf1, 0x8e, 0x46, 0x8b,
+	0xde, 0x40, 0x0b, 0xdf, 0x31, 0x1c, 0x05, 0x38, 0xb0, 0xea, 0x42, 0x50, 0x7f, 0xc6, 0xa6, 0xfd,
+	0x23, 0x85, 0x97, 0x16, 0xe6, 0xe4, 0xbd, 0xb7, 0xd0, 0x2d, 0xa0, 0xd0, 0x0a, 0x54, 0xaf, 0x71,
+	0x16, 0x59, 0x7e, 0xe4, 0xde, 0xbd, 0xf1, 0xc2, 0x54, 0x26, 0x59, 0xc7, 0x91, 0x97, 0x1f, 0x55,
+	0x5e, 0x1b, 0xf6, 0x21, 0x98, 0xc7, 0x69, 0x18, 0xe6, 0x0f, 0x03, 0x92, 0x64, 0x0f, 0x03, 0x92,
+	0x4c, 0x13, 0xad, 0xb2, 0x34, 0xd1, 0xfe, 0x69, 0xc0, 0xea, 0xd1, 0x0d, 0x8e, 0xd8, 0x79, 0xcc,
+	0xc8, 0x90, 0xf8, 0x1e, 0x23, 0x71, 0x84, 0x5e, 0x80, 0x19, 0x87, 0x81, 0xbb, 0x34, 0x53, 0x5b,
+	0x71, 0xa8, 0xb4, 0x7e, 0x01, 0x66, 0x84, 0x6f, 0xdd, 0xa5, 0xe2, 0x5a, 0x11, 0xbe, 0x95, 0xd4,
+	0xbb, 0xd0, 0x0d, 0x70, 0x88, 0x19, 0x76, 0xf3, 0xe8, 0xf0, 0xd0, 0x75, 0x24, 0xf0, 0x40, 0x86,
+	0xe3, 0x19, 0x7c, 0xc3, 0x59, 0x4e, 0xbc, 0x04, 0x47, 0xcc, 0x9d, 0x78, 0xec, 0x4a, 0xc4, 0xc4,
+	0x74, 0xba, 0x11, 0xbe, 0xbd, 0x10, 0xd0, 0x0b, 0x8f, 0x5d, 0x21, 0x0b, 0x9a, 0x71, 0x42, 0x46,
+	0x24, 0xa2, 0xc2, 0xf7, 0xa6, 0x93, 0x5d, 0xed, 0x3f, 0x57, 0xc0, 0xcc, 0xc3, 0x8c, 0x1e, 0x41,
+	0x93, 0x2b, 0xe4, 0x92, 0x40, 0xf9, 0xa8, 0xc1, 0xaf, 0x27, 0x01, 0xaf, 0x99, 0x78, 0x38, 0xa4,
+	0x98, 0x09, 0xc5, 0xab, 0x8e, 0xba, 0xf1, 0x9c, 0xa3, 0xe4, 0x0f, 0xb2, 0x4c, 0x6a, 0x8e, 0x38,
+	0xf3, 0x58, 0x8c, 0x19, 0x19, 0x63, 0xa1, 0x4a, 0xd5, 0x91, 0x17, 0xb4, 0x06, 0x75, 0xec, 0x32,
+	0x6f, 0x24, 0xf2, 0xdf, 0x74, 0x6a, 0xf8, 0xa3, 0x37, 0x42, 0xdf, 0x81, 0x07, 0x34, 0x4e, 0x13,
+	0x1f, 0xbb, 0x99, 0xd8, 0x86, 0xc0, 0x76, 0x24, 0xf4, 0x58, 0x0a, 0xb7, 0xa1, 0x3a, 0x24, 0x81,
+	0xd5, 0x14, 0x2e, 0x5b, 0x29, 0xa6, 0xe7, 0x49, 0xe0, 0x70, 0x24, 0xfa, 0x3e, 0x40, 0xce, 0x29,
+	0xb0, 0x5a, 0x0b, 0x48, 0xcd, 0x8c, 0x6f, 0xc0, 0x2b, 0xc3, 0x8f, 0x23, 0xc6, 0xfd, 0x76, 0xe5,
+	0xd1, 0x2b, 0xcb, 0x14, 0x82, 0xdb, 0x0a, 0xf6, 0x33, 0x8f, 0x5e, 0xd9, 0xbf, 0x82, 0x86, 0xd2,
+	0x60, 0x13, 0xcc, 0x9b, 0x38, 0x4c, 0xc7, 0xb9, 0x67, 0xba, 0x4e, 0x4b, 0x02, 0x4e, 0x02, 0xb4,
+	0x01, 0xa2, 0x51, 0xba, 0x3c, 0x25, 0x2b, 0xc2, 0x0f, 0xc2, 0x89, 0xbf, 0xc0, 0xa2, 0xd5, 0xf8,
+	0x71, 0x7c, 0x4d, 0xa4, 0x83, 0x9a, 0x8e, 0xba, 0xd9, 0xff, 0xaa, 0xc0, 0x83, 0x62, 0xad, 0x70,
+	0x11, 0x82, 0x8b, 0x70, 0xa7, 0x21, 0xd8, 0x08, 0xb6, 0x97, 0x05, 0x97, 0x56, 0x74, 0x97, 0x66,
+	0x4f, 0xc6, 0x71, 0x20, 0x05, 0x74, 0xe5, 0x93, 0xb3, 0x38, 0xc0, 0x3c, 0xd5, 0x53, 0x12, 0x88,
+	0x18, 0x74, 0x1d, 0x7e, 0xe4, 0x90, 0x11, 0x09, 0x54, 0xff, 0xe1, 0x47, 0xa1, 0x5e, 0x22, 0xf8,
+	0x36, 0x64, 0x54, 0xe5, 0x8d, 0x47, 0x75, 0xcc, 0xa1, 0x4d, 0x19, 0x2a, 0x7e, 0x46, 0x3b, 0xd0,
+	0x4e, 0xf0, 0x24, 0x54, 0xa9, 0x2f, 0x3c, 0x6c, 0x3a, 0x3a, 0x08, 0x6d, 0x03, 0xf8, 0x71, 0x18,
+	0x62, 0x5f, 0x10, 0x48, 0x7f, 0x6a, 0x10, 0x9e, 0x5c, 0x8c, 0x85, 0x2e, 0xc5, 0xbe, 0x05, 0x3b,
+	0xc6, 0x5e, 0xdd, 0x69, 0x30, 0x16, 0x5e, 0x62, 0x9f, 0xdb, 0x91, 0x52, 0x9c, 0xb8, 0xa2, 0x7b,
+	0xb5, 0xc5, 0xbb, 0x16, 0x07, 0x88, 0x3e, 0xdb, 0x07, 0x18, 0x25, 0x71, 0x3a, 0x91, 0xd8, 0x8e,
+	0xc8, 0x5e, 0x53, 0x40, 0x04, 0xfa, 0x29, 0x3c, 0xa0, 0x9f, 0xc7, 0x21, 0x89, 0xae, 0x5d, 0xe6,
+	0x25, 0x23, 0xcc, 0xac, 0xae, 0x2c, 0x00, 0x05, 0xfd, 0x28, 0x80, 0x36, 0x05, 0x74, 0x90, 0x60,
+	0x8f, 0xe1, 0xff, 0x60, 0x6e, 0x7d, 0x5d, 0x6b, 0xd0, 0x6b, 0xab, 0x5a, 0xac, 0xad, 0x87, 0xb0,
+	0x56, 0x10, 0x2a, 0x9b, 0x3b, 0xd7, 0xe5, 0xd3, 0x24, 0xf8, 0xff, 0xeb, 0x52, 0x10, 0xaa, 0x74,
+	0xf9, 0x9b, 0x01, 0xe8, 0x50, 0x74, 0x94, 0xff, 0x6e, 0xa0, 0xf3, 0x4a, 0xe6, 0x83, 0x46, 0x76,
+	0xac, 0xc0, 0x63, 0x9e, 0x1a, 0x85, 0x1d, 0x42, 0x25, 0xff, 0x43, 0x8f, 0x79, 0x6a, 0x1c, 0x25,
+	0xd8, 0x4f, 0x13, 0x3e, 0x1d, 0xad, 0x7a, 0x36, 0x8e, 0x9c, 0x0c, 0xa4, 0x9b, 0xd0, 0x28, 0x99,
+	0x50, 0x50, 0x55, 0x99, 0xf0, 0x17, 0x03, 0xac, 0x77, 0x2c, 0x1e, 0x13, 0xdf, 0xc1, 0x5c, 0x95,
+	0x82, 0x21, 0xbb, 0xd0, 0xe5, 0x1d, 0x7a, 0xd6, 0x98, 0x4e, 0x1c, 0x06, 0xd3, 0x09, 0xb8, 0x01,
+	0xbc, 0x49, 0xbb, 0x9a, 0x4d, 0xcd, 0x38, 0x0c, 0x44, 0x7a, 0xed, 0x02, 0xef, 0xa4, 0xda, 0x7b,
+	0xb9, 0x0f, 0x74, 0x22, 0x7c, 0x5b, 0x78, 0xcf, 0x89, 0xc4, 0x7b, 0xd9, 0x7e, 0x9b, 0x11, 0xbe,
+	0xe5, 0xef, 0xed, 0x4d, 0xd8, 0x98, 0xa3, 0x9b, 0xd2, 0xfc, 0x8b, 0x01, 0x6b, 0xef, 0x28, 0x25,
+	0xa3, 0xe8, 0x97, 0xa2, 0x97, 0x64, 0x4a, 0xaf, 0x43, 0xdd, 0x8f, 0xd3, 0x88, 0x09, 0x65, 0xeb,
+	0x8e, 0xbc, 0xcc, 0x94, 0x57, 0xa5, 0x54, 0x5e, 0x33, 0x05, 0x5a, 0x2d, 0x17, 0xa8, 0x56, 0x80,
+	0xb5, 0x42, 0x01, 0x3e, 0x86, 0x36, 0x0f, 0x99, 0xeb, 0xe3, 0x88, 0xe1, 0x44, 0x75, 0x68, 0xe0,
+	0xa0, 0x03, 0x01, 0xb1, 0xff, 0x68, 0xc0, 0x7a, 0x51, 0x53, 0xb5, 0xa8, 0x2c, 0x1c, 0x18, 0xbc,
+	0xfd, 0x24, 0xa1, 0x52, 0x93, 0x1f, 0x79, 0x21, 0x4f, 0xd2, 0x41, 0x48, 0x7c, 0x97, 0x23, 0xa4,
+	0x7a, 0xa6, 0x84, 0x7c, 0x4a, 0xc2, 0xa9, 0xd1, 0x35, 0xdd, 0x68, 0x04, 0x35, 0x2f, 0x65, 0x57,
+	0xd9, 0xd0, 0xe0, 0x67, 0xfb, 0x87, 0xb0, 0x26, 0x77, 0xc7, 0xa2, 0xd7, 0xfa, 0x00, 0x79, 0x8f,
+	0x96, 0x6b, 0x93, 0xe9, 0x98, 0x59, 0x93, 0xa6, 0xf6, 0x8f, 0xc1, 0x3c, 0x8d, 0xa5, 0x23, 0x28,
+	0x7a, 0x09, 0x66, 0x98, 0x5d, 0xd4, 0x86, 0x85, 0xa6, 0x25, 0x95, 0xd1, 0x39, 0x53, 0x22, 0xfb,
+	0x2d, 0xb4, 0x32, 0x70, 0x66, 0x9b, 0xb1, 0xc8, 0xb6, 0xca, 0x8c, 0x6d, 0xf6, 0x3f, 0x0c, 0x58,
+	0x2f, 0xaa, 0xac, 0xdc, 0xf7, 0x09, 0xba, 0xb9, 0x08, 0x77, 0xec, 0x4d, 0x94, 0x2e, 0x2f, 0x75,
+	0x5d, 0xca, 0xcf, 0x72, 0x05, 0xe9, 0x99, 0x37, 0x91, 0x29, 0xd5, 0x09, 0x35, 0x50, 0xef, 0x23,
+	0xac, 0x96, 0x48, 0xe6, 0x2c, 0x4d, 0xcf, 0xf5, 0xa5, 0xa9, 0xb0, 0xf8, 0xe5, 0xaf, 0xf5, 0x4d,
+	0xea, 0x0d, 0x3c, 0x92, 0xf5, 0x77, 0x90, 0x27, 0x5d, 0xe6, 0xfb, 0x62, 0x6e, 0x1a, 0xb3, 0xb9,
+	0x69, 0xf7, 0xc0, 0x2a, 0x3f, 0x55, 0x55, 0x30, 0x82, 0xd5, 0x4b, 0xe6, 0x31, 0x42, 0x19, 0xf1,
+	0xf3, 0x0d, 0x7e, 0x26, 0x99, 0x8d, 0xfb, 0xa6, 0x4d, 0xb9, 0x1c, 0x56, 0xa0, 0xca, 0x58, 0x96,
+	0x67, 0xfc, 0xc8, 0xa3, 0x80, 0x74, 0x49, 0x2a, 0x06, 0xff, 0x03, 0x51, 0x3c, 0x1f, 0x58, 0xcc,
+	0xbc, 0x50, 0x4e, 0xf3, 0x9a, 0x98, 0xe6, 0xa6, 0x80, 0x88, 0x71, 0x2e, 0x07, 0x5e, 0x20, 0xb1,
+	0x75, 0x39, 0xeb, 0x39, 0x40, 0x20, 0xfb, 0x00, 0xa2, 0xa4, 0x64, 0x35, 0x34, 0xe4, 0x5b, 0x0e,
+	0x39, 0xe0, 0x00, 0x7b, 0x1b, 0xb6, 0x7e, 0x8a, 0x19, 0xdf, 0x4b, 0x92, 0x83, 0x38, 0x1a, 0x92,
+	0x51, 0x9a, 0x78, 0x5a, 0x28, 0xec, 0x3f, 0x19, 0xd0, 0x5f, 0x40, 0xa0, 0x0c, 0xb6, 0xa0, 0x39,
+	0xf6, 0x28, 0xc3, 0x49, 0x56, 0x25, 0xd9, 0x75, 0xd6, 0x15, 0x95, 0xfb, 0x5c, 0x51, 0x2d, 0xb9,
+	0xe2, 0x21, 0x34, 0xc6, 0xde, 0x9d, 0x3b, 0x1e, 0xa8, 0xc5, 0xa3, 0x3e, 0xf6, 0xee, 0xce, 0x06,
+	0xf6, 0x2d, 0x58, 0x97, 0xe9, 0x80, 0xfa, 0x09, 0x19, 0xe0, 0x33, 0xcc, 0x3c, 0xde, 0x5a, 0xb2,
+	0x50, 0x3f, 0x86, 0xb6, 0x1f, 0x12, 0xbe, 0x87, 0x69, 0x5f, 0x2f, 0x20, 0x41, 0xa2, 0x07, 0x3f,
+	0x86, 0x36, 0xdf, 0x6c, 0xdd, 0xc2, 0x47, 0x1b, 0x70, 0xd0, 0x85, 0x80, 0xf0, 0xfe, 0x4b, 0x49,
+	0xe4, 0x63, 0x37, 0x92, 0x5b, 0x72, 0xd5, 0x69, 0x8a, 0xfb, 0x39, 0xe5, 0xc3, 0x61, 0x63, 0x8e,
+	0x64, 0xe5, 0x89, 0xe5, 0x63, 0xee, 0xe7, 0x80, 0xf0, 0x8d, 0xd0, 0x4b, 0xdb, 0xf9, 0x55, 0xad,
+	0x6c, 0x6a, 0x03, 0x78, 0xf6, 0xb3, 0xc0, 0x59, 0xc5, 0xb3, 0x20, 0xbe, 0xfd, 0x32, 0x3a, 0xd5,
+	0xaf, 0xc6, 0xe8, 0x39, 0x7d, 0xf5, 0xa5, 0x05, 0x9d, 0x4b, 0xec, 0xdd, 0x62, 0x1c, 0x88, 0x70,
+	0xa1, 0x51, 0xd6, 0x26, 0x8a, 0x5f, 0xc5, 0xe8, 0xe9, 0x6c, 0x3f, 0x98, 0xfb, 0x19, 0xde, 0x7b,
+	0x76, 0x1f, 0x99, 0xaa, 0xb8, 0x6f, 0xa1, 0x53, 0x68, 0x6b, 0x9f, 0x9d, 0x68, 0x4b, 0x7b, 0x58,
+	0xfa, 0x9a, 0xee, 0xf5, 0x17, 0x60, 0x75, 0x6e, 0xda, 0x9e, 0xa3, 0x73, 0x2b, 0xef, 0x5c, 0xbd,
+	0xfe, 0x02, 0xac, 0xce, 0x4d, 0xdb, 0x54, 0x74, 0x6e, 0xe5, 0xad, 0xa9, 0xd7, 0x5f, 0x80, 0xd5,
+	0xb9, 0x69, 0x4b, 0x83, 0xce, 0xad, 0xbc, 0xf6, 0xf4, 0xfa, 0x0b, 0xb0, 0x39, 0xb7, 0xdf, 0xc0,
+	0x6a, 0x69, 0x9c, 0x23, 0x7b, 0xfa, 0x6a, 0xd1, 0x1e, 0xd2, 0xdb, 0x5d, 0x4a, 0x93, 0xf3, 0xff,
+	0x00, 0x1d, 0x7d, 0xcc, 0x22, 0x4d, 0xa1, 0x39, 0x8b, 0x42, 0x6f, 0x7b, 0x11, 0x5a, 0x67, 0xa8,
+	0x4f, 0x10, 0x9d, 0xe1, 0x9c, 0x19, 0xda, 0xdb, 0x5e, 0x84, 0xce, 0x19, 0xfe, 0x1a, 0x56, 0x66,
+	0x3b, 0x39, 0x7a, 0x32, 0xeb, 0xb6, 0xd2, 0x80, 0xe8, 0xd9, 0xcb, 0x48, 0x72, 0xe6, 0x27, 0x00,
+	0xd3, 0x06, 0x8d, 0xb4, 0x1a, 0x2b, 0x0d, 0x88, 0xde, 0xd6, 0x7c, 0x64, 0xce, 0xea, 0x77, 0xf0,
+	0x70, 0x6e, 0x17, 0x44, 0x5a, 0x91, 0x2c, 0xeb, 0xa3, 0xbd, 0xef, 0xde, 0x4b, 0x97, 0xcb, 0xfa,
+	0x2d, 0xac, 0x96, 0x7a, 0x8c, 0x9e, 0x15, 0x8b, 0x5a, 0x5f, 0x6f, 0x77, 0x29, 0x4d, 0xc6, 0xff,
+	0xa5, 0xf1, 0x7e, 0x1b, 0x56, 0xa8, 0x6c, 0x14, 0x43, 0xba, 0x2f, 0x5b, 0xe3, 0x7b, 0x10, 0x3a,
+	0x5d, 0x24, 0x31, 0x8b, 0x07, 0x0d, 0xf1, 0xc3, 0xee, 0x07, 0xff, 0x1e, 0x00, 0x1e, 0x7c, 0x62,
+	0x71, 0xbf, 0x13, 0x00, 0x00,
 }
@@ -18,6 +18,8 @@ It has these top-level messages:
 	SuperBlockExtra
 	ClientListenRequest
 	VolumeLocation
+	WatchTopologyRequest
+	TopologyEvent
 	LookupVolumeRequest
 	LookupVolumeResponse
 	Location
@@ -31,6 +33,12 @@ It has these top-level messages:
 	CollectionListResponse
 	CollectionDeleteRequest
 	CollectionDeleteResponse
+	CollectionQuotaSetRequest
+	CollectionQuotaSetResponse
+	CollectionPlacementSetRequest
+	CollectionPlacementSetResponse
+	MaxVolumeIdRaiseRequest
+	MaxVolumeIdRaiseResponse
 	DataNodeInfo
 	RackInfo
 	DataCenterInfo
@@ -41,6 +49,18 @@ It has these top-level messages:
 	LookupEcVolumeResponse
 	GetMasterConfigurationRequest
 	GetMasterConfigurationResponse
+	VolumeRepairRequest
+	VolumeRepairResponse
+	RaftListClusterServersRequest
+	RaftListClusterServersResponse
+	RaftAddServerRequest
+	RaftAddServerResponse
+	RaftRemoveServerRequest
+	RaftRemoveServerResponse
+	LeaseAdminTokenRequest
+	LeaseAdminTokenResponse
+	ReleaseAdminTokenRequest
+	ReleaseAdminTokenResponse
 */
 package master_pb
 
@@ -78,12 +98,19 @@ type Heartbeat struct {
 	NewVolumes     []*VolumeShortInformationMessage `protobuf:"bytes,10,rep,name=new_volumes,json=newVolumes" json:"new_volumes,omitempty"`
 	DeletedVolumes []*VolumeShortInformationMessage `protobuf:"bytes,11,rep,name=deleted_volumes,json=deletedVolumes" json:"deleted_volumes,omitempty"`
 	HasNoVolumes   bool                             `protobuf:"varint,12,opt,name=has_no_volumes,json=hasNoVolumes" json:"has_no_volumes,omitempty"`
+	// directories below the minimum free space, sent with the full volume list
+	LowDiskSpaceDirs []string `protobuf:"bytes,13,rep,name=low_disk_space_dirs,json=lowDiskSpaceDirs" json:"low_disk_space_dirs,omitempty"`
 	// erasure coding
 	EcShards []*VolumeEcShardInformationMessage `protobuf:"bytes,16,rep,name=ec_shards,json=ecShards" json:"ec_shards,omitempty"`
 	// delta erasure coding shards
 	NewEcShards     []*VolumeEcShardInformationMessage `protobuf:"bytes,17,rep,name=new_ec_shards,json=newEcShards" json:"new_ec_shards,omitempty"`
 	DeletedEcShards []*VolumeEcShardInformationMessage `protobuf:"bytes,18,rep,name=deleted_ec_shards,json=deletedEcShards" json:"deleted_ec_shards,omitempty"`
 	HasNoEcShards   bool                               `protobuf:"varint,19,opt,name=has_no_ec_shards,json=hasNoEcShards" json:"has_no_ec_shards,omitempty"`
+	// labels like zone=a or tier=archive, to match the placement constraints
+	Labels map[string]string `protobuf:"bytes,20,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// disk space of all the directories, sent with the full volume list, to weight the volume placement
+	FreeDiskBytes  uint64 `protobuf:"varint,21,opt,name=free_disk_bytes,json=freeDiskBytes" json:"free_disk_bytes,omitempty"`
+	TotalDiskBytes uint64 `protobuf:"varint,22,opt,name=total_disk_bytes,json=totalDiskBytes" json:"total_disk_bytes,omitempty"`
 }
 
 func (m *Heartbeat) Reset()                    { *m = Heartbeat{} }
@@ -175,6 +202,13 @@ func (m *Heartbeat) GetHasNoVolumes() bool {
 	return false
 }
 
+func (m *Heartbeat) GetLowDiskSpaceDirs() []string {
+	if m != nil {
+		return m.LowDiskSpaceDirs
+	}
+	return nil
+}
+
 func (m *Heartbeat) GetEcShards() []*VolumeEcShardInformationMessage {
 	if m != nil {
 		return m.EcShards
@@ -203,6 +237,27 @@ func (m *Heartbeat) GetHasNoEcShards() bool {
 	return false
 }
 
+func (m *Heartbeat) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Heartbeat) GetFreeDiskBytes() uint64 {
+	if m != nil {
+		return m.FreeDiskBytes
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetTotalDiskBytes() uint64 {
+	if m != nil {
+		return m.TotalDiskBytes
+	}
+	return 0
+}
+
 type HeartbeatResponse struct {
 	VolumeSizeLimit        uint64 `protobuf:"varint,1,opt,name=volume_size_limit,json=volumeSizeLimit" json:"volume_size_limit,omitempty"`
 	Leader                 string `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
@@ -399,6 +454,9 @@ type VolumeEcShardInformationMessage struct {
 	Id          uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
 	EcIndexBits uint32 `protobuf:"varint,3,opt,name=ec_index_bits,json=ecIndexBits" json:"ec_index_bits,omitempty"`
+	// the ec scheme, 0 for the default 10+4
+	DataShards   uint32 `protobuf:"varint,4,opt,name=data_shards,json=dataShards" json:"data_shards,omitempty"`
+	ParityShards uint32 `protobuf:"varint,5,opt,name=parity_shards,json=parityShards" json:"parity_shards,omitempty"`
 }
 
 func (m *VolumeEcShardInformationMessage) Reset()                    { *m = VolumeEcShardInformationMessage{} }
@@ -427,6 +485,20 @@ func (m *VolumeEcShardInformationMessage) GetEcIndexBits() uint32 {
 	return 0
 }
 
+func (m *VolumeEcShardInformationMessage) GetDataShards() uint32 {
+	if m != nil {
+		return m.DataShards
+	}
+	return 0
+}
+
+func (m *VolumeEcShardInformationMessage) GetParityShards() uint32 {
+	if m != nil {
+		return m.ParityShards
+	}
+	return 0
+}
+
 type Empty struct {
 }
 
@@ -541,6 +613,120 @@ func (m *VolumeLocation) GetDeletedVids() []uint32 {
 	return nil
 }
 
+type WatchTopologyRequest struct {
+	ClientName string   `protobuf:"bytes,1,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes" json:"event_types,omitempty"`
+}
+
+func (m *WatchTopologyRequest) Reset()                    { *m = WatchTopologyRequest{} }
+func (m *WatchTopologyRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchTopologyRequest) ProtoMessage()               {}
+func (*WatchTopologyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *WatchTopologyRequest) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+func (m *WatchTopologyRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+type TopologyEvent struct {
+	TsNs int64 `protobuf:"varint,1,opt,name=ts_ns,json=tsNs" json:"ts_ns,omitempty"`
+	// node_joined, node_left, volume_created, volume_deleted, volume_read_only, volume_writable,
+	// ec_shards_added, ec_shards_removed, leader_changed, vacuum_started, vacuum_finished
+	Type       string   `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	DataCenter string   `protobuf:"bytes,3,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	Rack       string   `protobuf:"bytes,4,opt,name=rack" json:"rack,omitempty"`
+	DataNode   string   `protobuf:"bytes,5,opt,name=data_node,json=dataNode" json:"data_node,omitempty"`
+	VolumeId   uint32   `protobuf:"varint,6,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string   `protobuf:"bytes,7,opt,name=collection" json:"collection,omitempty"`
+	EcShardIds []uint32 `protobuf:"varint,8,rep,packed,name=ec_shard_ids,json=ecShardIds" json:"ec_shard_ids,omitempty"`
+	Leader     string   `protobuf:"bytes,9,opt,name=leader" json:"leader,omitempty"`
+	Message    string   `protobuf:"bytes,10,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *TopologyEvent) Reset()                    { *m = TopologyEvent{} }
+func (m *TopologyEvent) String() string            { return proto.CompactTextString(m) }
+func (*TopologyEvent) ProtoMessage()               {}
+func (*TopologyEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *TopologyEvent) GetTsNs() int64 {
+	if m != nil {
+		return m.TsNs
+	}
+	return 0
+}
+
+func (m *TopologyEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetRack() string {
+	if m != nil {
+		return m.Rack
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetDataNode() string {
+	if m != nil {
+		return m.DataNode
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *TopologyEvent) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetEcShardIds() []uint32 {
+	if m != nil {
+		return m.EcShardIds
+	}
+	return nil
+}
+
+func (m *TopologyEvent) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type LookupVolumeRequest struct {
 	VolumeIds  []string `protobuf:"bytes,1,rep,name=volume_ids,json=volumeIds" json:"volume_ids,omitempty"`
 	Collection string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -549,7 +735,7 @@ type LookupVolumeRequest struct {
 func (m *LookupVolumeRequest) Reset()                    { *m = LookupVolumeRequest{} }
 func (m *LookupVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeRequest) ProtoMessage()               {}
-func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *LookupVolumeRequest) GetVolumeIds() []string {
 	if m != nil {
@@ -572,7 +758,7 @@ type LookupVolumeResponse struct {
 func (m *LookupVolumeResponse) Reset()                    { *m = LookupVolumeResponse{} }
 func (m *LookupVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeResponse) ProtoMessage()               {}
-func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *LookupVolumeResponse) GetVolumeIdLocations() []*LookupVolumeResponse_VolumeIdLocation {
 	if m != nil {
@@ -591,7 +777,7 @@ func (m *LookupVolumeResponse_VolumeIdLocation) Reset()         { *m = LookupVol
 func (m *LookupVolumeResponse_VolumeIdLocation) String() string { return proto.CompactTextString(m) }
 func (*LookupVolumeResponse_VolumeIdLocation) ProtoMessage()    {}
 func (*LookupVolumeResponse_VolumeIdLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{10, 0}
+	return fileDescriptor0, []int{12, 0}
 }
 
 func (m *LookupVolumeResponse_VolumeIdLocation) GetVolumeId() string {
@@ -623,7 +809,7 @@ type Location struct {
 func (m *Location) Reset()                    { *m = Location{} }
 func (m *Location) String() string            { return proto.CompactTextString(m) }
 func (*Location) ProtoMessage()               {}
-func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *Location) GetUrl() string {
 	if m != nil {
@@ -647,12 +833,16 @@ type AssignRequest struct {
 	DataCenter  string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
 	Rack        string `protobuf:"bytes,6,opt,name=rack" json:"rack,omitempty"`
 	DataNode    string `protobuf:"bytes,7,opt,name=data_node,json=dataNode" json:"data_node,omitempty"`
+	// only the volumes on the volume servers with all these labels, and new volumes are placed there
+	RequiredLabels map[string]string `protobuf:"bytes,8,rep,name=required_labels,json=requiredLabels" json:"required_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// the replicas of new volumes are placed on volume servers with different values of these labels
+	AntiAffinityLabels []string `protobuf:"bytes,9,rep,name=anti_affinity_labels,json=antiAffinityLabels" json:"anti_affinity_labels,omitempty"`
 }
 
 func (m *AssignRequest) Reset()                    { *m = AssignRequest{} }
 func (m *AssignRequest) String() string            { return proto.CompactTextString(m) }
 func (*AssignRequest) ProtoMessage()               {}
-func (*AssignRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*AssignRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *AssignRequest) GetCount() uint64 {
 	if m != nil {
@@ -703,6 +893,20 @@ func (m *AssignRequest) GetDataNode() string {
 	return ""
 }
 
+func (m *AssignRequest) GetRequiredLabels() map[string]string {
+	if m != nil {
+		return m.RequiredLabels
+	}
+	return nil
+}
+
+func (m *AssignRequest) GetAntiAffinityLabels() []string {
+	if m != nil {
+		return m.AntiAffinityLabels
+	}
+	return nil
+}
+
 type AssignResponse struct {
 	Fid       string `protobuf:"bytes,1,opt,name=fid" json:"fid,omitempty"`
 	Url       string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
@@ -715,7 +919,7 @@ type AssignResponse struct {
 func (m *AssignResponse) Reset()                    { *m = AssignResponse{} }
 func (m *AssignResponse) String() string            { return proto.CompactTextString(m) }
 func (*AssignResponse) ProtoMessage()               {}
-func (*AssignResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*AssignResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *AssignResponse) GetFid() string {
 	if m != nil {
@@ -768,7 +972,7 @@ type StatisticsRequest struct {
 func (m *StatisticsRequest) Reset()                    { *m = StatisticsRequest{} }
 func (m *StatisticsRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsRequest) ProtoMessage()               {}
-func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *StatisticsRequest) GetReplication() string {
 	if m != nil {
@@ -803,7 +1007,7 @@ type StatisticsResponse struct {
 func (m *StatisticsResponse) Reset()                    { *m = StatisticsResponse{} }
 func (m *StatisticsResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsResponse) ProtoMessage()               {}
-func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *StatisticsResponse) GetReplication() string {
 	if m != nil {
@@ -855,7 +1059,7 @@ type StorageType struct {
 func (m *StorageType) Reset()                    { *m = StorageType{} }
 func (m *StorageType) String() string            { return proto.CompactTextString(m) }
 func (*StorageType) ProtoMessage()               {}
-func (*StorageType) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*StorageType) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *StorageType) GetReplication() string {
 	if m != nil {
@@ -873,12 +1077,19 @@ func (m *StorageType) GetTtl() string {
 
 type Collection struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// 0 means unlimited
+	QuotaBytes         uint64            `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes" json:"quota_bytes,omitempty"`
+	QuotaVolumes       uint64            `protobuf:"varint,3,opt,name=quota_volumes,json=quotaVolumes" json:"quota_volumes,omitempty"`
+	UsedBytes          uint64            `protobuf:"varint,4,opt,name=used_bytes,json=usedBytes" json:"used_bytes,omitempty"`
+	VolumeCount        uint64            `protobuf:"varint,5,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
+	RequiredLabels     map[string]string `protobuf:"bytes,6,rep,name=required_labels,json=requiredLabels" json:"required_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AntiAffinityLabels []string          `protobuf:"bytes,7,rep,name=anti_affinity_labels,json=antiAffinityLabels" json:"anti_affinity_labels,omitempty"`
 }
 
 func (m *Collection) Reset()                    { *m = Collection{} }
 func (m *Collection) String() string            { return proto.CompactTextString(m) }
 func (*Collection) ProtoMessage()               {}
-func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *Collection) GetName() string {
 	if m != nil {
@@ -887,6 +1098,48 @@ func (m *Collection) GetName() string {
 	return ""
 }
 
+func (m *Collection) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+func (m *Collection) GetQuotaVolumes() uint64 {
+	if m != nil {
+		return m.QuotaVolumes
+	}
+	return 0
+}
+
+func (m *Collection) GetUsedBytes() uint64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *Collection) GetVolumeCount() uint64 {
+	if m != nil {
+		return m.VolumeCount
+	}
+	return 0
+}
+
+func (m *Collection) GetRequiredLabels() map[string]string {
+	if m != nil {
+		return m.RequiredLabels
+	}
+	return nil
+}
+
+func (m *Collection) GetAntiAffinityLabels() []string {
+	if m != nil {
+		return m.AntiAffinityLabels
+	}
+	return nil
+}
+
 type CollectionListRequest struct {
 	IncludeNormalVolumes bool `protobuf:"varint,1,opt,name=include_normal_volumes,json=includeNormalVolumes" json:"include_normal_volumes,omitempty"`
 	IncludeEcVolumes     bool `protobuf:"varint,2,opt,name=include_ec_volumes,json=includeEcVolumes" json:"include_ec_volumes,omitempty"`
@@ -895,7 +1148,7 @@ type CollectionListRequest struct {
 func (m *CollectionListRequest) Reset()                    { *m = CollectionListRequest{} }
 func (m *CollectionListRequest) String() string            { return proto.CompactTextString(m) }
 func (*CollectionListRequest) ProtoMessage()               {}
-func (*CollectionListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*CollectionListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *CollectionListRequest) GetIncludeNormalVolumes() bool {
 	if m != nil {
@@ -918,7 +1171,7 @@ type CollectionListResponse struct {
 func (m *CollectionListResponse) Reset()                    { *m = CollectionListResponse{} }
 func (m *CollectionListResponse) String() string            { return proto.CompactTextString(m) }
 func (*CollectionListResponse) ProtoMessage()               {}
-func (*CollectionListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*CollectionListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *CollectionListResponse) GetCollections() []*Collection {
 	if m != nil {
@@ -934,7 +1187,7 @@ type CollectionDeleteRequest struct {
 func (m *CollectionDeleteRequest) Reset()                    { *m = CollectionDeleteRequest{} }
 func (m *CollectionDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*CollectionDeleteRequest) ProtoMessage()               {}
-func (*CollectionDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*CollectionDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *CollectionDeleteRequest) GetName() string {
 	if m != nil {
@@ -949,7 +1202,122 @@ type CollectionDeleteResponse struct {
 func (m *CollectionDeleteResponse) Reset()                    { *m = CollectionDeleteResponse{} }
 func (m *CollectionDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*CollectionDeleteResponse) ProtoMessage()               {}
-func (*CollectionDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*CollectionDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+type CollectionQuotaSetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// 0 means unlimited, both 0 removes the quota
+	QuotaBytes   uint64 `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes" json:"quota_bytes,omitempty"`
+	QuotaVolumes uint64 `protobuf:"varint,3,opt,name=quota_volumes,json=quotaVolumes" json:"quota_volumes,omitempty"`
+}
+
+func (m *CollectionQuotaSetRequest) Reset()                    { *m = CollectionQuotaSetRequest{} }
+func (m *CollectionQuotaSetRequest) String() string            { return proto.CompactTextString(m) }
+func (*CollectionQuotaSetRequest) ProtoMessage()               {}
+func (*CollectionQuotaSetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *CollectionQuotaSetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CollectionQuotaSetRequest) GetQuotaBytes() uint64 {
+	if m != nil {
+		return m.QuotaBytes
+	}
+	return 0
+}
+
+func (m *CollectionQuotaSetRequest) GetQuotaVolumes() uint64 {
+	if m != nil {
+		return m.QuotaVolumes
+	}
+	return 0
+}
+
+type CollectionQuotaSetResponse struct {
+}
+
+func (m *CollectionQuotaSetResponse) Reset()                    { *m = CollectionQuotaSetResponse{} }
+func (m *CollectionQuotaSetResponse) String() string            { return proto.CompactTextString(m) }
+func (*CollectionQuotaSetResponse) ProtoMessage()               {}
+func (*CollectionQuotaSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+type CollectionPlacementSetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// both empty removes the placement constraint
+	RequiredLabels     map[string]string `protobuf:"bytes,2,rep,name=required_labels,json=requiredLabels" json:"required_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AntiAffinityLabels []string          `protobuf:"bytes,3,rep,name=anti_affinity_labels,json=antiAffinityLabels" json:"anti_affinity_labels,omitempty"`
+}
+
+func (m *CollectionPlacementSetRequest) Reset()                    { *m = CollectionPlacementSetRequest{} }
+func (m *CollectionPlacementSetRequest) String() string            { return proto.CompactTextString(m) }
+func (*CollectionPlacementSetRequest) ProtoMessage()               {}
+func (*CollectionPlacementSetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *CollectionPlacementSetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CollectionPlacementSetRequest) GetRequiredLabels() map[string]string {
+	if m != nil {
+		return m.RequiredLabels
+	}
+	return nil
+}
+
+func (m *CollectionPlacementSetRequest) GetAntiAffinityLabels() []string {
+	if m != nil {
+		return m.AntiAffinityLabels
+	}
+	return nil
+}
+
+type CollectionPlacementSetResponse struct {
+}
+
+func (m *CollectionPlacementSetResponse) Reset()                    { *m = CollectionPlacementSetResponse{} }
+func (m *CollectionPlacementSetResponse) String() string            { return proto.CompactTextString(m) }
+func (*CollectionPlacementSetResponse) ProtoMessage()               {}
+func (*CollectionPlacementSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+type MaxVolumeIdRaiseRequest struct {
+	// the new volumes are created after this volume id, it is ignored if not larger than the current one
+	MaxVolumeId uint32 `protobuf:"varint,1,opt,name=max_volume_id,json=maxVolumeId" json:"max_volume_id,omitempty"`
+}
+
+func (m *MaxVolumeIdRaiseRequest) Reset()                    { *m = MaxVolumeIdRaiseRequest{} }
+func (m *MaxVolumeIdRaiseRequest) String() string            { return proto.CompactTextString(m) }
+func (*MaxVolumeIdRaiseRequest) ProtoMessage()               {}
+func (*MaxVolumeIdRaiseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *MaxVolumeIdRaiseRequest) GetMaxVolumeId() uint32 {
+	if m != nil {
+		return m.MaxVolumeId
+	}
+	return 0
+}
+
+type MaxVolumeIdRaiseResponse struct {
+	MaxVolumeId uint32 `protobuf:"varint,1,opt,name=max_volume_id,json=maxVolumeId" json:"max_volume_id,omitempty"`
+}
+
+func (m *MaxVolumeIdRaiseResponse) Reset()                    { *m = MaxVolumeIdRaiseResponse{} }
+func (m *MaxVolumeIdRaiseResponse) String() string            { return proto.CompactTextString(m) }
+func (*MaxVolumeIdRaiseResponse) ProtoMessage()               {}
+func (*MaxVolumeIdRaiseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *MaxVolumeIdRaiseResponse) GetMaxVolumeId() uint32 {
+	if m != nil {
+		return m.MaxVolumeId
+	}
+	return 0
+}
 
 //
 // volume related
@@ -962,12 +1330,14 @@ type DataNodeInfo struct {
 	ActiveVolumeCount uint64                             `protobuf:"varint,5,opt,name=active_volume_count,json=activeVolumeCount" json:"active_volume_count,omitempty"`
 	VolumeInfos       []*VolumeInformationMessage        `protobuf:"bytes,6,rep,name=volume_infos,json=volumeInfos" json:"volume_infos,omitempty"`
 	EcShardInfos      []*VolumeEcShardInformationMessage `protobuf:"bytes,7,rep,name=ec_shard_infos,json=ecShardInfos" json:"ec_shard_infos,omitempty"`
+	LowDiskSpaceDirs  []string                           `protobuf:"bytes,8,rep,name=low_disk_space_dirs,json=lowDiskSpaceDirs" json:"low_disk_space_dirs,omitempty"`
+	Labels            map[string]string                  `protobuf:"bytes,9,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DataNodeInfo) Reset()                    { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string            { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()               {}
-func (*DataNodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*DataNodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *DataNodeInfo) GetId() string {
 	if m != nil {
@@ -1018,6 +1388,20 @@ func (m *DataNodeInfo) GetEcShardInfos() []*VolumeEcShardInformationMessage {
 	return nil
 }
 
+func (m *DataNodeInfo) GetLowDiskSpaceDirs() []string {
+	if m != nil {
+		return m.LowDiskSpaceDirs
+	}
+	return nil
+}
+
+func (m *DataNodeInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type RackInfo struct {
 	Id                string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	VolumeCount       uint64          `protobuf:"varint,2,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
@@ -1030,7 +1414,7 @@ type RackInfo struct {
 func (m *RackInfo) Reset()                    { *m = RackInfo{} }
 func (m *RackInfo) String() string            { return proto.CompactTextString(m) }
 func (*RackInfo) ProtoMessage()               {}
-func (*RackInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*RackInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *RackInfo) GetId() string {
 	if m != nil {
@@ -1086,7 +1470,7 @@ type DataCenterInfo struct {
 func (m *DataCenterInfo) Reset()                    { *m = DataCenterInfo{} }
 func (m *DataCenterInfo) String() string            { return proto.CompactTextString(m) }
 func (*DataCenterInfo) ProtoMessage()               {}
-func (*DataCenterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DataCenterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *DataCenterInfo) GetId() string {
 	if m != nil {
@@ -1142,7 +1526,7 @@ type TopologyInfo struct {
 func (m *TopologyInfo) Reset()                    { *m = TopologyInfo{} }
 func (m *TopologyInfo) String() string            { return proto.CompactTextString(m) }
 func (*TopologyInfo) ProtoMessage()               {}
-func (*TopologyInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*TopologyInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *TopologyInfo) GetId() string {
 	if m != nil {
@@ -1192,7 +1576,7 @@ type VolumeListRequest struct {
 func (m *VolumeListRequest) Reset()                    { *m = VolumeListRequest{} }
 func (m *VolumeListRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeListRequest) ProtoMessage()               {}
-func (*VolumeListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*VolumeListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 type VolumeListResponse struct {
 	TopologyInfo      *TopologyInfo `protobuf:"bytes,1,opt,name=topology_info,json=topologyInfo" json:"topology_info,omitempty"`
@@ -1202,7 +1586,7 @@ type VolumeListResponse struct {
 func (m *VolumeListResponse) Reset()                    { *m = VolumeListResponse{} }
 func (m *VolumeListResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeListResponse) ProtoMessage()               {}
-func (*VolumeListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*VolumeListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *VolumeListResponse) GetTopologyInfo() *TopologyInfo {
 	if m != nil {
@@ -1225,7 +1609,7 @@ type LookupEcVolumeRequest struct {
 func (m *LookupEcVolumeRequest) Reset()                    { *m = LookupEcVolumeRequest{} }
 func (m *LookupEcVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LookupEcVolumeRequest) ProtoMessage()               {}
-func (*LookupEcVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*LookupEcVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *LookupEcVolumeRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1242,7 +1626,7 @@ type LookupEcVolumeResponse struct {
 func (m *LookupEcVolumeResponse) Reset()                    { *m = LookupEcVolumeResponse{} }
 func (m *LookupEcVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LookupEcVolumeResponse) ProtoMessage()               {}
-func (*LookupEcVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*LookupEcVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *LookupEcVolumeResponse) GetVolumeId() uint32 {
 	if m != nil {
@@ -1269,7 +1653,7 @@ func (m *LookupEcVolumeResponse_EcShardIdLocation) Reset() {
 func (m *LookupEcVolumeResponse_EcShardIdLocation) String() string { return proto.CompactTextString(m) }
 func (*LookupEcVolumeResponse_EcShardIdLocation) ProtoMessage()    {}
 func (*LookupEcVolumeResponse_EcShardIdLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{29, 0}
+	return fileDescriptor0, []int{37, 0}
 }
 
 func (m *LookupEcVolumeResponse_EcShardIdLocation) GetShardId() uint32 {
@@ -1292,7 +1676,7 @@ type GetMasterConfigurationRequest struct {
 func (m *GetMasterConfigurationRequest) Reset()                    { *m = GetMasterConfigurationRequest{} }
 func (m *GetMasterConfigurationRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetMasterConfigurationRequest) ProtoMessage()               {}
-func (*GetMasterConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*GetMasterConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 type GetMasterConfigurationResponse struct {
 	MetricsAddress         string `protobuf:"bytes,1,opt,name=metrics_address,json=metricsAddress" json:"metrics_address,omitempty"`
@@ -1302,7 +1686,7 @@ type GetMasterConfigurationResponse struct {
 func (m *GetMasterConfigurationResponse) Reset()                    { *m = GetMasterConfigurationResponse{} }
 func (m *GetMasterConfigurationResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetMasterConfigurationResponse) ProtoMessage()               {}
-func (*GetMasterConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*GetMasterConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *GetMasterConfigurationResponse) GetMetricsAddress() string {
 	if m != nil {
@@ -1318,50 +1702,489 @@ func (m *GetMasterConfigurationResponse) GetMetricsIntervalSeconds() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Heartbeat)(nil), "master_pb.Heartbeat")
-	proto.RegisterType((*HeartbeatResponse)(nil), "master_pb.HeartbeatResponse")
-	proto.RegisterType((*VolumeInformationMessage)(nil), "master_pb.VolumeInformationMessage")
-	proto.RegisterType((*VolumeShortInformationMessage)(nil), "master_pb.VolumeShortInformationMessage")
-	proto.RegisterType((*VolumeEcShardInformationMessage)(nil), "master_pb.VolumeEcShardInformationMessage")
-	proto.RegisterType((*Empty)(nil), "master_pb.Empty")
-	proto.RegisterType((*SuperBlockExtra)(nil), "master_pb.SuperBlockExtra")
-	proto.RegisterType((*SuperBlockExtra_ErasureCoding)(nil), "master_pb.SuperBlockExtra.ErasureCoding")
-	proto.RegisterType((*ClientListenRequest)(nil), "master_pb.ClientListenRequest")
-	proto.RegisterType((*VolumeLocation)(nil), "master_pb.VolumeLocation")
-	proto.RegisterType((*LookupVolumeRequest)(nil), "master_pb.LookupVolumeRequest")
-	proto.RegisterType((*LookupVolumeResponse)(nil), "master_pb.LookupVolumeResponse")
-	proto.RegisterType((*LookupVolumeResponse_VolumeIdLocation)(nil), "master_pb.LookupVolumeResponse.VolumeIdLocation")
-	proto.RegisterType((*Location)(nil), "master_pb.Location")
-	proto.RegisterType((*AssignRequest)(nil), "master_pb.AssignRequest")
-	proto.RegisterType((*AssignResponse)(nil), "master_pb.AssignResponse")
-	proto.RegisterType((*StatisticsRequest)(nil), "master_pb.StatisticsRequest")
-	proto.RegisterType((*StatisticsResponse)(nil), "master_pb.StatisticsResponse")
-	proto.RegisterType((*StorageType)(nil), "master_pb.StorageType")
-	proto.RegisterType((*Collection)(nil), "master_pb.Collection")
-	proto.RegisterType((*CollectionListRequest)(nil), "master_pb.CollectionListRequest")
-	proto.RegisterType((*CollectionListResponse)(nil), "master_pb.CollectionListResponse")
-	proto.RegisterType((*CollectionDeleteRequest)(nil), "master_pb.CollectionDeleteRequest")
-	proto.RegisterType((*CollectionDeleteResponse)(nil), "master_pb.CollectionDeleteResponse")
-	proto.RegisterType((*DataNodeInfo)(nil), "master_pb.DataNodeInfo")
-	proto.RegisterType((*RackInfo)(nil), "master_pb.RackInfo")
-	proto.RegisterType((*DataCenterInfo)(nil), "master_pb.DataCenterInfo")
-	proto.RegisterType((*TopologyInfo)(nil), "master_pb.TopologyInfo")
-	proto.RegisterType((*VolumeListRequest)(nil), "master_pb.VolumeListRequest")
-	proto.RegisterType((*VolumeListResponse)(nil), "master_pb.VolumeListResponse")
-	proto.RegisterType((*LookupEcVolumeRequest)(nil), "master_pb.LookupEcVolumeRequest")
-	proto.RegisterType((*LookupEcVolumeResponse)(nil), "master_pb.LookupEcVolumeResponse")
-	proto.RegisterType((*LookupEcVolumeResponse_EcShardIdLocation)(nil), "master_pb.LookupEcVolumeResponse.EcShardIdLocation")
-	proto.RegisterType((*GetMasterConfigurationRequest)(nil), "master_pb.GetMasterConfigurationRequest")
-	proto.RegisterType((*GetMasterConfigurationResponse)(nil), "master_pb.GetMasterConfigurationResponse")
+type VolumeRepairRequest struct {
+	Pause  bool `protobuf:"varint,1,opt,name=pause" json:"pause,omitempty"`
+	Resume bool `protobuf:"varint,2,opt,name=resume" json:"resume,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *VolumeRepairRequest) Reset()                    { *m = VolumeRepairRequest{} }
+func (m *VolumeRepairRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRepairRequest) ProtoMessage()               {}
+func (*VolumeRepairRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
+func (m *VolumeRepairRequest) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+func (m *VolumeRepairRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+type VolumeRepairResponse struct {
+	IsEnabled          bool                                 `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled" json:"is_enabled,omitempty"`
+	IsPaused           bool                                 `protobuf:"varint,2,opt,name=is_paused,json=isPaused" json:"is_paused,omitempty"`
+	GracePeriodSeconds uint32                               `protobuf:"varint,3,opt,name=grace_period_seconds,json=gracePeriodSeconds" json:"grace_period_seconds,omitempty"`
+	LostDataNodes      []*VolumeRepairResponse_LostDataNode `protobuf:"bytes,4,rep,name=lost_data_nodes,json=lostDataNodes" json:"lost_data_nodes,omitempty"`
+	PendingTaskCount   uint32                               `protobuf:"varint,5,opt,name=pending_task_count,json=pendingTaskCount" json:"pending_task_count,omitempty"`
+	RunningTasks       []string                             `protobuf:"bytes,6,rep,name=running_tasks,json=runningTasks" json:"running_tasks,omitempty"`
+	SucceededTaskCount uint64                               `protobuf:"varint,7,opt,name=succeeded_task_count,json=succeededTaskCount" json:"succeeded_task_count,omitempty"`
+	SkippedTaskCount   uint64                               `protobuf:"varint,8,opt,name=skipped_task_count,json=skippedTaskCount" json:"skipped_task_count,omitempty"`
+	FailedTaskCount    uint64                               `protobuf:"varint,9,opt,name=failed_task_count,json=failedTaskCount" json:"failed_task_count,omitempty"`
+	LastError          string                               `protobuf:"bytes,10,opt,name=last_error,json=lastError" json:"last_error,omitempty"`
+}
+
+func (m *VolumeRepairResponse) Reset()                    { *m = VolumeRepairResponse{} }
+func (m *VolumeRepairResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRepairResponse) ProtoMessage()               {}
+func (*VolumeRepairResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *VolumeRepairResponse) GetIsEnabled() bool {
+	if m != nil {
+		return m.IsEnabled
+	}
+	return false
+}
+
+func (m *VolumeRepairResponse) GetIsPaused() bool {
+	if m != nil {
+		return m.IsPaused
+	}
+	return false
+}
+
+func (m *VolumeRepairResponse) GetGracePeriodSeconds() uint32 {
+	if m != nil {
+		return m.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse) GetLostDataNodes() []*VolumeRepairResponse_LostDataNode {
+	if m != nil {
+		return m.LostDataNodes
+	}
+	return nil
+}
+
+func (m *VolumeRepairResponse) GetPendingTaskCount() uint32 {
+	if m != nil {
+		return m.PendingTaskCount
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse) GetRunningTasks() []string {
+	if m != nil {
+		return m.RunningTasks
+	}
+	return nil
+}
+
+func (m *VolumeRepairResponse) GetSucceededTaskCount() uint64 {
+	if m != nil {
+		return m.SucceededTaskCount
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse) GetSkippedTaskCount() uint64 {
+	if m != nil {
+		return m.SkippedTaskCount
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse) GetFailedTaskCount() uint64 {
+	if m != nil {
+		return m.FailedTaskCount
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type VolumeRepairResponse_LostDataNode struct {
+	Url           string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	LostAtNs      int64  `protobuf:"varint,2,opt,name=lost_at_ns,json=lostAtNs" json:"lost_at_ns,omitempty"`
+	VolumeCount   uint32 `protobuf:"varint,3,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
+	EcVolumeCount uint32 `protobuf:"varint,4,opt,name=ec_volume_count,json=ecVolumeCount" json:"ec_volume_count,omitempty"`
+}
+
+func (m *VolumeRepairResponse_LostDataNode) Reset()         { *m = VolumeRepairResponse_LostDataNode{} }
+func (m *VolumeRepairResponse_LostDataNode) String() string { return proto.CompactTextString(m) }
+func (*VolumeRepairResponse_LostDataNode) ProtoMessage()    {}
+func (*VolumeRepairResponse_LostDataNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{41, 0}
+}
+
+func (m *VolumeRepairResponse_LostDataNode) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *VolumeRepairResponse_LostDataNode) GetLostAtNs() int64 {
+	if m != nil {
+		return m.LostAtNs
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse_LostDataNode) GetVolumeCount() uint32 {
+	if m != nil {
+		return m.VolumeCount
+	}
+	return 0
+}
+
+func (m *VolumeRepairResponse_LostDataNode) GetEcVolumeCount() uint32 {
+	if m != nil {
+		return m.EcVolumeCount
+	}
+	return 0
+}
+
+type RaftListClusterServersRequest struct {
+}
+
+func (m *RaftListClusterServersRequest) Reset()                    { *m = RaftListClusterServersRequest{} }
+func (m *RaftListClusterServersRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftListClusterServersRequest) ProtoMessage()               {}
+func (*RaftListClusterServersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+type RaftListClusterServersResponse struct {
+	ClusterServers []*RaftListClusterServersResponse_ClusterServer `protobuf:"bytes,1,rep,name=cluster_servers,json=clusterServers" json:"cluster_servers,omitempty"`
+	Leader         string                                          `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
+	Term           uint64                                          `protobuf:"varint,3,opt,name=term" json:"term,omitempty"`
+	CommitIndex    uint64                                          `protobuf:"varint,4,opt,name=commit_index,json=commitIndex" json:"commit_index,omitempty"`
+	Server         string                                          `protobuf:"bytes,5,opt,name=server" json:"server,omitempty"`
+	State          string                                          `protobuf:"bytes,6,opt,name=state" json:"state,omitempty"`
+}
+
+func (m *RaftListClusterServersResponse) Reset()                    { *m = RaftListClusterServersResponse{} }
+func (m *RaftListClusterServersResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftListClusterServersResponse) ProtoMessage()               {}
+func (*RaftListClusterServersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *RaftListClusterServersResponse) GetClusterServers() []*RaftListClusterServersResponse_ClusterServer {
+	if m != nil {
+		return m.ClusterServers
+	}
+	return nil
+}
+
+func (m *RaftListClusterServersResponse) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *RaftListClusterServersResponse) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftListClusterServersResponse) GetCommitIndex() uint64 {
+	if m != nil {
+		return m.CommitIndex
+	}
+	return 0
+}
+
+func (m *RaftListClusterServersResponse) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *RaftListClusterServersResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type RaftListClusterServersResponse_ClusterServer struct {
+	Address     string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	GrpcAddress string `protobuf:"bytes,2,opt,name=grpc_address,json=grpcAddress" json:"grpc_address,omitempty"`
+	IsLeader    bool   `protobuf:"varint,3,opt,name=is_leader,json=isLeader" json:"is_leader,omitempty"`
+	// only known by the leader, 0 for the leader itself
+	LastActivityNs int64 `protobuf:"varint,4,opt,name=last_activity_ns,json=lastActivityNs" json:"last_activity_ns,omitempty"`
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) Reset() {
+	*m = RaftListClusterServersResponse_ClusterServer{}
+}
+func (m *RaftListClusterServersResponse_ClusterServer) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RaftListClusterServersResponse_ClusterServer) ProtoMessage() {}
+func (*RaftListClusterServersResponse_ClusterServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{43, 0}
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetGrpcAddress() string {
+	if m != nil {
+		return m.GrpcAddress
+	}
+	return ""
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *RaftListClusterServersResponse_ClusterServer) GetLastActivityNs() int64 {
+	if m != nil {
+		return m.LastActivityNs
+	}
+	return 0
+}
+
+type RaftAddServerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *RaftAddServerRequest) Reset()                    { *m = RaftAddServerRequest{} }
+func (m *RaftAddServerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftAddServerRequest) ProtoMessage()               {}
+func (*RaftAddServerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *RaftAddServerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RaftAddServerResponse struct {
+}
+
+func (m *RaftAddServerResponse) Reset()                    { *m = RaftAddServerResponse{} }
+func (m *RaftAddServerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftAddServerResponse) ProtoMessage()               {}
+func (*RaftAddServerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+type RaftRemoveServerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Force   bool   `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
+}
+
+func (m *RaftRemoveServerRequest) Reset()                    { *m = RaftRemoveServerRequest{} }
+func (m *RaftRemoveServerRequest) String() string            { return proto.CompactTextString(m) }
+func (*RaftRemoveServerRequest) ProtoMessage()               {}
+func (*RaftRemoveServerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *RaftRemoveServerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RaftRemoveServerRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type RaftRemoveServerResponse struct {
+}
+
+func (m *RaftRemoveServerResponse) Reset()                    { *m = RaftRemoveServerResponse{} }
+func (m *RaftRemoveServerResponse) String() string            { return proto.CompactTextString(m) }
+func (*RaftRemoveServerResponse) ProtoMessage()               {}
+func (*RaftRemoveServerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+type LeaseAdminTokenRequest struct {
+	PreviousToken int64  `protobuf:"varint,1,opt,name=previous_token,json=previousToken" json:"previous_token,omitempty"`
+	LockName      string `protobuf:"bytes,2,opt,name=lock_name,json=lockName" json:"lock_name,omitempty"`
+	ClientName    string `protobuf:"bytes,3,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
+}
+
+func (m *LeaseAdminTokenRequest) Reset()                    { *m = LeaseAdminTokenRequest{} }
+func (m *LeaseAdminTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*LeaseAdminTokenRequest) ProtoMessage()               {}
+func (*LeaseAdminTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *LeaseAdminTokenRequest) GetPreviousToken() int64 {
+	if m != nil {
+		return m.PreviousToken
+	}
+	return 0
+}
+
+func (m *LeaseAdminTokenRequest) GetLockName() string {
+	if m != nil {
+		return m.LockName
+	}
+	return ""
+}
+
+func (m *LeaseAdminTokenRequest) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+type LeaseAdminTokenResponse struct {
+	Token           int64 `protobuf:"varint,1,opt,name=token" json:"token,omitempty"`
+	LockTsNs        int64 `protobuf:"varint,2,opt,name=lock_ts_ns,json=lockTsNs" json:"lock_ts_ns,omitempty"`
+	LeaseDurationNs int64 `protobuf:"varint,3,opt,name=lease_duration_ns,json=leaseDurationNs" json:"lease_duration_ns,omitempty"`
+}
+
+func (m *LeaseAdminTokenResponse) Reset()                    { *m = LeaseAdminTokenResponse{} }
+func (m *LeaseAdminTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*LeaseAdminTokenResponse) ProtoMessage()               {}
+func (*LeaseAdminTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *LeaseAdminTokenResponse) GetToken() int64 {
+	if m != nil {
+		return m.Token
+	}
+	return 0
+}
+
+func (m *LeaseAdminTokenResponse) GetLockTsNs() int64 {
+	if m != nil {
+		return m.LockTsNs
+	}
+	return 0
+}
+
+func (m *LeaseAdminTokenResponse) GetLeaseDurationNs() int64 {
+	if m != nil {
+		return m.LeaseDurationNs
+	}
+	return 0
+}
+
+type ReleaseAdminTokenRequest struct {
+	PreviousToken int64  `protobuf:"varint,1,opt,name=previous_token,json=previousToken" json:"previous_token,omitempty"`
+	LockName      string `protobuf:"bytes,2,opt,name=lock_name,json=lockName" json:"lock_name,omitempty"`
+}
+
+func (m *ReleaseAdminTokenRequest) Reset()                    { *m = ReleaseAdminTokenRequest{} }
+func (m *ReleaseAdminTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseAdminTokenRequest) ProtoMessage()               {}
+func (*ReleaseAdminTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *ReleaseAdminTokenRequest) GetPreviousToken() int64 {
+	if m != nil {
+		return m.PreviousToken
+	}
+	return 0
+}
+
+func (m *ReleaseAdminTokenRequest) GetLockName() string {
+	if m != nil {
+		return m.LockName
+	}
+	return ""
+}
+
+type ReleaseAdminTokenResponse struct {
+}
+
+func (m *ReleaseAdminTokenResponse) Reset()                    { *m = ReleaseAdminTokenResponse{} }
+func (m *ReleaseAdminTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseAdminTokenResponse) ProtoMessage()               {}
+func (*ReleaseAdminTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func init() {
+	proto.RegisterType((*Heartbeat)(nil), "master_pb.Heartbeat")
+	proto.RegisterType((*HeartbeatResponse)(nil), "master_pb.HeartbeatResponse")
+	proto.RegisterType((*VolumeInformationMessage)(nil), "master_pb.VolumeInformationMessage")
+	proto.RegisterType((*VolumeShortInformationMessage)(nil), "master_pb.VolumeShortInformationMessage")
+	proto.RegisterType((*VolumeEcShardInformationMessage)(nil), "master_pb.VolumeEcShardInformationMessage")
+	proto.RegisterType((*Empty)(nil), "master_pb.Empty")
+	proto.RegisterType((*SuperBlockExtra)(nil), "master_pb.SuperBlockExtra")
+	proto.RegisterType((*SuperBlockExtra_ErasureCoding)(nil), "master_pb.SuperBlockExtra.ErasureCoding")
+	proto.RegisterType((*ClientListenRequest)(nil), "master_pb.ClientListenRequest")
+	proto.RegisterType((*VolumeLocation)(nil), "master_pb.VolumeLocation")
+	proto.RegisterType((*WatchTopologyRequest)(nil), "master_pb.WatchTopologyRequest")
+	proto.RegisterType((*TopologyEvent)(nil), "master_pb.TopologyEvent")
+	proto.RegisterType((*LookupVolumeRequest)(nil), "master_pb.LookupVolumeRequest")
+	proto.RegisterType((*LookupVolumeResponse)(nil), "master_pb.LookupVolumeResponse")
+	proto.RegisterType((*LookupVolumeResponse_VolumeIdLocation)(nil), "master_pb.LookupVolumeResponse.VolumeIdLocation")
+	proto.RegisterType((*Location)(nil), "master_pb.Location")
+	proto.RegisterType((*AssignRequest)(nil), "master_pb.AssignRequest")
+	proto.RegisterType((*AssignResponse)(nil), "master_pb.AssignResponse")
+	proto.RegisterType((*StatisticsRequest)(nil), "master_pb.StatisticsRequest")
+	proto.RegisterType((*StatisticsResponse)(nil), "master_pb.StatisticsResponse")
+	proto.RegisterType((*StorageType)(nil), "master_pb.StorageType")
+	proto.RegisterType((*Collection)(nil), "master_pb.Collection")
+	proto.RegisterType((*CollectionListRequest)(nil), "master_pb.CollectionListRequest")
+	proto.RegisterType((*CollectionListResponse)(nil), "master_pb.CollectionListResponse")
+	proto.RegisterType((*CollectionDeleteRequest)(nil), "master_pb.CollectionDeleteRequest")
+	proto.RegisterType((*CollectionDeleteResponse)(nil), "master_pb.CollectionDeleteResponse")
+	proto.RegisterType((*CollectionQuotaSetRequest)(nil), "master_pb.CollectionQuotaSetRequest")
+	proto.RegisterType((*CollectionQuotaSetResponse)(nil), "master_pb.CollectionQuotaSetResponse")
+	proto.RegisterType((*CollectionPlacementSetRequest)(nil), "master_pb.CollectionPlacementSetRequest")
+	proto.RegisterType((*CollectionPlacementSetResponse)(nil), "master_pb.CollectionPlacementSetResponse")
+	proto.RegisterType((*MaxVolumeIdRaiseRequest)(nil), "master_pb.MaxVolumeIdRaiseRequest")
+	proto.RegisterType((*MaxVolumeIdRaiseResponse)(nil), "master_pb.MaxVolumeIdRaiseResponse")
+	proto.RegisterType((*DataNodeInfo)(nil), "master_pb.DataNodeInfo")
+	proto.RegisterType((*RackInfo)(nil), "master_pb.RackInfo")
+	proto.RegisterType((*DataCenterInfo)(nil), "master_pb.DataCenterInfo")
+	proto.RegisterType((*TopologyInfo)(nil), "master_pb.TopologyInfo")
+	proto.RegisterType((*VolumeListRequest)(nil), "master_pb.VolumeListRequest")
+	proto.RegisterType((*VolumeListResponse)(nil), "master_pb.VolumeListResponse")
+	proto.RegisterType((*LookupEcVolumeRequest)(nil), "master_pb.LookupEcVolumeRequest")
+	proto.RegisterType((*LookupEcVolumeResponse)(nil), "master_pb.LookupEcVolumeResponse")
+	proto.RegisterType((*LookupEcVolumeResponse_EcShardIdLocation)(nil), "master_pb.LookupEcVolumeResponse.EcShardIdLocation")
+	proto.RegisterType((*GetMasterConfigurationRequest)(nil), "master_pb.GetMasterConfigurationRequest")
+	proto.RegisterType((*GetMasterConfigurationResponse)(nil), "master_pb.GetMasterConfigurationResponse")
+	proto.RegisterType((*VolumeRepairRequest)(nil), "master_pb.VolumeRepairRequest")
+	proto.RegisterType((*VolumeRepairResponse)(nil), "master_pb.VolumeRepairResponse")
+	proto.RegisterType((*VolumeRepairResponse_LostDataNode)(nil), "master_pb.VolumeRepairResponse.LostDataNode")
+	proto.RegisterType((*RaftListClusterServersRequest)(nil), "master_pb.RaftListClusterServersRequest")
+	proto.RegisterType((*RaftListClusterServersResponse)(nil), "master_pb.RaftListClusterServersResponse")
+	proto.RegisterType((*RaftListClusterServersResponse_ClusterServer)(nil), "master_pb.RaftListClusterServersResponse.ClusterServer")
+	proto.RegisterType((*RaftAddServerRequest)(nil), "master_pb.RaftAddServerRequest")
+	proto.RegisterType((*RaftAddServerResponse)(nil), "master_pb.RaftAddServerResponse")
+	proto.RegisterType((*RaftRemoveServerRequest)(nil), "master_pb.RaftRemoveServerRequest")
+	proto.RegisterType((*RaftRemoveServerResponse)(nil), "master_pb.RaftRemoveServerResponse")
+	proto.RegisterType((*LeaseAdminTokenRequest)(nil), "master_pb.LeaseAdminTokenRequest")
+	proto.RegisterType((*LeaseAdminTokenResponse)(nil), "master_pb.LeaseAdminTokenResponse")
+	proto.RegisterType((*ReleaseAdminTokenRequest)(nil), "master_pb.ReleaseAdminTokenRequest")
+	proto.RegisterType((*ReleaseAdminTokenResponse)(nil), "master_pb.ReleaseAdminTokenResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
 // Client API for Seaweed service
@@ -1374,9 +2197,19 @@ type SeaweedClient interface {
 	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
 	CollectionList(ctx context.Context, in *CollectionListRequest, opts ...grpc.CallOption) (*CollectionListResponse, error)
 	CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteResponse, error)
+	CollectionQuotaSet(ctx context.Context, in *CollectionQuotaSetRequest, opts ...grpc.CallOption) (*CollectionQuotaSetResponse, error)
+	CollectionPlacementSet(ctx context.Context, in *CollectionPlacementSetRequest, opts ...grpc.CallOption) (*CollectionPlacementSetResponse, error)
+	MaxVolumeIdRaise(ctx context.Context, in *MaxVolumeIdRaiseRequest, opts ...grpc.CallOption) (*MaxVolumeIdRaiseResponse, error)
 	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
 	LookupEcVolume(ctx context.Context, in *LookupEcVolumeRequest, opts ...grpc.CallOption) (*LookupEcVolumeResponse, error)
 	GetMasterConfiguration(ctx context.Context, in *GetMasterConfigurationRequest, opts ...grpc.CallOption) (*GetMasterConfigurationResponse, error)
+	VolumeRepair(ctx context.Context, in *VolumeRepairRequest, opts ...grpc.CallOption) (*VolumeRepairResponse, error)
+	RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error)
+	RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error)
+	RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error)
+	WatchTopology(ctx context.Context, in *WatchTopologyRequest, opts ...grpc.CallOption) (Seaweed_WatchTopologyClient, error)
+	LeaseAdminToken(ctx context.Context, in *LeaseAdminTokenRequest, opts ...grpc.CallOption) (*LeaseAdminTokenResponse, error)
+	ReleaseAdminToken(ctx context.Context, in *ReleaseAdminTokenRequest, opts ...grpc.CallOption) (*ReleaseAdminTokenResponse, error)
 }
 
 type seaweedClient struct {
@@ -1494,6 +2327,33 @@ func (c *seaweedClient) CollectionDelete(ctx context.Context, in *CollectionDele
 	return out, nil
 }
 
+func (c *seaweedClient) CollectionQuotaSet(ctx context.Context, in *CollectionQuotaSetRequest, opts ...grpc.CallOption) (*CollectionQuotaSetResponse, error) {
+	out := new(CollectionQuotaSetResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/CollectionQuotaSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) CollectionPlacementSet(ctx context.Context, in *CollectionPlacementSetRequest, opts ...grpc.CallOption) (*CollectionPlacementSetResponse, error) {
+	out := new(CollectionPlacementSetResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/CollectionPlacementSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) MaxVolumeIdRaise(ctx context.Context, in *MaxVolumeIdRaiseRequest, opts ...grpc.CallOption) (*MaxVolumeIdRaiseResponse, error) {
+	out := new(MaxVolumeIdRaiseResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/MaxVolumeIdRaise", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *seaweedClient) VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error) {
 	out := new(VolumeListResponse)
 	err := grpc.Invoke(ctx, "/master_pb.Seaweed/VolumeList", in, out, c.cc, opts...)
@@ -1521,6 +2381,92 @@ func (c *seaweedClient) GetMasterConfiguration(ctx context.Context, in *GetMaste
 	return out, nil
 }
 
+func (c *seaweedClient) VolumeRepair(ctx context.Context, in *VolumeRepairRequest, opts ...grpc.CallOption) (*VolumeRepairResponse, error) {
+	out := new(VolumeRepairResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/VolumeRepair", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftListClusterServers(ctx context.Context, in *RaftListClusterServersRequest, opts ...grpc.CallOption) (*RaftListClusterServersResponse, error) {
+	out := new(RaftListClusterServersResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftListClusterServers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftAddServer(ctx context.Context, in *RaftAddServerRequest, opts ...grpc.CallOption) (*RaftAddServerResponse, error) {
+	out := new(RaftAddServerResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftAddServer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) RaftRemoveServer(ctx context.Context, in *RaftRemoveServerRequest, opts ...grpc.CallOption) (*RaftRemoveServerResponse, error) {
+	out := new(RaftRemoveServerResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/RaftRemoveServer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) WatchTopology(ctx context.Context, in *WatchTopologyRequest, opts ...grpc.CallOption) (Seaweed_WatchTopologyClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Seaweed_serviceDesc.Streams[2], c.cc, "/master_pb.Seaweed/WatchTopology", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedWatchTopologyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Seaweed_WatchTopologyClient interface {
+	Recv() (*TopologyEvent, error)
+	grpc.ClientStream
+}
+
+type seaweedWatchTopologyClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedWatchTopologyClient) Recv() (*TopologyEvent, error) {
+	m := new(TopologyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedClient) LeaseAdminToken(ctx context.Context, in *LeaseAdminTokenRequest, opts ...grpc.CallOption) (*LeaseAdminTokenResponse, error) {
+	out := new(LeaseAdminTokenResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/LeaseAdminToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedClient) ReleaseAdminToken(ctx context.Context, in *ReleaseAdminTokenRequest, opts ...grpc.CallOption) (*ReleaseAdminTokenResponse, error) {
+	out := new(ReleaseAdminTokenResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/ReleaseAdminToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Seaweed service
 
 type SeaweedServer interface {
@@ -1531,9 +2477,19 @@ type SeaweedServer interface {
 	Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
 	CollectionList(context.Context, *CollectionListRequest) (*CollectionListResponse, error)
 	CollectionDelete(context.Context, *CollectionDeleteRequest) (*CollectionDeleteResponse, error)
+	CollectionQuotaSet(context.Context, *CollectionQuotaSetRequest) (*CollectionQuotaSetResponse, error)
+	CollectionPlacementSet(context.Context, *CollectionPlacementSetRequest) (*CollectionPlacementSetResponse, error)
+	MaxVolumeIdRaise(context.Context, *MaxVolumeIdRaiseRequest) (*MaxVolumeIdRaiseResponse, error)
 	VolumeList(context.Context, *VolumeListRequest) (*VolumeListResponse, error)
 	LookupEcVolume(context.Context, *LookupEcVolumeRequest) (*LookupEcVolumeResponse, error)
 	GetMasterConfiguration(context.Context, *GetMasterConfigurationRequest) (*GetMasterConfigurationResponse, error)
+	VolumeRepair(context.Context, *VolumeRepairRequest) (*VolumeRepairResponse, error)
+	RaftListClusterServers(context.Context, *RaftListClusterServersRequest) (*RaftListClusterServersResponse, error)
+	RaftAddServer(context.Context, *RaftAddServerRequest) (*RaftAddServerResponse, error)
+	RaftRemoveServer(context.Context, *RaftRemoveServerRequest) (*RaftRemoveServerResponse, error)
+	WatchTopology(*WatchTopologyRequest, Seaweed_WatchTopologyServer) error
+	LeaseAdminToken(context.Context, *LeaseAdminTokenRequest) (*LeaseAdminTokenResponse, error)
+	ReleaseAdminToken(context.Context, *ReleaseAdminTokenRequest) (*ReleaseAdminTokenResponse, error)
 }
 
 func RegisterSeaweedServer(s *grpc.Server, srv SeaweedServer) {
@@ -1682,6 +2638,60 @@ func _Seaweed_CollectionDelete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seaweed_CollectionQuotaSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionQuotaSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).CollectionQuotaSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/CollectionQuotaSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).CollectionQuotaSet(ctx, req.(*CollectionQuotaSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_CollectionPlacementSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionPlacementSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).CollectionPlacementSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/CollectionPlacementSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).CollectionPlacementSet(ctx, req.(*CollectionPlacementSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_MaxVolumeIdRaise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaxVolumeIdRaiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).MaxVolumeIdRaise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/MaxVolumeIdRaise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).MaxVolumeIdRaise(ctx, req.(*MaxVolumeIdRaiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Seaweed_VolumeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VolumeListRequest)
 	if err := dec(in); err != nil {
@@ -1736,6 +2746,135 @@ func _Seaweed_GetMasterConfiguration_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seaweed_VolumeRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).VolumeRepair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/VolumeRepair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).VolumeRepair(ctx, req.(*VolumeRepairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftListClusterServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftListClusterServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftListClusterServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftListClusterServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftListClusterServers(ctx, req.(*RaftListClusterServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftAddServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftAddServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftAddServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftAddServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftAddServer(ctx, req.(*RaftAddServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_RaftRemoveServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftRemoveServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).RaftRemoveServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/RaftRemoveServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).RaftRemoveServer(ctx, req.(*RaftRemoveServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_WatchTopology_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTopologyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedServer).WatchTopology(m, &seaweedWatchTopologyServer{stream})
+}
+
+type Seaweed_WatchTopologyServer interface {
+	Send(*TopologyEvent) error
+	grpc.ServerStream
+}
+
+type seaweedWatchTopologyServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedWatchTopologyServer) Send(m *TopologyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Seaweed_LeaseAdminToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseAdminTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).LeaseAdminToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/LeaseAdminToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).LeaseAdminToken(ctx, req.(*LeaseAdminTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Seaweed_ReleaseAdminToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAdminTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).ReleaseAdminToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/ReleaseAdminToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).ReleaseAdminToken(ctx, req.(*ReleaseAdminTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Seaweed_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "master_pb.Seaweed",
 	HandlerType: (*SeaweedServer)(nil),
@@ -1760,6 +2899,18 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollectionDelete",
 			Handler:    _Seaweed_CollectionDelete_Handler,
 		},
+		{
+			MethodName: "CollectionQuotaSet",
+			Handler:    _Seaweed_CollectionQuotaSet_Handler,
+		},
+		{
+			MethodName: "CollectionPlacementSet",
+			Handler:    _Seaweed_CollectionPlacementSet_Handler,
+		},
+		{
+			MethodName: "MaxVolumeIdRaise",
+			Handler:    _Seaweed_MaxVolumeIdRaise_Handler,
+		},
 		{
 			MethodName: "VolumeList",
 			Handler:    _Seaweed_VolumeList_Handler,
@@ -1772,6 +2923,30 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMasterConfiguration",
 			Handler:    _Seaweed_GetMasterConfiguration_Handler,
 		},
+		{
+			MethodName: "VolumeRepair",
+			Handler:    _Seaweed_VolumeRepair_Handler,
+		},
+		{
+			MethodName: "RaftListClusterServers",
+			Handler:    _Seaweed_RaftListClusterServers_Handler,
+		},
+		{
+			MethodName: "RaftAddServer",
+			Handler:    _Seaweed_RaftAddServer_Handler,
+		},
+		{
+			MethodName: "RaftRemoveServer",
+			Handler:    _Seaweed_RaftRemoveServer_Handler,
+		},
+		{
+			MethodName: "LeaseAdminToken",
+			Handler:    _Seaweed_LeaseAdminToken_Handler,
+		},
+		{
+			MethodName: "ReleaseAdminToken",
+			Handler:    _Seaweed_ReleaseAdminToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1786,6 +2961,11 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchTopology",
+			Handler:       _Seaweed_WatchTopology_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "master.proto",
 }
@@ -1793,123 +2973,203 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("master.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0xcf, 0x8c, 0xc7, 0x33, 0x6f, 0x3e, 0x3c, 0x53, 0x76, 0xbc, 0x93, 0x59, 0x92, 0x4c,
-	0x7a, 0x91, 0xd6, 0x09, 0x8b, 0x59, 0xb2, 0x2b, 0x81, 0x04, 0x68, 0x95, 0x38, 0xde, 0xc5, 0xca,
-	0xc7, 0x3a, 0x3d, 0x21, 0x48, 0x48, 0xa8, 0xa9, 0xe9, 0x2e, 0xdb, 0x25, 0xf7, 0x74, 0x37, 0x5d,
-	0x35, 0x8e, 0x67, 0x39, 0x70, 0x80, 0x1b, 0x12, 0x17, 0xce, 0xdc, 0xf9, 0x1b, 0x38, 0x70, 0xe1,
-	0xc8, 0x9d, 0x3b, 0xff, 0x02, 0x57, 0x84, 0xb4, 0xaa, 0xaf, 0xee, 0xea, 0x99, 0xb1, 0x1d, 0xaf,
-	0xb4, 0x87, 0xdc, 0xaa, 0xdf, 0x7b, 0xf5, 0xde, 0xab, 0xdf, 0xab, 0xf7, 0x51, 0x0d, 0xed, 0x29,
-	0x66, 0x9c, 0x64, 0xbb, 0x69, 0x96, 0xf0, 0x04, 0x35, 0xd5, 0x97, 0x9f, 0x4e, 0xdc, 0x3f, 0xd5,
-	0xa1, 0xf9, 0x73, 0x82, 0x33, 0x3e, 0x21, 0x98, 0xa3, 0x2e, 0x54, 0x68, 0x3a, 0x70, 0x46, 0xce,
-	0x4e, 0xd3, 0xab, 0xd0, 0x14, 0x21, 0xa8, 0xa5, 0x49, 0xc6, 0x07, 0x95, 0x91, 0xb3, 0xd3, 0xf1,
-	0xe4, 0x1a, 0xdd, 0x06, 0x48, 0x67, 0x93, 0x88, 0x06, 0xfe, 0x2c, 0x8b, 0x06, 0x55, 0x29, 0xdb,
-	0x54, 0x94, 0x5f, 0x64, 0x11, 0xda, 0x81, 0xde, 0x14, 0x9f, 0xfb, 0x67, 0x49, 0x34, 0x9b, 0x12,
-	0x3f, 0x48, 0x66, 0x31, 0x1f, 0xd4, 0xe4, 0xf6, 0xee, 0x14, 0x9f, 0xbf, 0x96, 0xe4, 0x3d, 0x41,
-	0x45, 0x23, 0xe1, 0xd5, 0xb9, 0x7f, 0x44, 0x23, 0xe2, 0x9f, 0x92, 0xf9, 0x60, 0x6d, 0xe4, 0xec,
-	0xd4, 0x3c, 0x98, 0xe2, 0xf3, 0xcf, 0x69, 0x44, 0x9e, 0x92, 0x39, 0xba, 0x0b, 0xad, 0x10, 0x73,
-	0xec, 0x07, 0x24, 0xe6, 0x24, 0x1b, 0xd4, 0xa5, 0x2d, 0x10, 0xa4, 0x3d, 0x49, 0x11, 0xfe, 0x65,
-	0x38, 0x38, 0x1d, 0xac, 0x4b, 0x8e, 0x5c, 0x0b, 0xff, 0x70, 0x38, 0xa5, 0xb1, 0x2f, 0x3d, 0x6f,
-	0x48, 0xd3, 0x4d, 0x49, 0x39, 0x14, 0xee, 0xff, 0x0c, 0xd6, 0x95, 0x6f, 0x6c, 0xd0, 0x1c, 0x55,
-	0x77, 0x5a, 0x0f, 0x3f, 0xd8, 0xcd, 0xd1, 0xd8, 0x55, 0xee, 0x1d, 0xc4, 0x47, 0x49, 0x36, 0xc5,
-	0x9c, 0x26, 0xf1, 0x73, 0xc2, 0x18, 0x3e, 0x26, 0x9e, 0xd9, 0x83, 0x0e, 0xa0, 0x15, 0x93, 0x37,
-	0xbe, 0x51, 0x01, 0x52, 0xc5, 0xce, 0x92, 0x8a, 0xf1, 0x49, 0x92, 0xf1, 0x15, 0x7a, 0x20, 0x26,
-	0x6f, 0x5e, 0x6b, 0x55, 0x2f, 0x61, 0x23, 0x24, 0x11, 0xe1, 0x24, 0xcc, 0xd5, 0xb5, 0xae, 0xa9,
-	0xae, 0xab, 0x15, 0x18, 0x95, 0xdf, 0x85, 0xee, 0x09, 0x66, 0x7e, 0x9c, 0xe4, 0x1a, 0xdb, 0x23,
-	0x67, 0xa7, 0xe1, 0xb5, 0x4f, 0x30, 0x7b, 0x91, 0x18, 0xa9, 0x2f, 0xa0, 0x49, 0x02, 0x9f, 0x9d,
-	0xe0, 0x2c, 0x64, 0x83, 0x9e, 0x34, 0xf9, 0x60, 0xc9, 0xe4, 0x7e, 0x30, 0x16, 0x02, 0x2b, 0x8c,
-	0x36, 0x88, 0x62, 0x31, 0xf4, 0x02, 0x3a, 0x02, 0x8c, 0x42, 0x59, 0xff, 0xda, 0xca, 0x04, 0x9a,
-	0xfb, 0x46, 0xdf, 0x6b, 0xe8, 0x1b, 0x44, 0x0a, 0x9d, 0xe8, 0xda, 0x3a, 0x0d, 0xac, 0xb9, 0xde,
-	0x0f, 0xa1, 0xa7, 0x61, 0x29, 0xd4, 0x6e, 0x4a, 0x60, 0x3a, 0x12, 0x18, 0x23, 0xe8, 0xfe, 0xdd,
-	0x81, 0x7e, 0x9e, 0x0d, 0x1e, 0x61, 0x69, 0x12, 0x33, 0x82, 0x1e, 0x40, 0x5f, 0x5f, 0x67, 0x46,
-	0xbf, 0x22, 0x7e, 0x44, 0xa7, 0x94, 0xcb, 0x24, 0xa9, 0x79, 0x1b, 0x8a, 0x31, 0xa6, 0x5f, 0x91,
-	0x67, 0x82, 0x8c, 0xb6, 0xa1, 0x1e, 0x11, 0x1c, 0x92, 0x4c, 0xe6, 0x4c, 0xd3, 0xd3, 0x5f, 0xe8,
-	0x43, 0xd8, 0x98, 0x12, 0x9e, 0xd1, 0x80, 0xf9, 0x38, 0x0c, 0x33, 0xc2, 0x98, 0x4e, 0x9d, 0xae,
-	0x26, 0x3f, 0x52, 0x54, 0xf4, 0x63, 0x18, 0x18, 0x41, 0x2a, 0xee, 0xf8, 0x19, 0x8e, 0x7c, 0x46,
-	0x82, 0x24, 0x0e, 0x99, 0xce, 0xa3, 0x6d, 0xcd, 0x3f, 0xd0, 0xec, 0xb1, 0xe2, 0xba, 0x7f, 0xad,
-	0xc2, 0xe0, 0xa2, 0x0b, 0x2c, 0x33, 0x3b, 0x94, 0x4e, 0x77, 0xbc, 0x0a, 0x0d, 0x45, 0xe6, 0x88,
-	0xc3, 0x48, 0x2f, 0x6b, 0x9e, 0x5c, 0xa3, 0x3b, 0x00, 0x41, 0x12, 0x45, 0x24, 0x10, 0x1b, 0xb5,
-	0x7b, 0x16, 0x45, 0x64, 0x96, 0x4c, 0xd6, 0x22, 0xa9, 0x6b, 0x5e, 0x53, 0x50, 0x54, 0x3e, 0xdf,
-	0x83, 0xb6, 0x02, 0x5e, 0x0b, 0xa8, 0x7c, 0x6e, 0x29, 0x9a, 0x12, 0xf9, 0x08, 0x90, 0x09, 0xf0,
-	0x64, 0x9e, 0x0b, 0xd6, 0xa5, 0x60, 0x4f, 0x73, 0x1e, 0xcf, 0x8d, 0xf4, 0xfb, 0xd0, 0xcc, 0x08,
-	0x0e, 0xfd, 0x24, 0x8e, 0xe6, 0x32, 0xc5, 0x1b, 0x5e, 0x43, 0x10, 0xbe, 0x8c, 0xa3, 0x39, 0xfa,
-	0x1e, 0xf4, 0x33, 0x92, 0x46, 0x34, 0xc0, 0x7e, 0x1a, 0xe1, 0x80, 0x4c, 0x49, 0x6c, 0xb2, 0xbd,
-	0xa7, 0x19, 0x87, 0x86, 0x8e, 0x06, 0xb0, 0x7e, 0x46, 0x32, 0x26, 0x8e, 0xd5, 0x94, 0x22, 0xe6,
-	0x13, 0xf5, 0xa0, 0xca, 0x79, 0x34, 0x00, 0x49, 0x15, 0x4b, 0x74, 0x1f, 0x7a, 0x41, 0x32, 0x4d,
-	0x71, 0xc0, 0xfd, 0x8c, 0x9c, 0x51, 0xb9, 0xa9, 0x25, 0xd9, 0x1b, 0x9a, 0xee, 0x69, 0xb2, 0x38,
-	0xce, 0x34, 0x09, 0xe9, 0x11, 0x25, 0xa1, 0x8f, 0xb9, 0x0e, 0x93, 0x4c, 0xb9, 0xaa, 0xd7, 0x33,
-	0x9c, 0x47, 0x5c, 0x05, 0xc8, 0xfd, 0x9b, 0x03, 0xb7, 0x2f, 0x4d, 0xe7, 0xa5, 0x20, 0x5d, 0x15,
-	0x90, 0x6f, 0x0b, 0x03, 0x77, 0x06, 0x77, 0xaf, 0x48, 0xb2, 0x2b, 0x7c, 0xad, 0x2c, 0xf9, 0xea,
-	0x42, 0x87, 0x04, 0x3e, 0x8d, 0x43, 0x72, 0xee, 0x4f, 0x28, 0x57, 0xd7, 0xbf, 0xe3, 0xb5, 0x48,
-	0x70, 0x20, 0x68, 0x8f, 0x29, 0x67, 0xee, 0x3a, 0xac, 0xed, 0x4f, 0x53, 0x3e, 0x77, 0xff, 0xe1,
-	0xc0, 0xc6, 0x78, 0x96, 0x92, 0xec, 0x71, 0x94, 0x04, 0xa7, 0xfb, 0xe7, 0x3c, 0xc3, 0xe8, 0x4b,
-	0xe8, 0x92, 0x0c, 0xb3, 0x59, 0x26, 0xae, 0x4d, 0x48, 0xe3, 0x63, 0x69, 0xbc, 0x5c, 0x2d, 0x17,
-	0xf6, 0xec, 0xee, 0xab, 0x0d, 0x7b, 0x52, 0xde, 0xeb, 0x10, 0xfb, 0x73, 0xf8, 0x2b, 0xe8, 0x94,
-	0xf8, 0x22, 0x27, 0x44, 0x6f, 0xd1, 0x87, 0x92, 0x6b, 0x91, 0xcf, 0x29, 0xce, 0x28, 0x9f, 0xeb,
-	0x1e, 0xa8, 0xbf, 0x44, 0x2e, 0xe8, 0x9a, 0x40, 0x43, 0x71, 0x96, 0xaa, 0xe8, 0x32, 0x8a, 0x72,
-	0x10, 0x32, 0xf7, 0x3e, 0x6c, 0xee, 0x45, 0x94, 0xc4, 0xfc, 0x19, 0x65, 0x9c, 0xc4, 0x1e, 0xf9,
-	0xed, 0x8c, 0x30, 0x2e, 0x2c, 0xc4, 0x78, 0x4a, 0x74, 0x87, 0x95, 0x6b, 0xf7, 0xf7, 0xd0, 0x55,
-	0x58, 0x3f, 0x4b, 0x02, 0x89, 0xb0, 0x88, 0x87, 0x68, 0xad, 0x4a, 0x48, 0x2c, 0x17, 0x7a, 0x6e,
-	0x65, 0xb1, 0xe7, 0xde, 0x82, 0x86, 0x6c, 0x4a, 0x85, 0x2b, 0xeb, 0xa2, 0xcf, 0xd0, 0x90, 0x15,
-	0x49, 0x19, 0x2a, 0x76, 0x4d, 0xb2, 0x5b, 0xa6, 0x6f, 0xd0, 0x90, 0xb9, 0xaf, 0x60, 0xf3, 0x59,
-	0x92, 0x9c, 0xce, 0x52, 0xe5, 0x86, 0xf1, 0xb5, 0x7c, 0x42, 0x67, 0x54, 0x15, 0x36, 0xf3, 0x13,
-	0x5e, 0x15, 0x6f, 0xf7, 0xbf, 0x0e, 0x6c, 0x95, 0xd5, 0xea, 0x6a, 0xfa, 0x1b, 0xd8, 0xcc, 0xf5,
-	0xfa, 0x91, 0x3e, 0xb3, 0x32, 0xd0, 0x7a, 0xf8, 0xb1, 0x15, 0xcc, 0x55, 0xbb, 0x4d, 0x87, 0x0e,
-	0x0d, 0x58, 0x5e, 0xff, 0x6c, 0x81, 0xc2, 0x86, 0xe7, 0xd0, 0x5b, 0x14, 0x13, 0xb5, 0x24, 0xb7,
-	0xaa, 0x91, 0x6d, 0x98, 0x9d, 0xe8, 0x87, 0xd0, 0x2c, 0x1c, 0xa9, 0x48, 0x47, 0x36, 0x4b, 0x8e,
-	0x68, 0x5b, 0x85, 0x14, 0xda, 0x82, 0x35, 0x92, 0x65, 0x49, 0xa6, 0xb3, 0x52, 0x7d, 0xb8, 0x3f,
-	0x81, 0xc6, 0x37, 0x8e, 0xa2, 0xfb, 0x2f, 0x07, 0x3a, 0x8f, 0x18, 0xa3, 0xc7, 0xf9, 0x75, 0xd9,
-	0x82, 0x35, 0x55, 0x21, 0x55, 0xb3, 0x51, 0x1f, 0x68, 0x04, 0x2d, 0x9d, 0xdc, 0x16, 0xf4, 0x36,
-	0xe9, 0xca, 0xba, 0xa1, 0x13, 0xbe, 0xa6, 0x5c, 0x13, 0x45, 0x6f, 0x61, 0xd2, 0x5a, 0xbb, 0x70,
-	0xd2, 0xaa, 0x5b, 0x93, 0xd6, 0xfb, 0xd0, 0x94, 0x9b, 0xe2, 0x24, 0x24, 0x7a, 0x04, 0x6b, 0x08,
-	0xc2, 0x8b, 0x24, 0x24, 0xee, 0x5f, 0x1c, 0xe8, 0x9a, 0xd3, 0xe8, 0xc8, 0xf7, 0xa0, 0x7a, 0x94,
-	0xa3, 0x2f, 0x96, 0x06, 0xa3, 0xca, 0x45, 0x18, 0x2d, 0x4d, 0x97, 0x39, 0x22, 0x35, 0x1b, 0x91,
-	0x3c, 0x18, 0x6b, 0x56, 0x30, 0x84, 0xcb, 0x78, 0xc6, 0x4f, 0x8c, 0xcb, 0x62, 0xed, 0x1e, 0x43,
-	0x7f, 0xcc, 0x31, 0xa7, 0x8c, 0xd3, 0x80, 0x19, 0x98, 0x17, 0x00, 0x75, 0xae, 0x02, 0xb4, 0x72,
-	0x11, 0xa0, 0xd5, 0x1c, 0x50, 0xf7, 0x9f, 0x0e, 0x20, 0xdb, 0x92, 0x86, 0xe0, 0x5b, 0x30, 0x25,
-	0x20, 0xe3, 0x09, 0x17, 0x63, 0x82, 0x68, 0xe8, 0xba, 0x2d, 0x4b, 0x8a, 0x18, 0x4b, 0x44, 0x94,
-	0x66, 0x8c, 0x84, 0x8a, 0xab, 0x7a, 0x72, 0x43, 0x10, 0x24, 0xb3, 0xdc, 0xd2, 0xeb, 0x0b, 0x2d,
-	0xdd, 0x7d, 0x04, 0xad, 0x31, 0x4f, 0x32, 0x7c, 0x4c, 0x5e, 0xcd, 0xd3, 0xb7, 0xf1, 0x5e, 0x7b,
-	0x57, 0x29, 0x80, 0x18, 0x01, 0xec, 0x15, 0xde, 0xaf, 0x2a, 0x80, 0xbf, 0x83, 0x9b, 0x85, 0x84,
-	0xa8, 0x97, 0x26, 0x2e, 0x9f, 0xc2, 0x36, 0x8d, 0x83, 0x68, 0x16, 0x12, 0x3f, 0x16, 0xed, 0x27,
-	0xca, 0xa7, 0x5a, 0x47, 0x0e, 0x03, 0x5b, 0x9a, 0xfb, 0x42, 0x32, 0xcd, 0x74, 0xfb, 0x11, 0x20,
-	0xb3, 0x8b, 0x04, 0xf9, 0x8e, 0x8a, 0xdc, 0xd1, 0xd3, 0x9c, 0xfd, 0x40, 0x4b, 0xbb, 0x2f, 0x61,
-	0x7b, 0xd1, 0xb8, 0x0e, 0xd5, 0x8f, 0xa0, 0x55, 0xc0, 0x6e, 0xea, 0xd3, 0x4d, 0xab, 0x2c, 0x14,
-	0xfb, 0x3c, 0x5b, 0xd2, 0xfd, 0x3e, 0xbc, 0x57, 0xb0, 0x9e, 0xc8, 0x42, 0x7b, 0x59, 0xfd, 0x1f,
-	0xc2, 0x60, 0x59, 0x5c, 0xf9, 0xe0, 0xfe, 0xa7, 0x02, 0xed, 0x27, 0x3a, 0xa3, 0x44, 0x0f, 0xb6,
-	0xba, 0x6e, 0x53, 0x76, 0xdd, 0x7b, 0xd0, 0x2e, 0xbd, 0xb4, 0xd4, 0x38, 0xd7, 0x3a, 0xb3, 0x9e,
-	0x59, 0xab, 0x1e, 0x64, 0x55, 0x29, 0xb6, 0xf8, 0x20, 0x7b, 0x00, 0xfd, 0xa3, 0x8c, 0x90, 0xe5,
-	0xb7, 0x5b, 0xcd, 0xdb, 0x10, 0x0c, 0x5b, 0x76, 0x17, 0x36, 0x71, 0xc0, 0xe9, 0xd9, 0x82, 0xb4,
-	0xba, 0x5f, 0x7d, 0xc5, 0xb2, 0xe5, 0x3f, 0xcf, 0x1d, 0xa5, 0xf1, 0x51, 0xc2, 0x06, 0xf5, 0xb7,
-	0x7f, 0x7b, 0xe9, 0xd3, 0x08, 0x0e, 0x43, 0x87, 0xd0, 0x35, 0x33, 0xbc, 0xd6, 0xb4, 0x7e, 0xed,
-	0xf7, 0x41, 0x9b, 0x14, 0x2c, 0xe6, 0xfe, 0xb1, 0x02, 0x0d, 0x0f, 0x07, 0xa7, 0xef, 0x36, 0xbe,
-	0x9f, 0xc1, 0x46, 0x5e, 0x8b, 0x4b, 0x10, 0xbf, 0x67, 0x01, 0x63, 0x5f, 0x25, 0xaf, 0x13, 0x5a,
-	0x5f, 0xcc, 0xfd, 0xbf, 0x03, 0xdd, 0x27, 0x79, 0xbd, 0x7f, 0xb7, 0xc1, 0x78, 0x08, 0x20, 0x1a,
-	0x54, 0x09, 0x07, 0xbb, 0xa1, 0x9b, 0x70, 0x7b, 0xcd, 0x4c, 0xaf, 0x98, 0xfb, 0xe7, 0x0a, 0xb4,
-	0x5f, 0x25, 0x69, 0x12, 0x25, 0xc7, 0xf3, 0x77, 0xfb, 0xf4, 0xfb, 0xd0, 0xb7, 0x7a, 0x79, 0x09,
-	0x84, 0x5b, 0x0b, 0x97, 0xa1, 0x08, 0xb6, 0xb7, 0x11, 0x96, 0xbe, 0x99, 0xbb, 0x09, 0x7d, 0x3d,
-	0x97, 0x16, 0x25, 0xd9, 0xfd, 0x83, 0x03, 0xc8, 0xa6, 0xea, 0x5a, 0xf9, 0x53, 0xe8, 0x70, 0x8d,
-	0x9d, 0xb4, 0xa7, 0x47, 0x73, 0xfb, 0xee, 0xd9, 0xd8, 0x7a, 0x6d, 0x6e, 0x23, 0xfd, 0x03, 0xd8,
-	0x5a, 0x7a, 0x5f, 0xfb, 0xd3, 0x89, 0x46, 0xb8, 0xbf, 0xf0, 0xc4, 0x7e, 0x3e, 0x71, 0x3f, 0x85,
-	0x9b, 0x6a, 0x38, 0x34, 0x75, 0xdc, 0xd4, 0xd7, 0xa5, 0x29, 0xaf, 0x53, 0x4c, 0x79, 0xee, 0xff,
-	0x1c, 0xd8, 0x5e, 0xdc, 0xa6, 0xfd, 0xbf, 0x6c, 0x1f, 0xc2, 0x80, 0x74, 0xbd, 0xb1, 0xe7, 0x55,
-	0x35, 0x26, 0x7e, 0xb2, 0x34, 0xaf, 0x2e, 0xea, 0xde, 0x35, 0x75, 0xa8, 0x18, 0x59, 0x7b, 0xac,
-	0x4c, 0x60, 0x43, 0x0c, 0xfd, 0x25, 0x31, 0x31, 0xd5, 0x1b, 0xbb, 0xda, 0xa7, 0x75, 0xbd, 0xf1,
-	0x1b, 0x0c, 0xac, 0xee, 0x5d, 0xb8, 0xfd, 0x05, 0xe1, 0xcf, 0xa5, 0xcc, 0x5e, 0x12, 0x1f, 0xd1,
-	0xe3, 0x59, 0xa6, 0x84, 0x8a, 0xd0, 0xde, 0xb9, 0x48, 0x42, 0xc3, 0xb4, 0xe2, 0x27, 0x86, 0x73,
-	0xed, 0x9f, 0x18, 0x95, 0xcb, 0x7e, 0x62, 0x3c, 0xfc, 0x77, 0x1d, 0xd6, 0xc7, 0x04, 0xbf, 0x21,
-	0x24, 0x44, 0x07, 0xd0, 0x19, 0x93, 0x38, 0x2c, 0x7e, 0x4f, 0x6e, 0x59, 0x67, 0xcc, 0xa9, 0xc3,
-	0xef, 0xac, 0xa2, 0xe6, 0x2d, 0xf4, 0xc6, 0x8e, 0xf3, 0xb1, 0x83, 0x0e, 0xa1, 0xf3, 0x94, 0x90,
-	0x74, 0x2f, 0x89, 0x63, 0x12, 0x70, 0x12, 0xa2, 0x3b, 0x76, 0x23, 0x5f, 0x7e, 0xa9, 0x0d, 0x6f,
-	0x2d, 0xf5, 0x13, 0x03, 0xaa, 0xd6, 0xf8, 0x12, 0xda, 0xf6, 0x03, 0xa5, 0xa4, 0x70, 0xc5, 0x73,
-	0x6a, 0x78, 0xf7, 0x8a, 0x97, 0x8d, 0x7b, 0x03, 0x7d, 0x06, 0x75, 0x35, 0x31, 0xa3, 0x81, 0x25,
-	0x5c, 0x7a, 0x12, 0x94, 0xfc, 0x2a, 0x8f, 0xd7, 0xee, 0x0d, 0xf4, 0x14, 0xa0, 0x98, 0x39, 0x91,
-	0x8d, 0xcb, 0xd2, 0xd0, 0x3b, 0xbc, 0x7d, 0x01, 0x37, 0x57, 0xf6, 0x4b, 0xe8, 0x96, 0x27, 0x23,
-	0x34, 0x5a, 0x39, 0xfc, 0x58, 0xe5, 0x61, 0x78, 0xef, 0x12, 0x89, 0x5c, 0xf1, 0xaf, 0xa1, 0xb7,
-	0x38, 0xf0, 0x20, 0x77, 0xe5, 0xc6, 0xd2, 0xf0, 0x34, 0xfc, 0xe0, 0x52, 0x19, 0x1b, 0x84, 0xa2,
-	0x42, 0x95, 0x40, 0x58, 0x2a, 0x67, 0x25, 0x10, 0x96, 0xcb, 0x9a, 0x02, 0xa1, 0x9c, 0xd6, 0x25,
-	0x10, 0x56, 0x16, 0xa1, 0x12, 0x08, 0xab, 0x6b, 0x82, 0x7b, 0x03, 0x25, 0xb0, 0xbd, 0x3a, 0xd9,
-	0x90, 0xfd, 0x3f, 0xe3, 0xd2, 0x8c, 0x1d, 0xde, 0x7f, 0x0b, 0x49, 0x63, 0x70, 0x52, 0x97, 0xbf,
-	0xfe, 0x3f, 0xf9, 0x3a, 0x00, 0x00, 0xff, 0xff, 0x28, 0x1f, 0x4c, 0x0e, 0x0a, 0x18, 0x00, 0x00,
+	// 3165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xde, 0x5d, 0x3e, 0x76, 0x6b, 0x77, 0xf6, 0xd1, 0xa4, 0xa8, 0xd5, 0xd8, 0x14, 0xa9, 0xa1,
+	0x1f, 0x94, 0x3e, 0x99, 0x9f, 0x22, 0x1b, 0xb0, 0x13, 0xdb, 0x31, 0x28, 0x8a, 0x76, 0x08, 0x53,
+	0xb4, 0x34, 0xa4, 0xed, 0xc0, 0x40, 0x32, 0x6e, 0xce, 0x34, 0xa9, 0x01, 0x67, 0x67, 0xd6, 0xd3,
+	0xbd, 0x14, 0xe9, 0x20, 0x08, 0x82, 0xe4, 0x90, 0x5c, 0x12, 0x20, 0x46, 0x90, 0x43, 0x90, 0x7b,
+	0x7e, 0x43, 0x02, 0xe4, 0x92, 0xbf, 0x92, 0x3f, 0x90, 0x5c, 0x83, 0x00, 0x41, 0xbf, 0xe6, 0xb5,
+	0xb3, 0x4b, 0xca, 0x8e, 0x0e, 0xba, 0x4d, 0x57, 0x55, 0x57, 0x57, 0x57, 0xd7, 0xab, 0xab, 0x07,
+	0x5a, 0x03, 0x4c, 0x19, 0x89, 0x37, 0x86, 0x71, 0xc4, 0x22, 0xd4, 0x90, 0x23, 0x67, 0x78, 0x68,
+	0xfd, 0xbc, 0x0e, 0x8d, 0x1f, 0x10, 0x1c, 0xb3, 0x43, 0x82, 0x19, 0x6a, 0x43, 0xd5, 0x1f, 0xf6,
+	0x2b, 0xab, 0x95, 0xf5, 0x86, 0x5d, 0xf5, 0x87, 0x08, 0xc1, 0xcc, 0x30, 0x8a, 0x59, 0xbf, 0xba,
+	0x5a, 0x59, 0x37, 0x6c, 0xf1, 0x8d, 0x96, 0x01, 0x86, 0xa3, 0xc3, 0xc0, 0x77, 0x9d, 0x51, 0x1c,
+	0xf4, 0x6b, 0x82, 0xb6, 0x21, 0x21, 0x9f, 0xc4, 0x01, 0x5a, 0x87, 0xee, 0x00, 0x9f, 0x39, 0xa7,
+	0x51, 0x30, 0x1a, 0x10, 0xc7, 0x8d, 0x46, 0x21, 0xeb, 0xcf, 0x88, 0xe9, 0xed, 0x01, 0x3e, 0xfb,
+	0x54, 0x80, 0xb7, 0x38, 0x14, 0xad, 0x72, 0xa9, 0xce, 0x9c, 0x23, 0x3f, 0x20, 0xce, 0x09, 0x39,
+	0xef, 0xcf, 0xae, 0x56, 0xd6, 0x67, 0x6c, 0x18, 0xe0, 0xb3, 0x0f, 0xfc, 0x80, 0x7c, 0x44, 0xce,
+	0xd1, 0x0a, 0x34, 0x3d, 0xcc, 0xb0, 0xe3, 0x92, 0x90, 0x91, 0xb8, 0x3f, 0x27, 0xd6, 0x02, 0x0e,
+	0xda, 0x12, 0x10, 0x2e, 0x5f, 0x8c, 0xdd, 0x93, 0xfe, 0xbc, 0xc0, 0x88, 0x6f, 0x2e, 0x1f, 0xf6,
+	0x06, 0x7e, 0xe8, 0x08, 0xc9, 0xeb, 0x62, 0xe9, 0x86, 0x80, 0x3c, 0xe4, 0xe2, 0xbf, 0x07, 0xf3,
+	0x52, 0x36, 0xda, 0x6f, 0xac, 0xd6, 0xd6, 0x9b, 0x77, 0xd7, 0x36, 0x12, 0x6d, 0x6c, 0x48, 0xf1,
+	0x76, 0xc2, 0xa3, 0x28, 0x1e, 0x60, 0xe6, 0x47, 0xe1, 0x03, 0x42, 0x29, 0x3e, 0x26, 0xb6, 0x9e,
+	0x83, 0x76, 0xa0, 0x19, 0x92, 0x27, 0x8e, 0x66, 0x01, 0x82, 0xc5, 0xfa, 0x18, 0x8b, 0xfd, 0xc7,
+	0x51, 0xcc, 0x4a, 0xf8, 0x40, 0x48, 0x9e, 0x7c, 0xaa, 0x58, 0x3d, 0x82, 0x8e, 0x47, 0x02, 0xc2,
+	0x88, 0x97, 0xb0, 0x6b, 0x3e, 0x25, 0xbb, 0xb6, 0x62, 0xa0, 0x59, 0xbe, 0x0c, 0xed, 0xc7, 0x98,
+	0x3a, 0x61, 0x94, 0x70, 0x6c, 0xad, 0x56, 0xd6, 0xeb, 0x76, 0xeb, 0x31, 0xa6, 0x7b, 0x91, 0xa6,
+	0x7a, 0x1d, 0x16, 0x82, 0xe8, 0x89, 0xe3, 0xf9, 0xf4, 0xc4, 0xa1, 0x43, 0xec, 0x12, 0xc7, 0xf3,
+	0x63, 0xda, 0x37, 0x56, 0x6b, 0xeb, 0x0d, 0xbb, 0x1b, 0x44, 0x4f, 0xee, 0xfb, 0xf4, 0x64, 0x9f,
+	0x23, 0xee, 0xfb, 0x31, 0x45, 0x1f, 0x42, 0x83, 0xb8, 0x0e, 0x7d, 0x8c, 0x63, 0x8f, 0xf6, 0xbb,
+	0x42, 0xc2, 0x5b, 0x63, 0x12, 0x6e, 0xbb, 0xfb, 0x9c, 0xa0, 0x44, 0xc6, 0x3a, 0x91, 0x28, 0x8a,
+	0xf6, 0xc0, 0xe0, 0xba, 0x4b, 0x99, 0xf5, 0x9e, 0x9a, 0x19, 0x57, 0xfe, 0xb6, 0xe6, 0xf7, 0x29,
+	0xf4, 0xb4, 0x02, 0x53, 0x9e, 0xe8, 0xa9, 0x79, 0xea, 0x53, 0x48, 0xf8, 0xbe, 0x06, 0x5d, 0xa5,
+	0xc5, 0x94, 0xed, 0x82, 0xd0, 0xa3, 0x21, 0xf4, 0x98, 0x10, 0xbe, 0x0d, 0x73, 0x01, 0x3e, 0x24,
+	0x01, 0xed, 0x2f, 0x8a, 0x55, 0x57, 0x33, 0xab, 0x26, 0x4e, 0xb5, 0xb1, 0x2b, 0x48, 0xb6, 0x43,
+	0x16, 0x9f, 0xdb, 0x8a, 0x1e, 0xbd, 0x0a, 0x9d, 0xa3, 0x98, 0x10, 0x79, 0x06, 0x87, 0xe7, 0x8c,
+	0xd0, 0xfe, 0x15, 0x61, 0xfe, 0x06, 0x07, 0x73, 0xfd, 0xdf, 0xe3, 0x40, 0xee, 0x4d, 0x2c, 0x62,
+	0x38, 0xc8, 0x12, 0x2e, 0x09, 0xc2, 0xb6, 0x80, 0x27, 0x94, 0xe6, 0x77, 0xa1, 0x99, 0x59, 0x08,
+	0x75, 0xa1, 0xc6, 0x7d, 0x4a, 0xba, 0x32, 0xff, 0x44, 0x8b, 0x30, 0x7b, 0x8a, 0x83, 0x11, 0x11,
+	0xce, 0xdc, 0xb0, 0xe5, 0xe0, 0x7b, 0xd5, 0xb7, 0x2b, 0xd6, 0x5f, 0x2a, 0xd0, 0x4b, 0xc4, 0xb5,
+	0x09, 0x1d, 0x46, 0x21, 0x25, 0xe8, 0x16, 0xf4, 0x94, 0x13, 0x53, 0xff, 0x2b, 0xe2, 0x04, 0xfe,
+	0xc0, 0x67, 0x82, 0xdf, 0x8c, 0xdd, 0x91, 0x88, 0x7d, 0xff, 0x2b, 0xb2, 0xcb, 0xc1, 0x68, 0x09,
+	0xe6, 0x02, 0x82, 0x3d, 0x12, 0x2b, 0xe6, 0x6a, 0x84, 0x5e, 0x83, 0xce, 0x80, 0xb0, 0xd8, 0x77,
+	0xa9, 0x83, 0x3d, 0x2f, 0x26, 0x94, 0xaa, 0x80, 0xd1, 0x56, 0xe0, 0x4d, 0x09, 0x45, 0x6f, 0x43,
+	0x5f, 0x13, 0xfa, 0xdc, 0xb3, 0x4f, 0x71, 0xe0, 0x50, 0xe2, 0x46, 0xa1, 0x47, 0x55, 0xf4, 0x58,
+	0x52, 0xf8, 0x1d, 0x85, 0xde, 0x97, 0x58, 0xeb, 0x4f, 0x35, 0xe8, 0x4f, 0x72, 0x5b, 0x11, 0xcf,
+	0x3c, 0x21, 0xb4, 0x61, 0x57, 0x7d, 0x8f, 0xc7, 0x0b, 0xbe, 0x19, 0x21, 0xe5, 0x8c, 0x2d, 0xbe,
+	0xd1, 0x75, 0x00, 0x37, 0x0a, 0x02, 0xe2, 0xf2, 0x89, 0x4a, 0xbc, 0x0c, 0x84, 0xc7, 0x13, 0x11,
+	0xa2, 0xd2, 0x50, 0x36, 0x63, 0x37, 0x38, 0x44, 0x46, 0xb1, 0x1b, 0xd0, 0x92, 0xf6, 0xa3, 0x08,
+	0x64, 0x14, 0x6b, 0x4a, 0x98, 0x24, 0xb9, 0x0d, 0x48, 0xdb, 0xe9, 0xe1, 0x79, 0x42, 0x38, 0x27,
+	0x08, 0xbb, 0x0a, 0x73, 0xef, 0x5c, 0x53, 0xbf, 0x08, 0x8d, 0x98, 0x60, 0xcf, 0x89, 0xc2, 0xe0,
+	0x5c, 0x04, 0xb6, 0xba, 0x5d, 0xe7, 0x80, 0x8f, 0xc3, 0xe0, 0x1c, 0xfd, 0x1f, 0xf4, 0x62, 0x32,
+	0x0c, 0x7c, 0x17, 0x3b, 0xc3, 0x00, 0xbb, 0x64, 0x40, 0x42, 0x1d, 0xe3, 0xba, 0x0a, 0xf1, 0x50,
+	0xc3, 0x51, 0x1f, 0xe6, 0x4f, 0x49, 0x4c, 0xf9, 0xb6, 0x1a, 0x82, 0x44, 0x0f, 0xb9, 0x75, 0x30,
+	0x16, 0xf4, 0x41, 0x40, 0xf9, 0x27, 0xba, 0x09, 0x5d, 0x37, 0x1a, 0x0c, 0xb1, 0xcb, 0x9c, 0x98,
+	0x9c, 0xfa, 0x62, 0x52, 0x53, 0xa0, 0x3b, 0x0a, 0x6e, 0x2b, 0x30, 0xdf, 0xce, 0x20, 0xf2, 0xfc,
+	0x23, 0x9f, 0x78, 0x0e, 0x66, 0xea, 0x98, 0x44, 0xa0, 0xa9, 0xd9, 0x5d, 0x8d, 0xd9, 0x64, 0xf2,
+	0x80, 0xac, 0x3f, 0x57, 0x60, 0x79, 0x6a, 0x10, 0x1b, 0x3b, 0xa4, 0x8b, 0x0e, 0xe4, 0x59, 0xe9,
+	0xc0, 0xfa, 0x6b, 0x05, 0x56, 0x2e, 0x08, 0x16, 0x17, 0x08, 0x5b, 0x1d, 0x13, 0xd6, 0x02, 0x83,
+	0xb8, 0x8e, 0x1f, 0x7a, 0xe4, 0xcc, 0x39, 0xf4, 0x99, 0xb4, 0x7f, 0xc3, 0x6e, 0x12, 0x77, 0x87,
+	0xc3, 0xee, 0xf9, 0x8c, 0x26, 0x69, 0x4e, 0x85, 0x1a, 0x69, 0xef, 0x22, 0xcd, 0xa9, 0x38, 0xb3,
+	0x06, 0xc6, 0x10, 0xc7, 0x3e, 0x3b, 0xd7, 0x24, 0xb3, 0x82, 0xa4, 0x25, 0x81, 0x92, 0xc8, 0x9a,
+	0x87, 0xd9, 0xed, 0xc1, 0x90, 0x9d, 0x5b, 0x7f, 0xab, 0x40, 0x67, 0x7f, 0x34, 0x24, 0xf1, 0xbd,
+	0x20, 0x72, 0x4f, 0xb6, 0xcf, 0x58, 0x8c, 0xd1, 0xc7, 0xd0, 0x26, 0x31, 0xa6, 0xa3, 0x98, 0x5b,
+	0x9f, 0xe7, 0x87, 0xc7, 0x62, 0x0b, 0xf9, 0x54, 0x53, 0x98, 0xb3, 0xb1, 0x2d, 0x27, 0x6c, 0x09,
+	0x7a, 0xdb, 0x20, 0xd9, 0xa1, 0xf9, 0x39, 0x18, 0x39, 0x3c, 0x77, 0x2d, 0x2e, 0xb1, 0x52, 0x8d,
+	0xf8, 0xe6, 0x61, 0x41, 0x8a, 0xa8, 0x0a, 0x08, 0x35, 0xe2, 0x2e, 0xa5, 0x42, 0x8b, 0xef, 0x71,
+	0x8d, 0xd4, 0x78, 0x8a, 0x96, 0x90, 0x1d, 0x8f, 0x5a, 0x37, 0x61, 0x61, 0x2b, 0xf0, 0x49, 0xc8,
+	0x76, 0x7d, 0xca, 0x48, 0x68, 0x93, 0x2f, 0x47, 0x84, 0x32, 0xbe, 0x42, 0x88, 0x07, 0x44, 0xc5,
+	0x34, 0xf1, 0x6d, 0xfd, 0x0c, 0xda, 0xf2, 0xc4, 0x76, 0x23, 0x17, 0x33, 0x75, 0xac, 0xbc, 0x2e,
+	0x51, 0x81, 0x6f, 0x14, 0x07, 0x85, 0x82, 0xa5, 0x5a, 0x2c, 0x58, 0xae, 0x41, 0x5d, 0x64, 0xf4,
+	0x54, 0x94, 0x79, 0x9e, 0xa4, 0x7d, 0x8f, 0xa6, 0xbe, 0xed, 0x49, 0xf4, 0x8c, 0x40, 0x37, 0x75,
+	0xd2, 0xf5, 0x3d, 0x6a, 0xfd, 0x10, 0x16, 0x3f, 0xc3, 0xcc, 0x7d, 0x7c, 0x10, 0x0d, 0xa3, 0x20,
+	0x3a, 0x3e, 0xd7, 0xc2, 0xae, 0x40, 0xd3, 0x15, 0x7b, 0x70, 0x32, 0x32, 0x83, 0x04, 0xed, 0xe1,
+	0x01, 0xe1, 0x04, 0xe4, 0x94, 0xe3, 0xd9, 0xf9, 0x90, 0xd0, 0x7e, 0x55, 0x24, 0x5f, 0x10, 0xa0,
+	0x03, 0x0e, 0xb1, 0xfe, 0x58, 0x05, 0x43, 0x73, 0xdd, 0xe6, 0x60, 0xb4, 0x00, 0xb3, 0x8c, 0x3a,
+	0x21, 0x15, 0xdc, 0x6a, 0xf6, 0x0c, 0xa3, 0x7b, 0x94, 0x6b, 0x85, 0x73, 0x50, 0xfb, 0x12, 0xdf,
+	0xc5, 0xba, 0xa9, 0x36, 0xb1, 0x6e, 0x9a, 0xc9, 0xd4, 0x4d, 0x2f, 0x42, 0x43, 0x4c, 0x0a, 0x23,
+	0x8f, 0x08, 0x03, 0x6b, 0xd8, 0x75, 0x0e, 0xd8, 0x8b, 0x3c, 0xc2, 0x91, 0xc9, 0x89, 0x89, 0xc8,
+	0x65, 0xd8, 0x75, 0x7d, 0x60, 0x05, 0x1f, 0x98, 0x1f, 0xf3, 0x81, 0x55, 0x68, 0xe9, 0x44, 0x2a,
+	0x0e, 0xbc, 0x2e, 0xd4, 0x08, 0xaa, 0x2e, 0xd8, 0xf1, 0x68, 0x26, 0x7f, 0x34, 0x72, 0xf9, 0xa3,
+	0x0f, 0xf3, 0x03, 0xe9, 0x78, 0xc2, 0x4f, 0x1b, 0xb6, 0x1e, 0x5a, 0x07, 0xb0, 0xb0, 0x1b, 0x45,
+	0x27, 0xa3, 0xa1, 0x3c, 0x7e, 0xad, 0xf6, 0xbc, 0x65, 0x55, 0x84, 0x52, 0x53, 0xcb, 0xba, 0xc8,
+	0x5b, 0xad, 0x7f, 0x55, 0x60, 0x31, 0xcf, 0x56, 0x25, 0xc3, 0x2f, 0x60, 0x21, 0xe1, 0xeb, 0x04,
+	0xca, 0xd6, 0xe4, 0x02, 0xcd, 0xbb, 0x77, 0x32, 0x4e, 0x54, 0x36, 0x5b, 0x97, 0x95, 0x9e, 0x36,
+	0x52, 0xbb, 0x77, 0x5a, 0x80, 0x50, 0xf3, 0x0c, 0xba, 0x45, 0xb2, 0xbc, 0xd6, 0xa5, 0x09, 0xa5,
+	0x5a, 0xff, 0x0e, 0x34, 0x52, 0x41, 0xaa, 0x42, 0x90, 0x85, 0x9c, 0x20, 0x6a, 0xad, 0x94, 0x8a,
+	0x97, 0x00, 0x24, 0x8e, 0x23, 0x6d, 0x11, 0x72, 0x60, 0xbd, 0x03, 0xf5, 0x6f, 0xec, 0x3d, 0xd6,
+	0x1f, 0x6a, 0x60, 0x6c, 0x52, 0xea, 0x1f, 0x27, 0x6e, 0xba, 0x08, 0xb3, 0x32, 0xc1, 0xc9, 0x5a,
+	0x41, 0x0e, 0xd0, 0x2a, 0x34, 0x55, 0x6c, 0xce, 0xa8, 0x3e, 0x0b, 0xba, 0x30, 0xec, 0xab, 0x78,
+	0x2d, 0x4d, 0x96, 0x7f, 0x16, 0xcd, 0x7c, 0x76, 0xa2, 0x99, 0xcf, 0x4d, 0x32, 0xf3, 0xf9, 0x82,
+	0x99, 0x7f, 0x02, 0x9d, 0x98, 0x7c, 0x39, 0xf2, 0x63, 0xe2, 0x39, 0xaa, 0xb2, 0xab, 0x0b, 0xcd,
+	0xde, 0xce, 0x68, 0x36, 0xb7, 0xdd, 0x0d, 0x5b, 0xd1, 0x67, 0xab, 0xbc, 0x76, 0x9c, 0x03, 0xa2,
+	0x3b, 0xb0, 0x88, 0x43, 0xe6, 0x3b, 0xf8, 0xe8, 0xc8, 0x0f, 0x79, 0x18, 0x57, 0xbc, 0x1b, 0xc2,
+	0x3e, 0x11, 0xc7, 0x6d, 0x2a, 0x94, 0x9c, 0x61, 0x6e, 0xc2, 0x42, 0x09, 0xe3, 0xa7, 0xaa, 0xea,
+	0xbe, 0xae, 0x40, 0x5b, 0x8b, 0xaa, 0xac, 0xb8, 0x0b, 0xb5, 0xa3, 0xc4, 0x92, 0xf8, 0xa7, 0x3e,
+	0xef, 0xea, 0xa4, 0xf3, 0x1e, 0xbb, 0xde, 0x25, 0xa7, 0x3b, 0x93, 0x3d, 0xdd, 0xc4, 0xb0, 0x66,
+	0x33, 0x86, 0xc5, 0xd5, 0x8f, 0x47, 0xec, 0xb1, 0x56, 0x3f, 0xff, 0xb6, 0x8e, 0xa1, 0xb7, 0xcf,
+	0x30, 0xf3, 0x29, 0xf3, 0x5d, 0xaa, 0x4d, 0xa6, 0x60, 0x1c, 0x95, 0x8b, 0x8c, 0xa3, 0x3a, 0xc9,
+	0x38, 0x6a, 0x89, 0x71, 0x58, 0x7f, 0xaf, 0x00, 0xca, 0xae, 0xa4, 0x54, 0xf0, 0x0c, 0x96, 0xe2,
+	0x2a, 0x93, 0x45, 0xba, 0xa8, 0x2d, 0x55, 0x85, 0x28, 0x20, 0xbc, 0x42, 0xe6, 0x16, 0x37, 0xa2,
+	0xc4, 0x93, 0x58, 0x59, 0x1e, 0xd6, 0x39, 0x40, 0x20, 0xf3, 0xd5, 0xe5, 0x5c, 0xa1, 0xba, 0xb4,
+	0x36, 0xa1, 0xb9, 0xcf, 0xa2, 0x18, 0x1f, 0x13, 0x9e, 0x14, 0x2e, 0x21, 0xbd, 0x92, 0xae, 0x9a,
+	0x2a, 0xe2, 0x9f, 0x55, 0x80, 0xad, 0x54, 0xfc, 0x92, 0x2c, 0xca, 0x1d, 0xe9, 0xcb, 0x51, 0xc4,
+	0xb0, 0xba, 0x60, 0xc8, 0xea, 0x18, 0x04, 0x48, 0x5e, 0x43, 0xd6, 0xc0, 0x90, 0x04, 0xfa, 0x5a,
+	0x59, 0x13, 0x24, 0x2d, 0x01, 0xd4, 0xd7, 0xca, 0x65, 0x00, 0xb1, 0x4f, 0xc9, 0x44, 0xa9, 0x81,
+	0x43, 0x24, 0x8f, 0x1b, 0xd0, 0xca, 0x35, 0x05, 0x54, 0xa1, 0x7c, 0x9a, 0xe9, 0x08, 0xd8, 0xe3,
+	0xee, 0x37, 0x27, 0xdc, 0xef, 0x66, 0xc6, 0xfd, 0xd2, 0xbd, 0x7c, 0x2b, 0xdf, 0x9b, 0x7f, 0x96,
+	0xbe, 0xf7, 0x13, 0xb8, 0x92, 0x8a, 0xc9, 0xab, 0x18, 0x6d, 0xe9, 0x6f, 0xc2, 0x92, 0x1f, 0xba,
+	0xc1, 0xc8, 0x23, 0x4e, 0xc8, 0x4b, 0xcb, 0x20, 0xd1, 0x68, 0x45, 0x54, 0xfa, 0x8b, 0x0a, 0xbb,
+	0x27, 0x90, 0x5a, 0xb3, 0xb7, 0x01, 0xe9, 0x59, 0xc4, 0x4d, 0x66, 0x54, 0xc5, 0x8c, 0xae, 0xc2,
+	0x6c, 0xbb, 0x8a, 0xda, 0x7a, 0x04, 0x4b, 0xc5, 0xc5, 0x95, 0xf1, 0xbf, 0x05, 0xcd, 0xd4, 0x90,
+	0x75, 0xf6, 0xba, 0x52, 0xaa, 0x5b, 0x3b, 0x4b, 0x69, 0xbd, 0x0e, 0x57, 0x53, 0xd4, 0x7d, 0x51,
+	0xfe, 0x4c, 0xab, 0xca, 0x4c, 0xe8, 0x8f, 0x93, 0x4b, 0x19, 0xac, 0x11, 0x5c, 0x4b, 0x71, 0x8f,
+	0xb8, 0xfd, 0xec, 0x13, 0x36, 0x85, 0xd9, 0xff, 0xc6, 0x38, 0xad, 0x97, 0xc0, 0x2c, 0x5b, 0x56,
+	0x09, 0xf5, 0xdb, 0x2a, 0x2c, 0xa7, 0xe8, 0xe4, 0xf6, 0x70, 0x81, 0x64, 0x64, 0xdc, 0x5c, 0x65,
+	0x1e, 0x7e, 0xb7, 0x54, 0xa5, 0x25, 0x6c, 0xbf, 0x95, 0x05, 0xd7, 0x9e, 0xa5, 0x05, 0xaf, 0xc2,
+	0xf5, 0x49, 0x92, 0x2b, 0x9d, 0xbd, 0x07, 0x57, 0x1f, 0xe8, 0x86, 0xde, 0x8e, 0x67, 0x63, 0x9f,
+	0x26, 0x36, 0x61, 0x81, 0x91, 0xe9, 0x01, 0x26, 0xf7, 0xa5, 0xe6, 0x20, 0xa5, 0xb7, 0xbe, 0x0f,
+	0xfd, 0xf1, 0xe9, 0xca, 0x4e, 0x2f, 0x33, 0xff, 0x57, 0x33, 0xd0, 0xba, 0xaf, 0xf2, 0x36, 0xbf,
+	0xa7, 0x65, 0x6e, 0x66, 0x0d, 0x71, 0x33, 0x2b, 0xc6, 0x9b, 0xea, 0x78, 0xbc, 0x29, 0xeb, 0x55,
+	0x4a, 0xe3, 0x29, 0xf6, 0x2a, 0x6f, 0x41, 0x4f, 0xf4, 0x6b, 0xc6, 0xda, 0x9a, 0x33, 0xb6, 0x68,
+	0xe4, 0x64, 0x69, 0x37, 0x60, 0x01, 0xbb, 0xcc, 0x3f, 0x25, 0x4e, 0x49, 0xbc, 0xeb, 0x49, 0x54,
+	0x96, 0xfe, 0x83, 0x44, 0x50, 0x3f, 0x3c, 0x8a, 0x74, 0xc8, 0xbb, 0x54, 0x5b, 0xb2, 0x79, 0x9a,
+	0x60, 0x28, 0x7a, 0x08, 0xed, 0xb4, 0xcc, 0x16, 0x9c, 0xe6, 0x9f, 0xba, 0x17, 0xd6, 0x22, 0x29,
+	0x6a, 0x62, 0xa3, 0xb0, 0x3e, 0xa1, 0x51, 0xf8, 0x0e, 0xcc, 0x65, 0x0a, 0x9b, 0xfc, 0x16, 0xb2,
+	0x47, 0x55, 0xd6, 0x11, 0xfb, 0x36, 0xfd, 0xab, 0x5f, 0x56, 0xa1, 0x6e, 0x63, 0xf7, 0xe4, 0xf9,
+	0x36, 0x83, 0xf7, 0xa1, 0x93, 0x14, 0xa6, 0x39, 0x4b, 0xb8, 0x3a, 0x41, 0x8d, 0xb6, 0xe1, 0x65,
+	0x46, 0xd4, 0xfa, 0x4f, 0x05, 0xda, 0xf7, 0x93, 0xe2, 0xf7, 0xf9, 0x56, 0xc6, 0x5d, 0x00, 0x5e,
+	0xad, 0xe7, 0xf4, 0x90, 0xbd, 0xdd, 0xe8, 0xe3, 0xb6, 0x1b, 0xb1, 0xfa, 0xa2, 0xd6, 0x6f, 0xaa,
+	0xd0, 0xd2, 0x17, 0xe6, 0xe7, 0x7b, 0xf7, 0xdb, 0xd0, 0xcb, 0x5c, 0x6c, 0x72, 0x4a, 0xb8, 0x56,
+	0x30, 0x86, 0xf4, 0xb0, 0xed, 0x8e, 0x97, 0x1b, 0x53, 0x6b, 0x01, 0x7a, 0xaa, 0x39, 0x92, 0x56,
+	0x20, 0xd6, 0x2f, 0x2a, 0x80, 0xb2, 0x50, 0x15, 0x72, 0xdf, 0x05, 0x83, 0x29, 0xdd, 0x89, 0xf5,
+	0x54, 0x7f, 0x28, 0x6b, 0x7b, 0x59, 0xdd, 0xda, 0x2d, 0x96, 0x19, 0xa1, 0xff, 0x87, 0xc5, 0xb1,
+	0x5e, 0xb1, 0x33, 0x38, 0x54, 0x1a, 0xee, 0x15, 0xda, 0xc5, 0x0f, 0x0e, 0xad, 0x37, 0xe1, 0x8a,
+	0xbc, 0x29, 0xeb, 0xb2, 0x45, 0xa7, 0x8e, 0xb1, 0x2b, 0x6f, 0xa6, 0xd1, 0x60, 0xfd, 0xbb, 0x02,
+	0x4b, 0xc5, 0x69, 0x4a, 0xfe, 0x69, 0xf3, 0x10, 0x06, 0xa4, 0xbb, 0x0f, 0x4e, 0xf1, 0xce, 0xfc,
+	0xc6, 0xd8, 0xe5, 0xbd, 0xc8, 0x7b, 0x43, 0x87, 0xcb, 0xf4, 0xfe, 0xde, 0xa5, 0x79, 0x00, 0x35,
+	0x31, 0xf4, 0xc6, 0xc8, 0x78, 0x6b, 0x49, 0xaf, 0xab, 0x64, 0x9a, 0x57, 0x13, 0xbf, 0xc1, 0xed,
+	0xdd, 0x5a, 0x81, 0xe5, 0x0f, 0x09, 0x7b, 0x20, 0x68, 0xb6, 0xa2, 0xf0, 0xc8, 0x3f, 0x1e, 0xc5,
+	0x92, 0x28, 0x3d, 0xda, 0xeb, 0x93, 0x28, 0x94, 0x9a, 0x4a, 0x1a, 0xf2, 0x95, 0xa7, 0x6e, 0xc8,
+	0x57, 0xa7, 0x36, 0xe4, 0xb7, 0x60, 0x41, 0xeb, 0x6f, 0x88, 0xfd, 0x38, 0xd3, 0x16, 0x18, 0xe2,
+	0x11, 0x25, 0xaa, 0xd0, 0x95, 0x03, 0xde, 0xf8, 0x89, 0x09, 0x1d, 0x0d, 0x88, 0xaa, 0x66, 0xd5,
+	0xc8, 0xfa, 0xc7, 0x0c, 0x2c, 0xe6, 0xb9, 0xa8, 0x0d, 0x2c, 0x03, 0xf8, 0xd4, 0x21, 0x21, 0x3e,
+	0x0c, 0x88, 0xa7, 0x78, 0x35, 0x7c, 0xba, 0x2d, 0x01, 0xdc, 0x0c, 0x7c, 0xea, 0x08, 0xde, 0x9e,
+	0x62, 0x59, 0xf7, 0xe9, 0x43, 0x31, 0xe6, 0x85, 0xd4, 0x71, 0xcc, 0xb3, 0xd8, 0x90, 0xc4, 0x7e,
+	0xe4, 0x25, 0xfb, 0x91, 0x2d, 0x59, 0x24, 0x70, 0x0f, 0x05, 0x4a, 0xed, 0x05, 0x1d, 0x40, 0x27,
+	0x88, 0x28, 0x73, 0x92, 0xc0, 0x2c, 0x7b, 0x80, 0xf9, 0x7e, 0x40, 0x99, 0x9c, 0x1b, 0xbb, 0x11,
+	0x65, 0x3a, 0x58, 0xdb, 0x46, 0x90, 0x19, 0x89, 0x72, 0x7e, 0x48, 0x42, 0xde, 0x35, 0x75, 0x18,
+	0xa6, 0x27, 0x99, 0x68, 0x60, 0xd8, 0x5d, 0x85, 0x39, 0xc0, 0xf4, 0x44, 0x06, 0x83, 0x35, 0x30,
+	0xe2, 0x51, 0x18, 0x6a, 0x6a, 0x19, 0x08, 0x1a, 0x76, 0x4b, 0x01, 0x39, 0xa1, 0xa8, 0x11, 0xe9,
+	0xc8, 0x75, 0x09, 0xf1, 0x88, 0x97, 0x65, 0x3a, 0x2f, 0x1c, 0x10, 0x25, 0xb8, 0x94, 0xed, 0x6d,
+	0x40, 0xf4, 0xc4, 0x1f, 0x0e, 0xf3, 0xf4, 0x75, 0xf9, 0x28, 0xa1, 0x30, 0x29, 0x35, 0x8f, 0x76,
+	0xd8, 0x0f, 0xf2, 0xc4, 0x0d, 0x15, 0xed, 0x04, 0x22, 0xa5, 0x5d, 0x06, 0x08, 0x30, 0x65, 0x8e,
+	0xec, 0x08, 0xc8, 0xbe, 0x5d, 0x83, 0x43, 0xb6, 0x39, 0xc0, 0xfc, 0x75, 0x05, 0x5a, 0x59, 0xed,
+	0x94, 0xf4, 0x9c, 0x5e, 0x02, 0x10, 0x6a, 0xc7, 0xcc, 0x09, 0xa5, 0xb9, 0xd5, 0xec, 0x3a, 0x87,
+	0x6c, 0xb2, 0xbd, 0xf1, 0x8b, 0xa4, 0xea, 0xa8, 0x67, 0xc3, 0xf8, 0xab, 0xd0, 0x49, 0x2e, 0x4a,
+	0xb9, 0x37, 0x68, 0x83, 0xb8, 0x99, 0x40, 0xcb, 0x5d, 0xca, 0xc6, 0x47, 0xa2, 0xcf, 0xbc, 0x15,
+	0x8c, 0xf8, 0x81, 0xee, 0x93, 0x98, 0x3f, 0x10, 0x68, 0x97, 0xfa, 0x7d, 0x0d, 0xae, 0x4f, 0xa2,
+	0x48, 0x5a, 0x83, 0x1d, 0x57, 0x62, 0x1c, 0x2a, 0x51, 0xea, 0x62, 0xf5, 0x56, 0x2e, 0x5f, 0x4d,
+	0xe3, 0xb1, 0x91, 0x03, 0xdb, 0x6d, 0x37, 0x47, 0x35, 0xf1, 0x75, 0x8d, 0xb7, 0x7e, 0x49, 0x3c,
+	0x50, 0x09, 0x4a, 0x7c, 0x73, 0xe5, 0xb8, 0xd1, 0x80, 0x87, 0x5f, 0xf1, 0xe6, 0xa0, 0x32, 0x52,
+	0x53, 0xc2, 0xc4, 0x93, 0x03, 0x67, 0x27, 0x05, 0x55, 0xdd, 0x1a, 0x35, 0xe2, 0x1e, 0x4a, 0x19,
+	0x66, 0x44, 0xf5, 0x6b, 0xe4, 0xc0, 0xfc, 0xba, 0x02, 0x46, 0x4e, 0x3c, 0xde, 0x94, 0xcd, 0xc7,
+	0x0e, 0x3d, 0xe4, 0x8b, 0x1f, 0xc7, 0x43, 0x37, 0x09, 0x2d, 0xaa, 0xcb, 0xc7, 0x61, 0x3a, 0xae,
+	0x48, 0x07, 0x55, 0xdb, 0xa9, 0x69, 0x07, 0xdd, 0x95, 0x1b, 0x5a, 0x87, 0xae, 0xb0, 0x1c, 0x91,
+	0x11, 0xf9, 0x4d, 0x27, 0x94, 0x7d, 0x84, 0x9a, 0xdd, 0xe6, 0xf0, 0x4d, 0x05, 0xde, 0xa3, 0xd6,
+	0x1d, 0x58, 0xe4, 0x2a, 0xdd, 0xf4, 0x3c, 0xa5, 0x33, 0x15, 0x65, 0x26, 0xca, 0x66, 0x5d, 0x85,
+	0x2b, 0x85, 0x19, 0xea, 0x1e, 0xb3, 0x03, 0x57, 0x39, 0xc2, 0x26, 0x83, 0xe8, 0x94, 0x5c, 0x92,
+	0x1b, 0xd7, 0xd5, 0x51, 0x14, 0xbb, 0x3a, 0x6c, 0xc9, 0x01, 0xbf, 0xf7, 0x8e, 0xb3, 0x52, 0xcb,
+	0xfc, 0x14, 0x96, 0x76, 0x09, 0xa6, 0x64, 0x93, 0xff, 0x89, 0x70, 0x10, 0x9d, 0xa4, 0xef, 0x1a,
+	0xaf, 0x40, 0x7b, 0xc8, 0xdf, 0xdc, 0xa2, 0x11, 0x75, 0x18, 0x47, 0xa8, 0xfe, 0xbe, 0xa1, 0xa1,
+	0x82, 0x9a, 0x6b, 0x8e, 0xbf, 0xcd, 0xc8, 0xf7, 0x04, 0xa9, 0xd9, 0x3a, 0x07, 0xe8, 0xd7, 0x84,
+	0xec, 0x73, 0x43, 0xad, 0xf8, 0xdc, 0x60, 0x9d, 0xc3, 0xd5, 0xb1, 0xe5, 0x95, 0x01, 0x2f, 0xc2,
+	0x6c, 0x76, 0x59, 0x39, 0x90, 0x3e, 0xe8, 0x9e, 0x38, 0xf2, 0xc5, 0x21, 0xf1, 0x41, 0xf7, 0xe4,
+	0x80, 0xbf, 0x3a, 0xdc, 0x82, 0x5e, 0xc0, 0xd9, 0x39, 0x9e, 0xca, 0x30, 0x9c, 0xa8, 0x26, 0x88,
+	0x3a, 0x02, 0x71, 0x5f, 0xc1, 0xf7, 0xa8, 0xf5, 0x63, 0xe8, 0xdb, 0x24, 0x78, 0x66, 0x7b, 0xb7,
+	0x5e, 0x84, 0x6b, 0x25, 0xfc, 0xe5, 0xe6, 0xee, 0xfe, 0xae, 0x0d, 0xf3, 0xfb, 0x04, 0x3f, 0x21,
+	0xc4, 0x43, 0x3b, 0x60, 0xec, 0x93, 0xd0, 0x4b, 0x7f, 0x77, 0x59, 0x2c, 0x7b, 0xaf, 0x37, 0x5f,
+	0x2a, 0x83, 0x26, 0xe7, 0xf8, 0xc2, 0x7a, 0xe5, 0x4e, 0x05, 0x3d, 0x04, 0xe3, 0x23, 0x42, 0x86,
+	0x5b, 0x51, 0x18, 0x12, 0x97, 0x11, 0x0f, 0x5d, 0xcf, 0x5e, 0xf9, 0xc7, 0x1f, 0xaf, 0xcc, 0x6b,
+	0x63, 0x09, 0x43, 0xa7, 0x78, 0xc5, 0xf1, 0x11, 0xb4, 0x64, 0xf9, 0x21, 0xb1, 0x39, 0x86, 0x25,
+	0x2f, 0x1d, 0xe6, 0xca, 0x05, 0x8f, 0x0e, 0xd6, 0x0b, 0xe8, 0x7d, 0x98, 0x93, 0x0d, 0x60, 0xd4,
+	0x9f, 0xd4, 0xbe, 0x36, 0xaf, 0x95, 0x60, 0x12, 0x06, 0x1f, 0x01, 0xa4, 0x2d, 0x54, 0x94, 0xd5,
+	0xcb, 0x58, 0x0f, 0xd7, 0x5c, 0x9e, 0x80, 0x4d, 0x98, 0x7d, 0x06, 0xed, 0x7c, 0x5b, 0x0a, 0xad,
+	0x96, 0xb6, 0x49, 0x32, 0xc5, 0xaa, 0x79, 0x63, 0x0a, 0x45, 0xc2, 0xf8, 0x47, 0xd0, 0x2d, 0x76,
+	0x9b, 0x90, 0x55, 0x3a, 0x31, 0xd7, 0xb9, 0x32, 0xd7, 0xa6, 0xd2, 0x24, 0xec, 0x5d, 0x40, 0xe3,
+	0x9d, 0x23, 0xf4, 0x72, 0xe9, 0xe4, 0x42, 0x3f, 0xcb, 0x7c, 0xe5, 0x02, 0xaa, 0x64, 0x91, 0x08,
+	0x96, 0xca, 0xdb, 0x2d, 0x68, 0xfd, 0xb2, 0xbd, 0x24, 0xf3, 0xe6, 0x25, 0x28, 0xb3, 0x4a, 0x2b,
+	0xb6, 0x5f, 0x72, 0x4a, 0x9b, 0xd0, 0xda, 0x31, 0xd7, 0xa6, 0xd2, 0x64, 0x2d, 0x27, 0xbd, 0x64,
+	0xe4, 0x2c, 0x67, 0xec, 0x46, 0x62, 0x2e, 0x4f, 0xc0, 0x66, 0x2d, 0x27, 0x5f, 0x99, 0xe7, 0x2c,
+	0xa7, 0xf4, 0x1e, 0x61, 0xde, 0x98, 0x42, 0x91, 0xd5, 0x7a, 0x79, 0xbd, 0x9c, 0xd3, 0xfa, 0xd4,
+	0xa2, 0xdb, 0xbc, 0x79, 0x09, 0xca, 0x64, 0xc1, 0x47, 0xd0, 0xca, 0x56, 0x8b, 0x39, 0x27, 0x2f,
+	0x29, 0x9a, 0xcd, 0x95, 0x89, 0xf8, 0xec, 0x1e, 0xca, 0x8b, 0x8b, 0xdc, 0x1e, 0xa6, 0x56, 0x39,
+	0xe6, 0xcd, 0x4b, 0x50, 0x26, 0x0b, 0x1e, 0x80, 0x91, 0x4b, 0xa4, 0x68, 0xa5, 0x30, 0xbb, 0x98,
+	0x94, 0xcd, 0xd5, 0xc9, 0x04, 0x59, 0x7b, 0x2c, 0xa6, 0xce, 0x9c, 0x3d, 0x4e, 0x48, 0xd1, 0xe6,
+	0xda, 0x54, 0x9a, 0x84, 0xfd, 0x1e, 0x18, 0xb9, 0x67, 0xfa, 0x9c, 0xd0, 0x65, 0x0f, 0xf8, 0x66,
+	0xbf, 0xe4, 0xe6, 0x2b, 0x9e, 0xe1, 0xad, 0x17, 0xee, 0x54, 0xd0, 0xe7, 0xd0, 0x29, 0xa4, 0x53,
+	0x94, 0xb3, 0xb8, 0xd2, 0x6c, 0x67, 0x5a, 0xd3, 0x48, 0x12, 0x59, 0xbf, 0x80, 0xde, 0x58, 0x3e,
+	0x43, 0xb9, 0x7d, 0x4e, 0xc8, 0xa6, 0xe6, 0xcb, 0xd3, 0x89, 0xf4, 0x0a, 0x87, 0x73, 0xe2, 0x37,
+	0xd0, 0x37, 0xfe, 0x3b, 0x00, 0xe1, 0x9c, 0xae, 0xd6, 0x16, 0x2a, 0x00, 0x00,
 }
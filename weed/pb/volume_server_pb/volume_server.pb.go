@@ -37,6 +37,12 @@ It has these top-level messages:
 	VolumeDeleteResponse
 	VolumeMarkReadonlyRequest
 	VolumeMarkReadonlyResponse
+	VolumeServerDrainRequest
+	VolumeServerDrainResponse
+	ReadNeedleBlobRequest
+	ReadNeedleBlobResponse
+	WriteNeedleBlobRequest
+	WriteNeedleBlobResponse
 	VolumeCopyRequest
 	VolumeCopyResponse
 	CopyFileRequest
@@ -61,6 +67,22 @@ It has these top-level messages:
 	VolumeEcShardReadResponse
 	VolumeEcBlobDeleteRequest
 	VolumeEcBlobDeleteResponse
+	VolumeEcShardsToVolumeRequest
+	VolumeEcShardsToVolumeResponse
+	VolumeEcShardsVerifyRequest
+	VolumeEcShardsVerifyResponse
+	VacuumEcVolumeCheckRequest
+	VacuumEcVolumeCheckResponse
+	VacuumEcVolumeCompactRequest
+	VacuumEcVolumeCompactResponse
+	VacuumEcVolumePrepareRequest
+	VacuumEcVolumePrepareResponse
+	VacuumEcVolumeCommitRequest
+	VacuumEcVolumeCommitResponse
+	VacuumEcVolumeRollbackRequest
+	VacuumEcVolumeRollbackResponse
+	VacuumEcVolumeCleanupRequest
+	VacuumEcVolumeCleanupResponse
 	ReadVolumeFileStatusRequest
 	ReadVolumeFileStatusResponse
 	DiskStatus
@@ -90,6 +112,10 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type BatchDeleteRequest struct {
 	FileIds []string `protobuf:"bytes,1,rep,name=file_ids,json=fileIds" json:"file_ids,omitempty"`
+	// delete the needles as they are, without checking the cookies or refusing the chunk manifests
+	SkipCookieCheck bool `protobuf:"varint,2,opt,name=skip_cookie_check,json=skipCookieCheck" json:"skip_cookie_check,omitempty"`
+	// also delete from the read only volumes, e.g., the target volumes of volume.mirror
+	IgnoreReadOnly bool `protobuf:"varint,3,opt,name=ignore_read_only,json=ignoreReadOnly" json:"ignore_read_only,omitempty"`
 }
 
 func (m *BatchDeleteRequest) Reset()                    { *m = BatchDeleteRequest{} }
@@ -104,6 +130,20 @@ func (m *BatchDeleteRequest) GetFileIds() []string {
 	return nil
 }
 
+func (m *BatchDeleteRequest) GetSkipCookieCheck() bool {
+	if m != nil {
+		return m.SkipCookieCheck
+	}
+	return false
+}
+
+func (m *BatchDeleteRequest) GetIgnoreReadOnly() bool {
+	if m != nil {
+		return m.IgnoreReadOnly
+	}
+	return false
+}
+
 type BatchDeleteResponse struct {
 	Results []*DeleteResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
 }
@@ -584,6 +624,135 @@ func (m *VolumeMarkReadonlyResponse) String() string            { return proto.C
 func (*VolumeMarkReadonlyResponse) ProtoMessage()               {}
 func (*VolumeMarkReadonlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
+type VolumeServerDrainRequest struct {
+	Cancel bool `protobuf:"varint,1,opt,name=cancel" json:"cancel,omitempty"`
+}
+
+func (m *VolumeServerDrainRequest) Reset()                    { *m = VolumeServerDrainRequest{} }
+func (m *VolumeServerDrainRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainRequest) ProtoMessage()               {}
+func (*VolumeServerDrainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *VolumeServerDrainRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
+type VolumeServerDrainResponse struct {
+}
+
+func (m *VolumeServerDrainResponse) Reset()                    { *m = VolumeServerDrainResponse{} }
+func (m *VolumeServerDrainResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainResponse) ProtoMessage()               {}
+func (*VolumeServerDrainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+type ReadNeedleBlobRequest struct {
+	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	NeedleId uint64 `protobuf:"varint,2,opt,name=needle_id,json=needleId" json:"needle_id,omitempty"`
+}
+
+func (m *ReadNeedleBlobRequest) Reset()                    { *m = ReadNeedleBlobRequest{} }
+func (m *ReadNeedleBlobRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleBlobRequest) ProtoMessage()               {}
+func (*ReadNeedleBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ReadNeedleBlobRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *ReadNeedleBlobRequest) GetNeedleId() uint64 {
+	if m != nil {
+		return m.NeedleId
+	}
+	return 0
+}
+
+type ReadNeedleBlobResponse struct {
+	NeedleBlob []byte `protobuf:"bytes,1,opt,name=needle_blob,json=needleBlob,proto3" json:"needle_blob,omitempty"`
+	Version    uint32 `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *ReadNeedleBlobResponse) Reset()                    { *m = ReadNeedleBlobResponse{} }
+func (m *ReadNeedleBlobResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleBlobResponse) ProtoMessage()               {}
+func (*ReadNeedleBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ReadNeedleBlobResponse) GetNeedleBlob() []byte {
+	if m != nil {
+		return m.NeedleBlob
+	}
+	return nil
+}
+
+func (m *ReadNeedleBlobResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type WriteNeedleBlobRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	NeedleId   uint64 `protobuf:"varint,2,opt,name=needle_id,json=needleId" json:"needle_id,omitempty"`
+	NeedleBlob []byte `protobuf:"bytes,3,opt,name=needle_blob,json=needleBlob,proto3" json:"needle_blob,omitempty"`
+	Version    uint32 `protobuf:"varint,4,opt,name=version" json:"version,omitempty"`
+	// also write to the read only volumes, e.g., the target volumes of volume.mirror
+	IgnoreReadOnly bool `protobuf:"varint,5,opt,name=ignore_read_only,json=ignoreReadOnly" json:"ignore_read_only,omitempty"`
+}
+
+func (m *WriteNeedleBlobRequest) Reset()                    { *m = WriteNeedleBlobRequest{} }
+func (m *WriteNeedleBlobRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleBlobRequest) ProtoMessage()               {}
+func (*WriteNeedleBlobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *WriteNeedleBlobRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *WriteNeedleBlobRequest) GetNeedleId() uint64 {
+	if m != nil {
+		return m.NeedleId
+	}
+	return 0
+}
+
+func (m *WriteNeedleBlobRequest) GetNeedleBlob() []byte {
+	if m != nil {
+		return m.NeedleBlob
+	}
+	return nil
+}
+
+func (m *WriteNeedleBlobRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WriteNeedleBlobRequest) GetIgnoreReadOnly() bool {
+	if m != nil {
+		return m.IgnoreReadOnly
+	}
+	return false
+}
+
+type WriteNeedleBlobResponse struct {
+}
+
+func (m *WriteNeedleBlobResponse) Reset()                    { *m = WriteNeedleBlobResponse{} }
+func (m *WriteNeedleBlobResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleBlobResponse) ProtoMessage()               {}
+func (*WriteNeedleBlobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
 type VolumeCopyRequest struct {
 	VolumeId       uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	Collection     string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
@@ -595,7 +764,7 @@ type VolumeCopyRequest struct {
 func (m *VolumeCopyRequest) Reset()                    { *m = VolumeCopyRequest{} }
 func (m *VolumeCopyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeCopyRequest) ProtoMessage()               {}
-func (*VolumeCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*VolumeCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *VolumeCopyRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -639,7 +808,7 @@ type VolumeCopyResponse struct {
 func (m *VolumeCopyResponse) Reset()                    { *m = VolumeCopyResponse{} }
 func (m *VolumeCopyResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeCopyResponse) ProtoMessage()               {}
-func (*VolumeCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*VolumeCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *VolumeCopyResponse) GetLastAppendAtNs() uint64 {
 	if m != nil {
@@ -649,18 +818,19 @@ func (m *VolumeCopyResponse) GetLastAppendAtNs() uint64 {
 }
 
 type CopyFileRequest struct {
-	VolumeId           uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
-	Ext                string `protobuf:"bytes,2,opt,name=ext" json:"ext,omitempty"`
-	CompactionRevision uint32 `protobuf:"varint,3,opt,name=compaction_revision,json=compactionRevision" json:"compaction_revision,omitempty"`
-	StopOffset         uint64 `protobuf:"varint,4,opt,name=stop_offset,json=stopOffset" json:"stop_offset,omitempty"`
-	Collection         string `protobuf:"bytes,5,opt,name=collection" json:"collection,omitempty"`
-	IsEcVolume         bool   `protobuf:"varint,6,opt,name=is_ec_volume,json=isEcVolume" json:"is_ec_volume,omitempty"`
+	VolumeId                 uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Ext                      string `protobuf:"bytes,2,opt,name=ext" json:"ext,omitempty"`
+	CompactionRevision       uint32 `protobuf:"varint,3,opt,name=compaction_revision,json=compactionRevision" json:"compaction_revision,omitempty"`
+	StopOffset               uint64 `protobuf:"varint,4,opt,name=stop_offset,json=stopOffset" json:"stop_offset,omitempty"`
+	Collection               string `protobuf:"bytes,5,opt,name=collection" json:"collection,omitempty"`
+	IsEcVolume               bool   `protobuf:"varint,6,opt,name=is_ec_volume,json=isEcVolume" json:"is_ec_volume,omitempty"`
+	IgnoreSourceFileNotFound bool   `protobuf:"varint,7,opt,name=ignore_source_file_not_found,json=ignoreSourceFileNotFound" json:"ignore_source_file_not_found,omitempty"`
 }
 
 func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
 func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*CopyFileRequest) ProtoMessage()               {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *CopyFileRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -704,6 +874,13 @@ func (m *CopyFileRequest) GetIsEcVolume() bool {
 	return false
 }
 
+func (m *CopyFileRequest) GetIgnoreSourceFileNotFound() bool {
+	if m != nil {
+		return m.IgnoreSourceFileNotFound
+	}
+	return false
+}
+
 type CopyFileResponse struct {
 	FileContent []byte `protobuf:"bytes,1,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
 }
@@ -711,7 +888,7 @@ type CopyFileResponse struct {
 func (m *CopyFileResponse) Reset()                    { *m = CopyFileResponse{} }
 func (m *CopyFileResponse) String() string            { return proto.CompactTextString(m) }
 func (*CopyFileResponse) ProtoMessage()               {}
-func (*CopyFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*CopyFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *CopyFileResponse) GetFileContent() []byte {
 	if m != nil {
@@ -729,7 +906,7 @@ type VolumeTailSenderRequest struct {
 func (m *VolumeTailSenderRequest) Reset()                    { *m = VolumeTailSenderRequest{} }
 func (m *VolumeTailSenderRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailSenderRequest) ProtoMessage()               {}
-func (*VolumeTailSenderRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*VolumeTailSenderRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *VolumeTailSenderRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -761,7 +938,7 @@ type VolumeTailSenderResponse struct {
 func (m *VolumeTailSenderResponse) Reset()                    { *m = VolumeTailSenderResponse{} }
 func (m *VolumeTailSenderResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailSenderResponse) ProtoMessage()               {}
-func (*VolumeTailSenderResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*VolumeTailSenderResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *VolumeTailSenderResponse) GetNeedleHeader() []byte {
 	if m != nil {
@@ -794,7 +971,7 @@ type VolumeTailReceiverRequest struct {
 func (m *VolumeTailReceiverRequest) Reset()                    { *m = VolumeTailReceiverRequest{} }
 func (m *VolumeTailReceiverRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailReceiverRequest) ProtoMessage()               {}
-func (*VolumeTailReceiverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*VolumeTailReceiverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *VolumeTailReceiverRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -830,17 +1007,20 @@ type VolumeTailReceiverResponse struct {
 func (m *VolumeTailReceiverResponse) Reset()                    { *m = VolumeTailReceiverResponse{} }
 func (m *VolumeTailReceiverResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailReceiverResponse) ProtoMessage()               {}
-func (*VolumeTailReceiverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*VolumeTailReceiverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 type VolumeEcShardsGenerateRequest struct {
 	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	// the ec scheme, 0 for the default 10+4
+	DataShards   uint32 `protobuf:"varint,3,opt,name=data_shards,json=dataShards" json:"data_shards,omitempty"`
+	ParityShards uint32 `protobuf:"varint,4,opt,name=parity_shards,json=parityShards" json:"parity_shards,omitempty"`
 }
 
 func (m *VolumeEcShardsGenerateRequest) Reset()                    { *m = VolumeEcShardsGenerateRequest{} }
 func (m *VolumeEcShardsGenerateRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsGenerateRequest) ProtoMessage()               {}
-func (*VolumeEcShardsGenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*VolumeEcShardsGenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *VolumeEcShardsGenerateRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -856,13 +1036,27 @@ func (m *VolumeEcShardsGenerateRequest) GetCollection() string {
 	return ""
 }
 
+func (m *VolumeEcShardsGenerateRequest) GetDataShards() uint32 {
+	if m != nil {
+		return m.DataShards
+	}
+	return 0
+}
+
+func (m *VolumeEcShardsGenerateRequest) GetParityShards() uint32 {
+	if m != nil {
+		return m.ParityShards
+	}
+	return 0
+}
+
 type VolumeEcShardsGenerateResponse struct {
 }
 
 func (m *VolumeEcShardsGenerateResponse) Reset()                    { *m = VolumeEcShardsGenerateResponse{} }
 func (m *VolumeEcShardsGenerateResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsGenerateResponse) ProtoMessage()               {}
-func (*VolumeEcShardsGenerateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*VolumeEcShardsGenerateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 type VolumeEcShardsRebuildRequest struct {
 	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -872,7 +1066,7 @@ type VolumeEcShardsRebuildRequest struct {
 func (m *VolumeEcShardsRebuildRequest) Reset()                    { *m = VolumeEcShardsRebuildRequest{} }
 func (m *VolumeEcShardsRebuildRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsRebuildRequest) ProtoMessage()               {}
-func (*VolumeEcShardsRebuildRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*VolumeEcShardsRebuildRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *VolumeEcShardsRebuildRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -895,7 +1089,7 @@ type VolumeEcShardsRebuildResponse struct {
 func (m *VolumeEcShardsRebuildResponse) Reset()                    { *m = VolumeEcShardsRebuildResponse{} }
 func (m *VolumeEcShardsRebuildResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsRebuildResponse) ProtoMessage()               {}
-func (*VolumeEcShardsRebuildResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*VolumeEcShardsRebuildResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *VolumeEcShardsRebuildResponse) GetRebuiltShardIds() []uint32 {
 	if m != nil {
@@ -905,17 +1099,18 @@ func (m *VolumeEcShardsRebuildResponse) GetRebuiltShardIds() []uint32 {
 }
 
 type VolumeEcShardsCopyRequest struct {
-	VolumeId       uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
-	Collection     string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
-	ShardIds       []uint32 `protobuf:"varint,3,rep,packed,name=shard_ids,json=shardIds" json:"shard_ids,omitempty"`
-	CopyEcxFile    bool     `protobuf:"varint,4,opt,name=copy_ecx_file,json=copyEcxFile" json:"copy_ecx_file,omitempty"`
-	SourceDataNode string   `protobuf:"bytes,5,opt,name=source_data_node,json=sourceDataNode" json:"source_data_node,omitempty"`
+	VolumeId           uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection         string   `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	ShardIds           []uint32 `protobuf:"varint,3,rep,packed,name=shard_ids,json=shardIds" json:"shard_ids,omitempty"`
+	CopyEcxFile        bool     `protobuf:"varint,4,opt,name=copy_ecx_file,json=copyEcxFile" json:"copy_ecx_file,omitempty"`
+	SourceDataNode     string   `protobuf:"bytes,5,opt,name=source_data_node,json=sourceDataNode" json:"source_data_node,omitempty"`
+	CopyCompactedFiles bool     `protobuf:"varint,6,opt,name=copy_compacted_files,json=copyCompactedFiles" json:"copy_compacted_files,omitempty"`
 }
 
 func (m *VolumeEcShardsCopyRequest) Reset()                    { *m = VolumeEcShardsCopyRequest{} }
 func (m *VolumeEcShardsCopyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsCopyRequest) ProtoMessage()               {}
-func (*VolumeEcShardsCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*VolumeEcShardsCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *VolumeEcShardsCopyRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -952,13 +1147,20 @@ func (m *VolumeEcShardsCopyRequest) GetSourceDataNode() string {
 	return ""
 }
 
+func (m *VolumeEcShardsCopyRequest) GetCopyCompactedFiles() bool {
+	if m != nil {
+		return m.CopyCompactedFiles
+	}
+	return false
+}
+
 type VolumeEcShardsCopyResponse struct {
 }
 
 func (m *VolumeEcShardsCopyResponse) Reset()                    { *m = VolumeEcShardsCopyResponse{} }
 func (m *VolumeEcShardsCopyResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsCopyResponse) ProtoMessage()               {}
-func (*VolumeEcShardsCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*VolumeEcShardsCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 type VolumeEcShardsDeleteRequest struct {
 	VolumeId   uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -969,7 +1171,7 @@ type VolumeEcShardsDeleteRequest struct {
 func (m *VolumeEcShardsDeleteRequest) Reset()                    { *m = VolumeEcShardsDeleteRequest{} }
 func (m *VolumeEcShardsDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsDeleteRequest) ProtoMessage()               {}
-func (*VolumeEcShardsDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*VolumeEcShardsDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *VolumeEcShardsDeleteRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -998,7 +1200,7 @@ type VolumeEcShardsDeleteResponse struct {
 func (m *VolumeEcShardsDeleteResponse) Reset()                    { *m = VolumeEcShardsDeleteResponse{} }
 func (m *VolumeEcShardsDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsDeleteResponse) ProtoMessage()               {}
-func (*VolumeEcShardsDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*VolumeEcShardsDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 type VolumeEcShardsMountRequest struct {
 	VolumeId   uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1009,7 +1211,7 @@ type VolumeEcShardsMountRequest struct {
 func (m *VolumeEcShardsMountRequest) Reset()                    { *m = VolumeEcShardsMountRequest{} }
 func (m *VolumeEcShardsMountRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsMountRequest) ProtoMessage()               {}
-func (*VolumeEcShardsMountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*VolumeEcShardsMountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *VolumeEcShardsMountRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1038,7 +1240,7 @@ type VolumeEcShardsMountResponse struct {
 func (m *VolumeEcShardsMountResponse) Reset()                    { *m = VolumeEcShardsMountResponse{} }
 func (m *VolumeEcShardsMountResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsMountResponse) ProtoMessage()               {}
-func (*VolumeEcShardsMountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*VolumeEcShardsMountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 type VolumeEcShardsUnmountRequest struct {
 	VolumeId uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1048,7 +1250,7 @@ type VolumeEcShardsUnmountRequest struct {
 func (m *VolumeEcShardsUnmountRequest) Reset()                    { *m = VolumeEcShardsUnmountRequest{} }
 func (m *VolumeEcShardsUnmountRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsUnmountRequest) ProtoMessage()               {}
-func (*VolumeEcShardsUnmountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*VolumeEcShardsUnmountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *VolumeEcShardsUnmountRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1070,20 +1272,21 @@ type VolumeEcShardsUnmountResponse struct {
 func (m *VolumeEcShardsUnmountResponse) Reset()                    { *m = VolumeEcShardsUnmountResponse{} }
 func (m *VolumeEcShardsUnmountResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsUnmountResponse) ProtoMessage()               {}
-func (*VolumeEcShardsUnmountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*VolumeEcShardsUnmountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 type VolumeEcShardReadRequest struct {
-	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
-	ShardId  uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
-	Offset   int64  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
-	Size     int64  `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
-	FileKey  uint64 `protobuf:"varint,5,opt,name=file_key,json=fileKey" json:"file_key,omitempty"`
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	ShardId    uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Offset     int64  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	Size       int64  `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
+	FileKey    uint64 `protobuf:"varint,5,opt,name=file_key,json=fileKey" json:"file_key,omitempty"`
+	Generation uint32 `protobuf:"varint,6,opt,name=generation" json:"generation,omitempty"`
 }
 
 func (m *VolumeEcShardReadRequest) Reset()                    { *m = VolumeEcShardReadRequest{} }
 func (m *VolumeEcShardReadRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardReadRequest) ProtoMessage()               {}
-func (*VolumeEcShardReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*VolumeEcShardReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *VolumeEcShardReadRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1120,6 +1323,13 @@ func (m *VolumeEcShardReadRequest) GetFileKey() uint64 {
 	return 0
 }
 
+func (m *VolumeEcShardReadRequest) GetGeneration() uint32 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
 type VolumeEcShardReadResponse struct {
 	Data      []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	IsDeleted bool   `protobuf:"varint,2,opt,name=is_deleted,json=isDeleted" json:"is_deleted,omitempty"`
@@ -1128,7 +1338,7 @@ type VolumeEcShardReadResponse struct {
 func (m *VolumeEcShardReadResponse) Reset()                    { *m = VolumeEcShardReadResponse{} }
 func (m *VolumeEcShardReadResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardReadResponse) ProtoMessage()               {}
-func (*VolumeEcShardReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*VolumeEcShardReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *VolumeEcShardReadResponse) GetData() []byte {
 	if m != nil {
@@ -1154,7 +1364,7 @@ type VolumeEcBlobDeleteRequest struct {
 func (m *VolumeEcBlobDeleteRequest) Reset()                    { *m = VolumeEcBlobDeleteRequest{} }
 func (m *VolumeEcBlobDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcBlobDeleteRequest) ProtoMessage()               {}
-func (*VolumeEcBlobDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*VolumeEcBlobDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *VolumeEcBlobDeleteRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1184,13 +1394,325 @@ func (m *VolumeEcBlobDeleteRequest) GetVersion() uint32 {
 	return 0
 }
 
-type VolumeEcBlobDeleteResponse struct {
+type VolumeEcBlobDeleteResponse struct {
+}
+
+func (m *VolumeEcBlobDeleteResponse) Reset()                    { *m = VolumeEcBlobDeleteResponse{} }
+func (m *VolumeEcBlobDeleteResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeEcBlobDeleteResponse) ProtoMessage()               {}
+func (*VolumeEcBlobDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+type VolumeEcShardsToVolumeRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VolumeEcShardsToVolumeRequest) Reset()                    { *m = VolumeEcShardsToVolumeRequest{} }
+func (m *VolumeEcShardsToVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeEcShardsToVolumeRequest) ProtoMessage()               {}
+func (*VolumeEcShardsToVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *VolumeEcShardsToVolumeRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VolumeEcShardsToVolumeRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VolumeEcShardsToVolumeResponse struct {
+	Replication string `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
+}
+
+func (m *VolumeEcShardsToVolumeResponse) Reset()                    { *m = VolumeEcShardsToVolumeResponse{} }
+func (m *VolumeEcShardsToVolumeResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeEcShardsToVolumeResponse) ProtoMessage()               {}
+func (*VolumeEcShardsToVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *VolumeEcShardsToVolumeResponse) GetReplication() string {
+	if m != nil {
+		return m.Replication
+	}
+	return ""
+}
+
+type VolumeEcShardsVerifyRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VolumeEcShardsVerifyRequest) Reset()                    { *m = VolumeEcShardsVerifyRequest{} }
+func (m *VolumeEcShardsVerifyRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeEcShardsVerifyRequest) ProtoMessage()               {}
+func (*VolumeEcShardsVerifyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *VolumeEcShardsVerifyRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VolumeEcShardsVerifyRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VolumeEcShardsVerifyResponse struct {
+	Offset      int64    `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	Size        int64    `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	BadShardIds []uint32 `protobuf:"varint,3,rep,packed,name=bad_shard_ids,json=badShardIds" json:"bad_shard_ids,omitempty"`
+}
+
+func (m *VolumeEcShardsVerifyResponse) Reset()                    { *m = VolumeEcShardsVerifyResponse{} }
+func (m *VolumeEcShardsVerifyResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeEcShardsVerifyResponse) ProtoMessage()               {}
+func (*VolumeEcShardsVerifyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *VolumeEcShardsVerifyResponse) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *VolumeEcShardsVerifyResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *VolumeEcShardsVerifyResponse) GetBadShardIds() []uint32 {
+	if m != nil {
+		return m.BadShardIds
+	}
+	return nil
+}
+
+type VacuumEcVolumeCheckRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VacuumEcVolumeCheckRequest) Reset()                    { *m = VacuumEcVolumeCheckRequest{} }
+func (m *VacuumEcVolumeCheckRequest) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCheckRequest) ProtoMessage()               {}
+func (*VacuumEcVolumeCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *VacuumEcVolumeCheckRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VacuumEcVolumeCheckRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VacuumEcVolumeCheckResponse struct {
+	GarbageRatio float64 `protobuf:"fixed64,1,opt,name=garbage_ratio,json=garbageRatio" json:"garbage_ratio,omitempty"`
+}
+
+func (m *VacuumEcVolumeCheckResponse) Reset()                    { *m = VacuumEcVolumeCheckResponse{} }
+func (m *VacuumEcVolumeCheckResponse) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCheckResponse) ProtoMessage()               {}
+func (*VacuumEcVolumeCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *VacuumEcVolumeCheckResponse) GetGarbageRatio() float64 {
+	if m != nil {
+		return m.GarbageRatio
+	}
+	return 0
+}
+
+type VacuumEcVolumeCompactRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VacuumEcVolumeCompactRequest) Reset()                    { *m = VacuumEcVolumeCompactRequest{} }
+func (m *VacuumEcVolumeCompactRequest) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCompactRequest) ProtoMessage()               {}
+func (*VacuumEcVolumeCompactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *VacuumEcVolumeCompactRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VacuumEcVolumeCompactRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VacuumEcVolumeCompactResponse struct {
+}
+
+func (m *VacuumEcVolumeCompactResponse) Reset()                    { *m = VacuumEcVolumeCompactResponse{} }
+func (m *VacuumEcVolumeCompactResponse) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCompactResponse) ProtoMessage()               {}
+func (*VacuumEcVolumeCompactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+type VacuumEcVolumePrepareRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VacuumEcVolumePrepareRequest) Reset()                    { *m = VacuumEcVolumePrepareRequest{} }
+func (m *VacuumEcVolumePrepareRequest) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumePrepareRequest) ProtoMessage()               {}
+func (*VacuumEcVolumePrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *VacuumEcVolumePrepareRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VacuumEcVolumePrepareRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VacuumEcVolumePrepareResponse struct {
+}
+
+func (m *VacuumEcVolumePrepareResponse) Reset()                    { *m = VacuumEcVolumePrepareResponse{} }
+func (m *VacuumEcVolumePrepareResponse) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumePrepareResponse) ProtoMessage()               {}
+func (*VacuumEcVolumePrepareResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+type VacuumEcVolumeCommitRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VacuumEcVolumeCommitRequest) Reset()                    { *m = VacuumEcVolumeCommitRequest{} }
+func (m *VacuumEcVolumeCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCommitRequest) ProtoMessage()               {}
+func (*VacuumEcVolumeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *VacuumEcVolumeCommitRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VacuumEcVolumeCommitRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VacuumEcVolumeCommitResponse struct {
+	ShardIds []uint32 `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds" json:"shard_ids,omitempty"`
+}
+
+func (m *VacuumEcVolumeCommitResponse) Reset()                    { *m = VacuumEcVolumeCommitResponse{} }
+func (m *VacuumEcVolumeCommitResponse) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCommitResponse) ProtoMessage()               {}
+func (*VacuumEcVolumeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *VacuumEcVolumeCommitResponse) GetShardIds() []uint32 {
+	if m != nil {
+		return m.ShardIds
+	}
+	return nil
+}
+
+type VacuumEcVolumeRollbackRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VacuumEcVolumeRollbackRequest) Reset()                    { *m = VacuumEcVolumeRollbackRequest{} }
+func (m *VacuumEcVolumeRollbackRequest) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeRollbackRequest) ProtoMessage()               {}
+func (*VacuumEcVolumeRollbackRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *VacuumEcVolumeRollbackRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VacuumEcVolumeRollbackRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VacuumEcVolumeRollbackResponse struct {
+	ShardIds []uint32 `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds" json:"shard_ids,omitempty"`
+}
+
+func (m *VacuumEcVolumeRollbackResponse) Reset()                    { *m = VacuumEcVolumeRollbackResponse{} }
+func (m *VacuumEcVolumeRollbackResponse) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeRollbackResponse) ProtoMessage()               {}
+func (*VacuumEcVolumeRollbackResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *VacuumEcVolumeRollbackResponse) GetShardIds() []uint32 {
+	if m != nil {
+		return m.ShardIds
+	}
+	return nil
+}
+
+type VacuumEcVolumeCleanupRequest struct {
+	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *VacuumEcVolumeCleanupRequest) Reset()                    { *m = VacuumEcVolumeCleanupRequest{} }
+func (m *VacuumEcVolumeCleanupRequest) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCleanupRequest) ProtoMessage()               {}
+func (*VacuumEcVolumeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *VacuumEcVolumeCleanupRequest) GetVolumeId() uint32 {
+	if m != nil {
+		return m.VolumeId
+	}
+	return 0
+}
+
+func (m *VacuumEcVolumeCleanupRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type VacuumEcVolumeCleanupResponse struct {
 }
 
-func (m *VolumeEcBlobDeleteResponse) Reset()                    { *m = VolumeEcBlobDeleteResponse{} }
-func (m *VolumeEcBlobDeleteResponse) String() string            { return proto.CompactTextString(m) }
-func (*VolumeEcBlobDeleteResponse) ProtoMessage()               {}
-func (*VolumeEcBlobDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (m *VacuumEcVolumeCleanupResponse) Reset()                    { *m = VacuumEcVolumeCleanupResponse{} }
+func (m *VacuumEcVolumeCleanupResponse) String() string            { return proto.CompactTextString(m) }
+func (*VacuumEcVolumeCleanupResponse) ProtoMessage()               {}
+func (*VacuumEcVolumeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 type ReadVolumeFileStatusRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1199,7 +1721,7 @@ type ReadVolumeFileStatusRequest struct {
 func (m *ReadVolumeFileStatusRequest) Reset()                    { *m = ReadVolumeFileStatusRequest{} }
 func (m *ReadVolumeFileStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReadVolumeFileStatusRequest) ProtoMessage()               {}
-func (*ReadVolumeFileStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*ReadVolumeFileStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *ReadVolumeFileStatusRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1222,7 +1744,7 @@ type ReadVolumeFileStatusResponse struct {
 func (m *ReadVolumeFileStatusResponse) Reset()                    { *m = ReadVolumeFileStatusResponse{} }
 func (m *ReadVolumeFileStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadVolumeFileStatusResponse) ProtoMessage()               {}
-func (*ReadVolumeFileStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*ReadVolumeFileStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *ReadVolumeFileStatusResponse) GetVolumeId() uint32 {
 	if m != nil {
@@ -1290,7 +1812,7 @@ type DiskStatus struct {
 func (m *DiskStatus) Reset()                    { *m = DiskStatus{} }
 func (m *DiskStatus) String() string            { return proto.CompactTextString(m) }
 func (*DiskStatus) ProtoMessage()               {}
-func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *DiskStatus) GetDir() string {
 	if m != nil {
@@ -1333,7 +1855,7 @@ type MemStatus struct {
 func (m *MemStatus) Reset()                    { *m = MemStatus{} }
 func (m *MemStatus) String() string            { return proto.CompactTextString(m) }
 func (*MemStatus) ProtoMessage()               {}
-func (*MemStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*MemStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *MemStatus) GetGoroutines() int32 {
 	if m != nil {
@@ -1413,6 +1935,12 @@ func init() {
 	proto.RegisterType((*VolumeDeleteResponse)(nil), "volume_server_pb.VolumeDeleteResponse")
 	proto.RegisterType((*VolumeMarkReadonlyRequest)(nil), "volume_server_pb.VolumeMarkReadonlyRequest")
 	proto.RegisterType((*VolumeMarkReadonlyResponse)(nil), "volume_server_pb.VolumeMarkReadonlyResponse")
+	proto.RegisterType((*VolumeServerDrainRequest)(nil), "volume_server_pb.VolumeServerDrainRequest")
+	proto.RegisterType((*VolumeServerDrainResponse)(nil), "volume_server_pb.VolumeServerDrainResponse")
+	proto.RegisterType((*ReadNeedleBlobRequest)(nil), "volume_server_pb.ReadNeedleBlobRequest")
+	proto.RegisterType((*ReadNeedleBlobResponse)(nil), "volume_server_pb.ReadNeedleBlobResponse")
+	proto.RegisterType((*WriteNeedleBlobRequest)(nil), "volume_server_pb.WriteNeedleBlobRequest")
+	proto.RegisterType((*WriteNeedleBlobResponse)(nil), "volume_server_pb.WriteNeedleBlobResponse")
 	proto.RegisterType((*VolumeCopyRequest)(nil), "volume_server_pb.VolumeCopyRequest")
 	proto.RegisterType((*VolumeCopyResponse)(nil), "volume_server_pb.VolumeCopyResponse")
 	proto.RegisterType((*CopyFileRequest)(nil), "volume_server_pb.CopyFileRequest")
@@ -1437,6 +1965,22 @@ func init() {
 	proto.RegisterType((*VolumeEcShardReadResponse)(nil), "volume_server_pb.VolumeEcShardReadResponse")
 	proto.RegisterType((*VolumeEcBlobDeleteRequest)(nil), "volume_server_pb.VolumeEcBlobDeleteRequest")
 	proto.RegisterType((*VolumeEcBlobDeleteResponse)(nil), "volume_server_pb.VolumeEcBlobDeleteResponse")
+	proto.RegisterType((*VolumeEcShardsToVolumeRequest)(nil), "volume_server_pb.VolumeEcShardsToVolumeRequest")
+	proto.RegisterType((*VolumeEcShardsToVolumeResponse)(nil), "volume_server_pb.VolumeEcShardsToVolumeResponse")
+	proto.RegisterType((*VolumeEcShardsVerifyRequest)(nil), "volume_server_pb.VolumeEcShardsVerifyRequest")
+	proto.RegisterType((*VolumeEcShardsVerifyResponse)(nil), "volume_server_pb.VolumeEcShardsVerifyResponse")
+	proto.RegisterType((*VacuumEcVolumeCheckRequest)(nil), "volume_server_pb.VacuumEcVolumeCheckRequest")
+	proto.RegisterType((*VacuumEcVolumeCheckResponse)(nil), "volume_server_pb.VacuumEcVolumeCheckResponse")
+	proto.RegisterType((*VacuumEcVolumeCompactRequest)(nil), "volume_server_pb.VacuumEcVolumeCompactRequest")
+	proto.RegisterType((*VacuumEcVolumeCompactResponse)(nil), "volume_server_pb.VacuumEcVolumeCompactResponse")
+	proto.RegisterType((*VacuumEcVolumePrepareRequest)(nil), "volume_server_pb.VacuumEcVolumePrepareRequest")
+	proto.RegisterType((*VacuumEcVolumePrepareResponse)(nil), "volume_server_pb.VacuumEcVolumePrepareResponse")
+	proto.RegisterType((*VacuumEcVolumeCommitRequest)(nil), "volume_server_pb.VacuumEcVolumeCommitRequest")
+	proto.RegisterType((*VacuumEcVolumeCommitResponse)(nil), "volume_server_pb.VacuumEcVolumeCommitResponse")
+	proto.RegisterType((*VacuumEcVolumeRollbackRequest)(nil), "volume_server_pb.VacuumEcVolumeRollbackRequest")
+	proto.RegisterType((*VacuumEcVolumeRollbackResponse)(nil), "volume_server_pb.VacuumEcVolumeRollbackResponse")
+	proto.RegisterType((*VacuumEcVolumeCleanupRequest)(nil), "volume_server_pb.VacuumEcVolumeCleanupRequest")
+	proto.RegisterType((*VacuumEcVolumeCleanupResponse)(nil), "volume_server_pb.VacuumEcVolumeCleanupResponse")
 	proto.RegisterType((*ReadVolumeFileStatusRequest)(nil), "volume_server_pb.ReadVolumeFileStatusRequest")
 	proto.RegisterType((*ReadVolumeFileStatusResponse)(nil), "volume_server_pb.ReadVolumeFileStatusResponse")
 	proto.RegisterType((*DiskStatus)(nil), "volume_server_pb.DiskStatus")
@@ -1468,12 +2012,18 @@ type VolumeServerClient interface {
 	VolumeUnmount(ctx context.Context, in *VolumeUnmountRequest, opts ...grpc.CallOption) (*VolumeUnmountResponse, error)
 	VolumeDelete(ctx context.Context, in *VolumeDeleteRequest, opts ...grpc.CallOption) (*VolumeDeleteResponse, error)
 	VolumeMarkReadonly(ctx context.Context, in *VolumeMarkReadonlyRequest, opts ...grpc.CallOption) (*VolumeMarkReadonlyResponse, error)
+	// stop accepting new volumes, used when evacuating the volume server
+	VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error)
 	// copy the .idx .dat files, and mount this volume
 	VolumeCopy(ctx context.Context, in *VolumeCopyRequest, opts ...grpc.CallOption) (*VolumeCopyResponse, error)
 	ReadVolumeFileStatus(ctx context.Context, in *ReadVolumeFileStatusRequest, opts ...grpc.CallOption) (*ReadVolumeFileStatusResponse, error)
 	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (VolumeServer_CopyFileClient, error)
 	VolumeTailSender(ctx context.Context, in *VolumeTailSenderRequest, opts ...grpc.CallOption) (VolumeServer_VolumeTailSenderClient, error)
 	VolumeTailReceiver(ctx context.Context, in *VolumeTailReceiverRequest, opts ...grpc.CallOption) (*VolumeTailReceiverResponse, error)
+	// read the raw needle, used by read repair
+	ReadNeedleBlob(ctx context.Context, in *ReadNeedleBlobRequest, opts ...grpc.CallOption) (*ReadNeedleBlobResponse, error)
+	// write the raw needle to the local replica only, used by volume.check.disk
+	WriteNeedleBlob(ctx context.Context, in *WriteNeedleBlobRequest, opts ...grpc.CallOption) (*WriteNeedleBlobResponse, error)
 	// erasure coding
 	VolumeEcShardsGenerate(ctx context.Context, in *VolumeEcShardsGenerateRequest, opts ...grpc.CallOption) (*VolumeEcShardsGenerateResponse, error)
 	VolumeEcShardsRebuild(ctx context.Context, in *VolumeEcShardsRebuildRequest, opts ...grpc.CallOption) (*VolumeEcShardsRebuildResponse, error)
@@ -1483,6 +2033,14 @@ type VolumeServerClient interface {
 	VolumeEcShardsUnmount(ctx context.Context, in *VolumeEcShardsUnmountRequest, opts ...grpc.CallOption) (*VolumeEcShardsUnmountResponse, error)
 	VolumeEcShardRead(ctx context.Context, in *VolumeEcShardReadRequest, opts ...grpc.CallOption) (VolumeServer_VolumeEcShardReadClient, error)
 	VolumeEcBlobDelete(ctx context.Context, in *VolumeEcBlobDeleteRequest, opts ...grpc.CallOption) (*VolumeEcBlobDeleteResponse, error)
+	VolumeEcShardsToVolume(ctx context.Context, in *VolumeEcShardsToVolumeRequest, opts ...grpc.CallOption) (*VolumeEcShardsToVolumeResponse, error)
+	VolumeEcShardsVerify(ctx context.Context, in *VolumeEcShardsVerifyRequest, opts ...grpc.CallOption) (VolumeServer_VolumeEcShardsVerifyClient, error)
+	VacuumEcVolumeCheck(ctx context.Context, in *VacuumEcVolumeCheckRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCheckResponse, error)
+	VacuumEcVolumeCompact(ctx context.Context, in *VacuumEcVolumeCompactRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCompactResponse, error)
+	VacuumEcVolumePrepare(ctx context.Context, in *VacuumEcVolumePrepareRequest, opts ...grpc.CallOption) (*VacuumEcVolumePrepareResponse, error)
+	VacuumEcVolumeCommit(ctx context.Context, in *VacuumEcVolumeCommitRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCommitResponse, error)
+	VacuumEcVolumeRollback(ctx context.Context, in *VacuumEcVolumeRollbackRequest, opts ...grpc.CallOption) (*VacuumEcVolumeRollbackResponse, error)
+	VacuumEcVolumeCleanup(ctx context.Context, in *VacuumEcVolumeCleanupRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCleanupResponse, error)
 }
 
 type volumeServerClient struct {
@@ -1633,6 +2191,15 @@ func (c *volumeServerClient) VolumeMarkReadonly(ctx context.Context, in *VolumeM
 	return out, nil
 }
 
+func (c *volumeServerClient) VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error) {
+	out := new(VolumeServerDrainResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeServerDrain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeServerClient) VolumeCopy(ctx context.Context, in *VolumeCopyRequest, opts ...grpc.CallOption) (*VolumeCopyResponse, error) {
 	out := new(VolumeCopyResponse)
 	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeCopy", in, out, c.cc, opts...)
@@ -1724,6 +2291,24 @@ func (c *volumeServerClient) VolumeTailReceiver(ctx context.Context, in *VolumeT
 	return out, nil
 }
 
+func (c *volumeServerClient) ReadNeedleBlob(ctx context.Context, in *ReadNeedleBlobRequest, opts ...grpc.CallOption) (*ReadNeedleBlobResponse, error) {
+	out := new(ReadNeedleBlobResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/ReadNeedleBlob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) WriteNeedleBlob(ctx context.Context, in *WriteNeedleBlobRequest, opts ...grpc.CallOption) (*WriteNeedleBlobResponse, error) {
+	out := new(WriteNeedleBlobResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/WriteNeedleBlob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeServerClient) VolumeEcShardsGenerate(ctx context.Context, in *VolumeEcShardsGenerateRequest, opts ...grpc.CallOption) (*VolumeEcShardsGenerateResponse, error) {
 	out := new(VolumeEcShardsGenerateResponse)
 	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeEcShardsGenerate", in, out, c.cc, opts...)
@@ -1819,6 +2404,101 @@ func (c *volumeServerClient) VolumeEcBlobDelete(ctx context.Context, in *VolumeE
 	return out, nil
 }
 
+func (c *volumeServerClient) VolumeEcShardsToVolume(ctx context.Context, in *VolumeEcShardsToVolumeRequest, opts ...grpc.CallOption) (*VolumeEcShardsToVolumeResponse, error) {
+	out := new(VolumeEcShardsToVolumeResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeEcShardsToVolume", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VolumeEcShardsVerify(ctx context.Context, in *VolumeEcShardsVerifyRequest, opts ...grpc.CallOption) (VolumeServer_VolumeEcShardsVerifyClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[4], c.cc, "/volume_server_pb.VolumeServer/VolumeEcShardsVerify", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeServerVolumeEcShardsVerifyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VolumeServer_VolumeEcShardsVerifyClient interface {
+	Recv() (*VolumeEcShardsVerifyResponse, error)
+	grpc.ClientStream
+}
+
+type volumeServerVolumeEcShardsVerifyClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeServerVolumeEcShardsVerifyClient) Recv() (*VolumeEcShardsVerifyResponse, error) {
+	m := new(VolumeEcShardsVerifyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *volumeServerClient) VacuumEcVolumeCheck(ctx context.Context, in *VacuumEcVolumeCheckRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCheckResponse, error) {
+	out := new(VacuumEcVolumeCheckResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumEcVolumeCheck", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VacuumEcVolumeCompact(ctx context.Context, in *VacuumEcVolumeCompactRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCompactResponse, error) {
+	out := new(VacuumEcVolumeCompactResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumEcVolumeCompact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VacuumEcVolumePrepare(ctx context.Context, in *VacuumEcVolumePrepareRequest, opts ...grpc.CallOption) (*VacuumEcVolumePrepareResponse, error) {
+	out := new(VacuumEcVolumePrepareResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumEcVolumePrepare", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VacuumEcVolumeCommit(ctx context.Context, in *VacuumEcVolumeCommitRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCommitResponse, error) {
+	out := new(VacuumEcVolumeCommitResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumEcVolumeCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VacuumEcVolumeRollback(ctx context.Context, in *VacuumEcVolumeRollbackRequest, opts ...grpc.CallOption) (*VacuumEcVolumeRollbackResponse, error) {
+	out := new(VacuumEcVolumeRollbackResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumEcVolumeRollback", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeServerClient) VacuumEcVolumeCleanup(ctx context.Context, in *VacuumEcVolumeCleanupRequest, opts ...grpc.CallOption) (*VacuumEcVolumeCleanupResponse, error) {
+	out := new(VacuumEcVolumeCleanupResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumEcVolumeCleanup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for VolumeServer service
 
 type VolumeServerServer interface {
@@ -1836,12 +2516,18 @@ type VolumeServerServer interface {
 	VolumeUnmount(context.Context, *VolumeUnmountRequest) (*VolumeUnmountResponse, error)
 	VolumeDelete(context.Context, *VolumeDeleteRequest) (*VolumeDeleteResponse, error)
 	VolumeMarkReadonly(context.Context, *VolumeMarkReadonlyRequest) (*VolumeMarkReadonlyResponse, error)
+	// stop accepting new volumes, used when evacuating the volume server
+	VolumeServerDrain(context.Context, *VolumeServerDrainRequest) (*VolumeServerDrainResponse, error)
 	// copy the .idx .dat files, and mount this volume
 	VolumeCopy(context.Context, *VolumeCopyRequest) (*VolumeCopyResponse, error)
 	ReadVolumeFileStatus(context.Context, *ReadVolumeFileStatusRequest) (*ReadVolumeFileStatusResponse, error)
 	CopyFile(*CopyFileRequest, VolumeServer_CopyFileServer) error
 	VolumeTailSender(*VolumeTailSenderRequest, VolumeServer_VolumeTailSenderServer) error
 	VolumeTailReceiver(context.Context, *VolumeTailReceiverRequest) (*VolumeTailReceiverResponse, error)
+	// read the raw needle, used by read repair
+	ReadNeedleBlob(context.Context, *ReadNeedleBlobRequest) (*ReadNeedleBlobResponse, error)
+	// write the raw needle to the local replica only, used by volume.check.disk
+	WriteNeedleBlob(context.Context, *WriteNeedleBlobRequest) (*WriteNeedleBlobResponse, error)
 	// erasure coding
 	VolumeEcShardsGenerate(context.Context, *VolumeEcShardsGenerateRequest) (*VolumeEcShardsGenerateResponse, error)
 	VolumeEcShardsRebuild(context.Context, *VolumeEcShardsRebuildRequest) (*VolumeEcShardsRebuildResponse, error)
@@ -1851,6 +2537,14 @@ type VolumeServerServer interface {
 	VolumeEcShardsUnmount(context.Context, *VolumeEcShardsUnmountRequest) (*VolumeEcShardsUnmountResponse, error)
 	VolumeEcShardRead(*VolumeEcShardReadRequest, VolumeServer_VolumeEcShardReadServer) error
 	VolumeEcBlobDelete(context.Context, *VolumeEcBlobDeleteRequest) (*VolumeEcBlobDeleteResponse, error)
+	VolumeEcShardsToVolume(context.Context, *VolumeEcShardsToVolumeRequest) (*VolumeEcShardsToVolumeResponse, error)
+	VolumeEcShardsVerify(*VolumeEcShardsVerifyRequest, VolumeServer_VolumeEcShardsVerifyServer) error
+	VacuumEcVolumeCheck(context.Context, *VacuumEcVolumeCheckRequest) (*VacuumEcVolumeCheckResponse, error)
+	VacuumEcVolumeCompact(context.Context, *VacuumEcVolumeCompactRequest) (*VacuumEcVolumeCompactResponse, error)
+	VacuumEcVolumePrepare(context.Context, *VacuumEcVolumePrepareRequest) (*VacuumEcVolumePrepareResponse, error)
+	VacuumEcVolumeCommit(context.Context, *VacuumEcVolumeCommitRequest) (*VacuumEcVolumeCommitResponse, error)
+	VacuumEcVolumeRollback(context.Context, *VacuumEcVolumeRollbackRequest) (*VacuumEcVolumeRollbackResponse, error)
+	VacuumEcVolumeCleanup(context.Context, *VacuumEcVolumeCleanupRequest) (*VacuumEcVolumeCleanupResponse, error)
 }
 
 func RegisterVolumeServerServer(s *grpc.Server, srv VolumeServerServer) {
@@ -2094,6 +2788,24 @@ func _VolumeServer_VolumeMarkReadonly_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_VolumeServerDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeServerDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeServerDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeServerDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeServerDrain(ctx, req.(*VolumeServerDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeServer_VolumeCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VolumeCopyRequest)
 	if err := dec(in); err != nil {
@@ -2190,6 +2902,42 @@ func _VolumeServer_VolumeTailReceiver_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_ReadNeedleBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNeedleBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).ReadNeedleBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/ReadNeedleBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).ReadNeedleBlob(ctx, req.(*ReadNeedleBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_WriteNeedleBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteNeedleBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).WriteNeedleBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/WriteNeedleBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).WriteNeedleBlob(ctx, req.(*WriteNeedleBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeServer_VolumeEcShardsGenerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VolumeEcShardsGenerateRequest)
 	if err := dec(in); err != nil {
@@ -2337,6 +3085,153 @@ func _VolumeServer_VolumeEcBlobDelete_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_VolumeEcShardsToVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeEcShardsToVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeEcShardsToVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeEcShardsToVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeEcShardsToVolume(ctx, req.(*VolumeEcShardsToVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VolumeEcShardsVerify_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VolumeEcShardsVerifyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumeServerServer).VolumeEcShardsVerify(m, &volumeServerVolumeEcShardsVerifyServer{stream})
+}
+
+type VolumeServer_VolumeEcShardsVerifyServer interface {
+	Send(*VolumeEcShardsVerifyResponse) error
+	grpc.ServerStream
+}
+
+type volumeServerVolumeEcShardsVerifyServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeServerVolumeEcShardsVerifyServer) Send(m *VolumeEcShardsVerifyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VolumeServer_VacuumEcVolumeCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VacuumEcVolumeCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VacuumEcVolumeCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VacuumEcVolumeCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VacuumEcVolumeCheck(ctx, req.(*VacuumEcVolumeCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VacuumEcVolumeCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VacuumEcVolumeCompactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VacuumEcVolumeCompact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VacuumEcVolumeCompact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VacuumEcVolumeCompact(ctx, req.(*VacuumEcVolumeCompactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VacuumEcVolumePrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VacuumEcVolumePrepareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VacuumEcVolumePrepare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VacuumEcVolumePrepare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VacuumEcVolumePrepare(ctx, req.(*VacuumEcVolumePrepareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VacuumEcVolumeCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VacuumEcVolumeCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VacuumEcVolumeCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VacuumEcVolumeCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VacuumEcVolumeCommit(ctx, req.(*VacuumEcVolumeCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VacuumEcVolumeRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VacuumEcVolumeRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VacuumEcVolumeRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VacuumEcVolumeRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VacuumEcVolumeRollback(ctx, req.(*VacuumEcVolumeRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeServer_VacuumEcVolumeCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VacuumEcVolumeCleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VacuumEcVolumeCleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VacuumEcVolumeCleanup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VacuumEcVolumeCleanup(ctx, req.(*VacuumEcVolumeCleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "volume_server_pb.VolumeServer",
 	HandlerType: (*VolumeServerServer)(nil),
@@ -2389,6 +3284,10 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeMarkReadonly",
 			Handler:    _VolumeServer_VolumeMarkReadonly_Handler,
 		},
+		{
+			MethodName: "VolumeServerDrain",
+			Handler:    _VolumeServer_VolumeServerDrain_Handler,
+		},
 		{
 			MethodName: "VolumeCopy",
 			Handler:    _VolumeServer_VolumeCopy_Handler,
@@ -2401,6 +3300,14 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeTailReceiver",
 			Handler:    _VolumeServer_VolumeTailReceiver_Handler,
 		},
+		{
+			MethodName: "ReadNeedleBlob",
+			Handler:    _VolumeServer_ReadNeedleBlob_Handler,
+		},
+		{
+			MethodName: "WriteNeedleBlob",
+			Handler:    _VolumeServer_WriteNeedleBlob_Handler,
+		},
 		{
 			MethodName: "VolumeEcShardsGenerate",
 			Handler:    _VolumeServer_VolumeEcShardsGenerate_Handler,
@@ -2429,6 +3336,34 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeEcBlobDelete",
 			Handler:    _VolumeServer_VolumeEcBlobDelete_Handler,
 		},
+		{
+			MethodName: "VolumeEcShardsToVolume",
+			Handler:    _VolumeServer_VolumeEcShardsToVolume_Handler,
+		},
+		{
+			MethodName: "VacuumEcVolumeCheck",
+			Handler:    _VolumeServer_VacuumEcVolumeCheck_Handler,
+		},
+		{
+			MethodName: "VacuumEcVolumeCompact",
+			Handler:    _VolumeServer_VacuumEcVolumeCompact_Handler,
+		},
+		{
+			MethodName: "VacuumEcVolumePrepare",
+			Handler:    _VolumeServer_VacuumEcVolumePrepare_Handler,
+		},
+		{
+			MethodName: "VacuumEcVolumeCommit",
+			Handler:    _VolumeServer_VacuumEcVolumeCommit_Handler,
+		},
+		{
+			MethodName: "VacuumEcVolumeRollback",
+			Handler:    _VolumeServer_VacuumEcVolumeRollback_Handler,
+		},
+		{
+			MethodName: "VacuumEcVolumeCleanup",
+			Handler:    _VolumeServer_VacuumEcVolumeCleanup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2451,6 +3386,11 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			Handler:       _VolumeServer_VolumeEcShardRead_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "VolumeEcShardsVerify",
+			Handler:       _VolumeServer_VolumeEcShardsVerify_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "volume_server.proto",
 }
@@ -2458,126 +3398,161 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("volume_server.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1924 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x19, 0xcb, 0x72, 0xdc, 0xc6,
-	0x91, 0xd0, 0x2e, 0xb9, 0xbb, 0xbd, 0x4b, 0x89, 0x1c, 0x52, 0xe4, 0x0a, 0x14, 0x29, 0x1a, 0x76,
-	0x6c, 0x8a, 0xb2, 0x49, 0x45, 0xae, 0x24, 0x4e, 0x72, 0x48, 0x44, 0x8a, 0x49, 0x54, 0x8e, 0xe9,
-	0x2a, 0x50, 0x56, 0x39, 0x65, 0x57, 0xa1, 0x86, 0xc0, 0x50, 0x44, 0x11, 0x0b, 0x40, 0x98, 0x01,
-	0xa5, 0x55, 0x25, 0x27, 0xe5, 0x9a, 0x0f, 0xc8, 0x39, 0xb7, 0x1c, 0x72, 0xcd, 0x07, 0xe4, 0x17,
-	0x72, 0xcb, 0x37, 0xe4, 0x0b, 0x72, 0x49, 0xcd, 0x03, 0x58, 0x3c, 0x77, 0xc1, 0x90, 0x55, 0xb9,
-	0x61, 0x7b, 0xfa, 0x3d, 0xdd, 0x3d, 0xdd, 0xbd, 0xb0, 0x72, 0x19, 0x78, 0xf1, 0x88, 0x58, 0x94,
-	0x44, 0x97, 0x24, 0xda, 0x0b, 0xa3, 0x80, 0x05, 0x68, 0x29, 0x07, 0xb4, 0xc2, 0x53, 0x63, 0x1f,
-	0xd0, 0x01, 0x66, 0xf6, 0xf9, 0x33, 0xe2, 0x11, 0x46, 0x4c, 0xf2, 0x3a, 0x26, 0x94, 0xa1, 0x7b,
-	0xd0, 0x3d, 0x73, 0x3d, 0x62, 0xb9, 0x0e, 0x1d, 0x6a, 0xdb, 0xad, 0x9d, 0x9e, 0xd9, 0xe1, 0xbf,
-	0x9f, 0x3b, 0xd4, 0xf8, 0x1a, 0x56, 0x72, 0x04, 0x34, 0x0c, 0x7c, 0x4a, 0xd0, 0x17, 0xd0, 0x89,
-	0x08, 0x8d, 0x3d, 0x26, 0x09, 0xfa, 0x4f, 0xb6, 0xf6, 0x8a, 0xb2, 0xf6, 0x52, 0x92, 0xd8, 0x63,
-	0x66, 0x82, 0x6e, 0xbc, 0xd7, 0x60, 0x90, 0x3d, 0x41, 0xeb, 0xd0, 0x51, 0xc2, 0x87, 0xda, 0xb6,
-	0xb6, 0xd3, 0x33, 0x17, 0xa4, 0x6c, 0xb4, 0x06, 0x0b, 0x94, 0x61, 0x16, 0xd3, 0xe1, 0xad, 0x6d,
-	0x6d, 0x67, 0xde, 0x54, 0xbf, 0xd0, 0x2a, 0xcc, 0x93, 0x28, 0x0a, 0xa2, 0x61, 0x4b, 0xa0, 0xcb,
-	0x1f, 0x08, 0x41, 0x9b, 0xba, 0xef, 0xc8, 0xb0, 0xbd, 0xad, 0xed, 0x2c, 0x9a, 0xe2, 0x1b, 0x0d,
-	0xa1, 0x73, 0x49, 0x22, 0xea, 0x06, 0xfe, 0x70, 0x5e, 0x80, 0x93, 0x9f, 0x46, 0x07, 0xe6, 0x8f,
-	0x46, 0x21, 0x1b, 0x1b, 0x3f, 0x81, 0xe1, 0x4b, 0x6c, 0xc7, 0xf1, 0xe8, 0xa5, 0x50, 0xff, 0xf0,
-	0x9c, 0xd8, 0x17, 0x89, 0x5b, 0x36, 0xa0, 0xa7, 0x8c, 0x52, 0xba, 0x2d, 0x9a, 0x5d, 0x09, 0x78,
-	0xee, 0x18, 0xbf, 0x84, 0x7b, 0x15, 0x84, 0xca, 0x3d, 0x1f, 0xc2, 0xe2, 0x2b, 0x1c, 0x9d, 0xe2,
-	0x57, 0xc4, 0x8a, 0x30, 0x73, 0x03, 0x41, 0xad, 0x99, 0x03, 0x05, 0x34, 0x39, 0xcc, 0xf8, 0x0e,
-	0xf4, 0x1c, 0x87, 0x60, 0x14, 0x62, 0x9b, 0x35, 0x11, 0x8e, 0xb6, 0xa1, 0x1f, 0x46, 0x04, 0x7b,
-	0x5e, 0x60, 0x63, 0x46, 0x84, 0x7f, 0x5a, 0x66, 0x16, 0x64, 0x6c, 0xc2, 0x46, 0x25, 0x73, 0xa9,
-	0xa0, 0xf1, 0x45, 0x41, 0xfb, 0x60, 0x34, 0x72, 0x1b, 0x89, 0x36, 0xee, 0x97, 0xb4, 0x16, 0x94,
-	0x8a, 0xef, 0x4f, 0x0b, 0xa7, 0x1e, 0xc1, 0x7e, 0x1c, 0x36, 0x62, 0x5c, 0xd4, 0x38, 0x21, 0x4d,
-	0x39, 0xaf, 0xcb, 0xb0, 0x39, 0x0c, 0x3c, 0x8f, 0xd8, 0xcc, 0x0d, 0xfc, 0x84, 0xed, 0x16, 0x80,
-	0x9d, 0x02, 0x55, 0x10, 0x65, 0x20, 0x86, 0x0e, 0xc3, 0x32, 0xa9, 0x62, 0xfb, 0x57, 0x0d, 0xee,
-	0x3e, 0x55, 0x4e, 0x93, 0x82, 0x1b, 0x5d, 0x40, 0x5e, 0xe4, 0xad, 0xa2, 0xc8, 0xe2, 0x05, 0xb5,
-	0x4a, 0x17, 0xc4, 0x31, 0x22, 0x12, 0x7a, 0xae, 0x8d, 0x05, 0x8b, 0xb6, 0x60, 0x91, 0x05, 0xa1,
-	0x25, 0x68, 0x31, 0xe6, 0x89, 0xc8, 0xed, 0x99, 0xfc, 0xd3, 0x18, 0xc2, 0x5a, 0x51, 0x57, 0x65,
-	0xc6, 0x8f, 0x61, 0x5d, 0x42, 0x4e, 0xc6, 0xbe, 0x7d, 0x22, 0xf2, 0xa4, 0x91, 0xd3, 0xff, 0xa3,
-	0xc1, 0xb0, 0x4c, 0xa8, 0xa2, 0xf8, 0xba, 0x1e, 0xb8, 0xaa, 0x7d, 0xe8, 0x01, 0xf4, 0x19, 0x76,
-	0x3d, 0x2b, 0x38, 0x3b, 0xa3, 0x84, 0x0d, 0x17, 0xb6, 0xb5, 0x9d, 0xb6, 0x09, 0x1c, 0xf4, 0xb5,
-	0x80, 0xa0, 0x87, 0xb0, 0x64, 0xcb, 0x48, 0xb6, 0x22, 0x72, 0xe9, 0x8a, 0xcc, 0xee, 0x08, 0xc5,
-	0xee, 0xd8, 0x49, 0x84, 0x4b, 0x30, 0x32, 0x60, 0xd1, 0x75, 0xde, 0x5a, 0xa2, 0xb4, 0x88, 0xc2,
-	0xd0, 0x15, 0xdc, 0xfa, 0xae, 0xf3, 0xf6, 0x57, 0xae, 0x47, 0x4e, 0xdc, 0x77, 0xc4, 0x78, 0x09,
-	0xf7, 0xa5, 0xf1, 0xcf, 0x7d, 0x3b, 0x22, 0x23, 0xe2, 0x33, 0xec, 0x1d, 0x06, 0xe1, 0xb8, 0x51,
-	0x08, 0xdc, 0x83, 0x2e, 0x75, 0x7d, 0x9b, 0x58, 0xbe, 0x2c, 0x50, 0x6d, 0xb3, 0x23, 0x7e, 0x1f,
-	0x53, 0xe3, 0x00, 0x36, 0x6b, 0xf8, 0x2a, 0xcf, 0x7e, 0x00, 0x03, 0xa1, 0x98, 0x1d, 0xf8, 0x8c,
-	0xf8, 0x4c, 0xf0, 0x1e, 0x98, 0x7d, 0x0e, 0x3b, 0x94, 0x20, 0xe3, 0x87, 0x80, 0x24, 0x8f, 0xaf,
-	0x82, 0xd8, 0x6f, 0x96, 0x9a, 0x77, 0x61, 0x25, 0x47, 0xa2, 0x62, 0xe3, 0x73, 0x58, 0x95, 0xe0,
-	0x6f, 0xfc, 0x51, 0x63, 0x5e, 0xeb, 0x70, 0xb7, 0x40, 0xa4, 0xb8, 0x3d, 0x49, 0x84, 0xe4, 0x9f,
-	0x90, 0xa9, 0xcc, 0xd6, 0x12, 0x0d, 0xf2, 0xaf, 0x88, 0xa8, 0x42, 0x52, 0x61, 0x1c, 0x5d, 0x98,
-	0x04, 0x3b, 0x81, 0xef, 0x8d, 0x1b, 0x57, 0xa1, 0x0a, 0x4a, 0xc5, 0xf7, 0x6f, 0x1a, 0x2c, 0x27,
-	0xe5, 0xa9, 0xe1, 0x6d, 0x5e, 0x31, 0x9c, 0x5b, 0xb5, 0xe1, 0xdc, 0x9e, 0x84, 0xf3, 0x0e, 0x2c,
-	0xd1, 0x20, 0x8e, 0x6c, 0x62, 0x39, 0x98, 0x61, 0xcb, 0x0f, 0x1c, 0xa2, 0xa2, 0xfd, 0xb6, 0x84,
-	0x3f, 0xc3, 0x0c, 0x1f, 0x07, 0x0e, 0x31, 0x7e, 0x91, 0x5c, 0x76, 0x2e, 0x4a, 0x1e, 0xc2, 0xb2,
-	0x87, 0x29, 0xb3, 0x70, 0x18, 0x12, 0xdf, 0xb1, 0x30, 0xe3, 0xa1, 0xa6, 0x89, 0x50, 0xbb, 0xcd,
-	0x0f, 0x9e, 0x0a, 0xf8, 0x53, 0x76, 0x4c, 0x8d, 0x7f, 0x6a, 0x70, 0x87, 0xd3, 0xf2, 0xd0, 0x6e,
-	0x64, 0xef, 0x12, 0xb4, 0xc8, 0x5b, 0xa6, 0x0c, 0xe5, 0x9f, 0x68, 0x1f, 0x56, 0x54, 0x0e, 0xb9,
-	0x81, 0x3f, 0x49, 0xaf, 0x96, 0x20, 0x44, 0x93, 0xa3, 0x34, 0xc3, 0x1e, 0x40, 0x9f, 0xb2, 0x20,
-	0x4c, 0xb2, 0xb5, 0x2d, 0xb3, 0x95, 0x83, 0x54, 0xb6, 0xe6, 0x7d, 0x3a, 0x5f, 0xe1, 0xd3, 0x81,
-	0x4b, 0x2d, 0x62, 0x5b, 0x52, 0x2b, 0x91, 0xef, 0x5d, 0x13, 0x5c, 0x7a, 0x64, 0x4b, 0x6f, 0x18,
-	0x3f, 0x82, 0xa5, 0x89, 0x55, 0xcd, 0x73, 0xe7, 0xbd, 0x96, 0x94, 0xc3, 0x17, 0xd8, 0xf5, 0x4e,
-	0x88, 0xef, 0x90, 0xe8, 0x9a, 0x39, 0x8d, 0x1e, 0xc3, 0xaa, 0xeb, 0x78, 0xc4, 0x62, 0xee, 0x88,
-	0x04, 0x31, 0xb3, 0x28, 0xb1, 0x03, 0xdf, 0xa1, 0x89, 0x7f, 0xf8, 0xd9, 0x0b, 0x79, 0x74, 0x22,
-	0x4f, 0x8c, 0x3f, 0xa6, 0xb5, 0x35, 0xab, 0xc5, 0xa4, 0x43, 0xf0, 0x09, 0xe1, 0x0c, 0xcf, 0x09,
-	0x76, 0x48, 0xa4, 0xcc, 0x18, 0x48, 0xe0, 0x6f, 0x04, 0x8c, 0x7b, 0x58, 0x21, 0x9d, 0x06, 0xce,
-	0x58, 0x68, 0x34, 0x30, 0x41, 0x82, 0x0e, 0x02, 0x67, 0x2c, 0x8a, 0x1c, 0xb5, 0x44, 0x90, 0xd8,
-	0xe7, 0xb1, 0x7f, 0x21, 0xb4, 0xe9, 0x9a, 0x7d, 0x97, 0xfe, 0x16, 0x53, 0x76, 0xc8, 0x41, 0xc6,
-	0xdf, 0xb5, 0x24, 0xcb, 0xb8, 0x1a, 0x26, 0xb1, 0x89, 0x7b, 0xf9, 0x7f, 0x70, 0x07, 0xa7, 0x50,
-	0xd9, 0x90, 0xeb, 0x14, 0x55, 0xc2, 0x20, 0x79, 0xa6, 0xde, 0x22, 0x71, 0x32, 0x49, 0xf2, 0xbc,
-	0xe2, 0x2a, 0xc9, 0xbf, 0x4f, 0x8a, 0xec, 0x91, 0x7d, 0x72, 0x8e, 0x23, 0x87, 0xfe, 0x9a, 0xf8,
-	0x24, 0xc2, 0xec, 0x46, 0x1e, 0x70, 0x63, 0x1b, 0xb6, 0xea, 0xb8, 0x2b, 0xf9, 0xdf, 0x25, 0x8f,
-	0x47, 0x82, 0x61, 0x92, 0xd3, 0xd8, 0xf5, 0x9c, 0x1b, 0x11, 0xff, 0x65, 0xd1, 0xb8, 0x94, 0xb9,
-	0x8a, 0x9f, 0x5d, 0x58, 0x8e, 0x04, 0x88, 0x59, 0x94, 0x23, 0xa4, 0xbd, 0xfb, 0xa2, 0x79, 0x47,
-	0x1d, 0x08, 0x42, 0xde, 0xc3, 0xff, 0x23, 0x8d, 0x80, 0x84, 0xdb, 0x8d, 0x95, 0xc5, 0x0d, 0xe8,
-	0x4d, 0xc4, 0xb7, 0x84, 0xf8, 0x2e, 0x55, 0x72, 0x79, 0x74, 0xda, 0x41, 0x38, 0xb6, 0x88, 0x2d,
-	0xdf, 0x61, 0x71, 0xd5, 0x5d, 0xb3, 0xcf, 0x81, 0x47, 0xb6, 0x78, 0x86, 0xaf, 0x50, 0x23, 0xd3,
-	0x68, 0xc8, 0x1b, 0xa1, 0x6e, 0xe3, 0x0d, 0x6c, 0xe4, 0x4f, 0x9b, 0x3f, 0x4f, 0xd7, 0x32, 0xd2,
-	0xd8, 0x2a, 0x86, 0x41, 0xe1, 0x8d, 0xbb, 0x2c, 0xaa, 0xdd, 0xf8, 0x3d, 0xbf, 0x9e, 0x5e, 0x9b,
-	0x45, 0x87, 0xe4, 0x9b, 0x82, 0x6f, 0x8b, 0x6a, 0x5f, 0xa1, 0x39, 0x98, 0x2e, 0xf8, 0x41, 0x31,
-	0x74, 0x8b, 0x1d, 0xc4, 0x9f, 0xd3, 0xba, 0xa8, 0x30, 0xf8, 0xfb, 0xdd, 0xb8, 0x1e, 0x29, 0xb9,
-	0xc2, 0x1d, 0x8b, 0x66, 0x47, 0x89, 0xe5, 0xc3, 0xa2, 0x7a, 0x87, 0x64, 0xaf, 0xad, 0x7e, 0xe5,
-	0xc6, 0xc2, 0x96, 0x1a, 0x0b, 0x93, 0x71, 0xf7, 0x82, 0x8c, 0x45, 0xac, 0xb5, 0xe5, 0xb8, 0xfb,
-	0x25, 0x19, 0x1b, 0xc7, 0x85, 0x4c, 0x91, 0xaa, 0xa9, 0x9c, 0x43, 0xd0, 0xe6, 0x41, 0xaa, 0x4a,
-	0xb5, 0xf8, 0x46, 0x9b, 0x00, 0x2e, 0xb5, 0x1c, 0x71, 0xe7, 0x52, 0xa9, 0xae, 0xd9, 0x73, 0x55,
-	0x10, 0x38, 0xc6, 0x9f, 0x32, 0xa9, 0x77, 0xe0, 0x05, 0xa7, 0x37, 0x18, 0x95, 0x59, 0x2b, 0x5a,
-	0x39, 0x2b, 0xb2, 0x73, 0x6f, 0x3b, 0x3f, 0xf7, 0x66, 0x92, 0x28, 0xab, 0x8e, 0xba, 0x99, 0x9f,
-	0xc1, 0x06, 0x37, 0x58, 0x62, 0x88, 0x2e, 0xb9, 0xf9, 0x24, 0xf1, 0xef, 0x5b, 0x70, 0xbf, 0x9a,
-	0xb8, 0xc9, 0x34, 0xf1, 0x73, 0xd0, 0xd3, 0x6e, 0x9d, 0x3f, 0x29, 0x94, 0xe1, 0x51, 0x98, 0x3e,
-	0x2a, 0xf2, 0xed, 0x59, 0x57, 0xad, 0xfb, 0x8b, 0xe4, 0x3c, 0x79, 0x59, 0x4a, 0xad, 0x7e, 0xab,
-	0xd4, 0xea, 0x73, 0x01, 0x0e, 0x66, 0x75, 0x02, 0x64, 0xef, 0xb2, 0xee, 0x60, 0x56, 0x27, 0x20,
-	0x25, 0x16, 0x02, 0x64, 0xd4, 0xf4, 0x15, 0xbe, 0x10, 0xb0, 0x09, 0xa0, 0xda, 0x92, 0xd8, 0x4f,
-	0x46, 0x97, 0x9e, 0x6c, 0x4a, 0x62, 0xbf, 0xb6, 0xbb, 0xea, 0xd4, 0x76, 0x57, 0xf9, 0xeb, 0xef,
-	0x96, 0x5e, 0x88, 0x6f, 0x01, 0x9e, 0xb9, 0xf4, 0x42, 0x3a, 0x99, 0xb7, 0x73, 0x8e, 0x1b, 0xa9,
-	0xd9, 0x97, 0x7f, 0x72, 0x08, 0xf6, 0x3c, 0xe5, 0x3a, 0xfe, 0xc9, 0xc3, 0x37, 0xa6, 0xc4, 0x51,
-	0xde, 0x11, 0xdf, 0x1c, 0x76, 0x16, 0x11, 0xa2, 0x1c, 0x20, 0xbe, 0x8d, 0xbf, 0x68, 0xd0, 0xfb,
-	0x8a, 0x8c, 0x14, 0xe7, 0x2d, 0x80, 0x57, 0x41, 0x14, 0xc4, 0xcc, 0xf5, 0x89, 0xec, 0x3e, 0xe7,
-	0xcd, 0x0c, 0xe4, 0x7f, 0x97, 0x23, 0x52, 0x93, 0x78, 0x67, 0xca, 0x99, 0xe2, 0x9b, 0xc3, 0xce,
-	0x09, 0x0e, 0x95, 0xff, 0xc4, 0x37, 0x5a, 0x85, 0x79, 0xca, 0xb0, 0x7d, 0x21, 0x9c, 0xd5, 0x36,
-	0xe5, 0x8f, 0x27, 0xff, 0x5a, 0x83, 0x41, 0xb6, 0x5b, 0x40, 0xdf, 0x43, 0x3f, 0xb3, 0xa9, 0x42,
-	0x1f, 0x95, 0x17, 0x52, 0xe5, 0xcd, 0x97, 0xfe, 0x83, 0x19, 0x58, 0x2a, 0x31, 0xe6, 0x90, 0x0f,
-	0xcb, 0xa5, 0x75, 0x0f, 0xda, 0x2d, 0x53, 0xd7, 0x2d, 0x93, 0xf4, 0x47, 0x8d, 0x70, 0x53, 0x79,
-	0x0c, 0x56, 0x2a, 0xf6, 0x37, 0xe8, 0xd3, 0x19, 0x5c, 0x72, 0x3b, 0x24, 0xfd, 0xb3, 0x86, 0xd8,
-	0xa9, 0xd4, 0xd7, 0x80, 0xca, 0xcb, 0x1d, 0xf4, 0x68, 0x26, 0x9b, 0xc9, 0xf2, 0x48, 0xff, 0xb4,
-	0x19, 0x72, 0xad, 0xa1, 0x72, 0xed, 0x33, 0xd3, 0xd0, 0xdc, 0x62, 0x69, 0xa6, 0xa1, 0x85, 0x5d,
-	0xd2, 0x1c, 0xba, 0x80, 0xa5, 0xe2, 0x4a, 0x08, 0x3d, 0xac, 0x5b, 0x61, 0x96, 0x36, 0x4e, 0xfa,
-	0x6e, 0x13, 0xd4, 0x54, 0x18, 0x81, 0xdb, 0xf9, 0xb5, 0x0d, 0xfa, 0xa4, 0x4c, 0x5f, 0xb9, 0x84,
-	0xd2, 0x77, 0x66, 0x23, 0x66, 0x6d, 0x2a, 0xae, 0x72, 0xaa, 0x6c, 0xaa, 0xd9, 0x13, 0x55, 0xd9,
-	0x54, 0xb7, 0x19, 0x32, 0xe6, 0xd0, 0xef, 0x93, 0xfd, 0x40, 0x61, 0xc5, 0x81, 0xf6, 0xea, 0xd8,
-	0x54, 0xef, 0x58, 0xf4, 0xfd, 0xc6, 0xf8, 0x89, 0xec, 0xc7, 0x1a, 0xcf, 0xf5, 0xcc, 0xa6, 0xa3,
-	0x2a, 0xd7, 0xcb, 0xbb, 0x93, 0xaa, 0x5c, 0xaf, 0x5a, 0x97, 0xcc, 0xa1, 0x53, 0x58, 0xcc, 0xed,
-	0x3e, 0xd0, 0xc7, 0x75, 0x94, 0xf9, 0xa6, 0x49, 0xff, 0x64, 0x26, 0x5e, 0x2a, 0xc3, 0x4a, 0xaa,
-	0x97, 0x2a, 0x57, 0xb5, 0xca, 0xe5, 0xeb, 0xd5, 0xc7, 0xb3, 0xd0, 0x72, 0xa9, 0x5c, 0xda, 0x90,
-	0x54, 0xa6, 0x72, 0xdd, 0x06, 0xa6, 0x32, 0x95, 0xeb, 0x97, 0x2e, 0x73, 0xe8, 0x77, 0x00, 0x93,
-	0x2d, 0x06, 0xfa, 0xb0, 0x8e, 0x3a, 0x7b, 0xfb, 0x1f, 0x4d, 0x47, 0x4a, 0x59, 0xbf, 0x81, 0xd5,
-	0xaa, 0xe6, 0x02, 0x55, 0x24, 0xfe, 0x94, 0x0e, 0x46, 0xdf, 0x6b, 0x8a, 0x9e, 0x0a, 0xfe, 0x06,
-	0xba, 0xc9, 0x06, 0x02, 0x7d, 0x50, 0xa6, 0x2e, 0xec, 0x5c, 0x74, 0x63, 0x1a, 0x4a, 0x26, 0x80,
-	0x47, 0x49, 0xae, 0x4e, 0x56, 0x03, 0xf5, 0xb9, 0x5a, 0x5a, 0x62, 0xd4, 0xe7, 0x6a, 0x79, 0xd3,
-	0x20, 0xc4, 0xa5, 0xc1, 0x90, 0x9d, 0xa4, 0xeb, 0x83, 0xa1, 0x62, 0x51, 0x50, 0x1f, 0x0c, 0x95,
-	0xc3, 0xf9, 0x1c, 0xfa, 0x03, 0xac, 0x55, 0x0f, 0xd0, 0xa8, 0x36, 0xe3, 0x6b, 0x06, 0x79, 0xfd,
-	0x71, 0x73, 0x82, 0x54, 0xfc, 0xbb, 0xa4, 0x3e, 0x15, 0x06, 0xe8, 0xfa, 0xfa, 0x54, 0x3d, 0xc6,
-	0xeb, 0xfb, 0x8d, 0xf1, 0xcb, 0xa9, 0x97, 0x9d, 0x54, 0xeb, 0xbd, 0x5d, 0x31, 0x94, 0xd7, 0x7b,
-	0xbb, 0x72, 0xf8, 0x15, 0xf9, 0x51, 0x35, 0x85, 0x56, 0xe5, 0xc7, 0x94, 0x31, 0x59, 0xdf, 0x6b,
-	0x8a, 0x9e, 0x7b, 0xbe, 0xcb, 0x63, 0x26, 0x9a, 0xa9, 0x7f, 0xae, 0x32, 0x7f, 0xd6, 0x10, 0xbb,
-	0xfe, 0x76, 0x93, 0x4a, 0x3d, 0xd3, 0x80, 0x42, 0xc5, 0xde, 0x6f, 0x8c, 0x9f, 0xca, 0x0e, 0x93,
-	0xdd, 0x72, 0x66, 0x44, 0x44, 0xbb, 0x33, 0xf8, 0x64, 0x46, 0x5c, 0xfd, 0x51, 0x23, 0xdc, 0xaa,
-	0xec, 0xcd, 0x0e, 0x6d, 0xd3, 0xe2, 0xa9, 0x34, 0x69, 0x4e, 0x8b, 0xa7, 0x8a, 0x39, 0x70, 0xee,
-	0x74, 0x41, 0xfc, 0x81, 0xfc, 0xf9, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x44, 0x1a, 0xc5,
-	0x57, 0x1e, 0x00, 0x00,
+	// 2493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5b, 0x73, 0xdc, 0x48,
+	0x15, 0x66, 0x3c, 0x63, 0x7b, 0x7c, 0xc6, 0x4e, 0xec, 0x8e, 0x2f, 0x63, 0xf9, 0x12, 0xaf, 0xf6,
+	0xe6, 0x38, 0x8e, 0x1d, 0x76, 0x0b, 0x58, 0xd8, 0xe2, 0x92, 0x38, 0x59, 0x48, 0x2d, 0xeb, 0x80,
+	0x9c, 0x0d, 0x7b, 0x49, 0x95, 0xaa, 0x47, 0x6a, 0xc7, 0x2a, 0x6b, 0xa4, 0x59, 0xa9, 0xc7, 0x9b,
+	0x49, 0xc1, 0xd3, 0xc2, 0x23, 0x6f, 0xfc, 0x02, 0x78, 0xe3, 0x81, 0x57, 0x8a, 0x1f, 0xc5, 0x23,
+	0x55, 0x54, 0xf1, 0x42, 0xf5, 0x45, 0x1a, 0xb5, 0xd4, 0xf2, 0x74, 0x36, 0xa6, 0x78, 0xd3, 0x9c,
+	0x3e, 0xb7, 0x3e, 0x3a, 0xfd, 0xf5, 0xd1, 0x39, 0x03, 0x37, 0x2e, 0xe2, 0x70, 0xd8, 0x27, 0x6e,
+	0x4a, 0x92, 0x0b, 0x92, 0x1c, 0x0c, 0x92, 0x98, 0xc6, 0x68, 0x51, 0x21, 0xba, 0x83, 0x9e, 0xfd,
+	0x87, 0x06, 0xa0, 0xfb, 0x98, 0x7a, 0x67, 0x0f, 0x48, 0x48, 0x28, 0x71, 0xc8, 0x57, 0x43, 0x92,
+	0x52, 0xb4, 0x0e, 0xed, 0xd3, 0x20, 0x24, 0x6e, 0xe0, 0xa7, 0xdd, 0xc6, 0x4e, 0x73, 0x77, 0xce,
+	0x99, 0x65, 0xbf, 0x1f, 0xf9, 0x29, 0xda, 0x83, 0xa5, 0xf4, 0x3c, 0x18, 0xb8, 0x5e, 0x1c, 0x9f,
+	0x07, 0xc4, 0xf5, 0xce, 0x88, 0x77, 0xde, 0x9d, 0xda, 0x69, 0xec, 0xb6, 0x9d, 0xeb, 0x6c, 0xe1,
+	0x88, 0xd3, 0x8f, 0x18, 0x19, 0xed, 0xc2, 0x62, 0xf0, 0x3c, 0x8a, 0x13, 0xe2, 0x26, 0x04, 0xfb,
+	0x6e, 0x1c, 0x85, 0xa3, 0x6e, 0x93, 0xb3, 0x5e, 0x13, 0x74, 0x87, 0x60, 0xff, 0x71, 0x14, 0x8e,
+	0xec, 0xc7, 0x70, 0x43, 0x71, 0x23, 0x1d, 0xc4, 0x51, 0x4a, 0xd0, 0x07, 0x30, 0x9b, 0x90, 0x74,
+	0x18, 0x52, 0xe1, 0x46, 0xe7, 0xbd, 0xed, 0x83, 0xf2, 0x16, 0x0e, 0x72, 0x91, 0x61, 0x48, 0x9d,
+	0x8c, 0xdd, 0xfe, 0xa6, 0x01, 0xf3, 0xc5, 0x15, 0xb4, 0x06, 0xb3, 0x72, 0x4b, 0xdd, 0xc6, 0x4e,
+	0x63, 0x77, 0xce, 0x99, 0x11, 0x3b, 0x42, 0xab, 0x30, 0x93, 0x52, 0x4c, 0x87, 0x29, 0xdf, 0xc5,
+	0xb4, 0x23, 0x7f, 0xa1, 0x65, 0x98, 0x26, 0x49, 0x12, 0x27, 0xdc, 0xe3, 0x39, 0x47, 0xfc, 0x40,
+	0x08, 0x5a, 0x69, 0xf0, 0x92, 0x74, 0x5b, 0x3b, 0x8d, 0xdd, 0x05, 0x87, 0x3f, 0xa3, 0x2e, 0xcc,
+	0x5e, 0x90, 0x24, 0x0d, 0xe2, 0xa8, 0x3b, 0xcd, 0xc9, 0xd9, 0x4f, 0x7b, 0x16, 0xa6, 0x1f, 0xf6,
+	0x07, 0x74, 0x64, 0xff, 0x00, 0xba, 0x4f, 0xb1, 0x37, 0x1c, 0xf6, 0x9f, 0x72, 0xf7, 0x79, 0x78,
+	0xb2, 0x60, 0x6f, 0xc0, 0x9c, 0xdc, 0x94, 0xf4, 0x6d, 0xc1, 0x69, 0x0b, 0xc2, 0x23, 0xdf, 0xfe,
+	0x19, 0xac, 0x6b, 0x04, 0x65, 0x78, 0xde, 0x84, 0x85, 0xe7, 0x38, 0xe9, 0xe1, 0xe7, 0xc4, 0x4d,
+	0x30, 0x0d, 0x62, 0x2e, 0xdd, 0x70, 0xe6, 0x25, 0xd1, 0x61, 0x34, 0xfb, 0x4b, 0xb0, 0x14, 0x0d,
+	0x71, 0x7f, 0x80, 0x3d, 0x6a, 0x62, 0x1c, 0xed, 0x40, 0x67, 0x90, 0x10, 0x1c, 0x86, 0xb1, 0x87,
+	0x29, 0xe1, 0xf1, 0x69, 0x3a, 0x45, 0x92, 0xbd, 0x05, 0x1b, 0x5a, 0xe5, 0xc2, 0x41, 0xfb, 0x83,
+	0x92, 0xf7, 0x71, 0xbf, 0x1f, 0x18, 0x99, 0xb6, 0x37, 0xc1, 0xd2, 0x49, 0x4a, 0xbd, 0x3f, 0x2c,
+	0xad, 0x86, 0x04, 0x47, 0xc3, 0x81, 0x91, 0xe2, 0xb2, 0xc7, 0x99, 0x68, 0xae, 0x79, 0x4d, 0xa4,
+	0xcd, 0x51, 0x1c, 0x86, 0xc4, 0xa3, 0x41, 0x1c, 0x65, 0x6a, 0xb7, 0x01, 0xbc, 0x9c, 0x28, 0x93,
+	0xa8, 0x40, 0xb1, 0x2d, 0xe8, 0x56, 0x45, 0xa5, 0xda, 0xbf, 0x36, 0x60, 0xe5, 0x9e, 0x0c, 0x9a,
+	0x30, 0x6c, 0xf4, 0x02, 0x54, 0x93, 0x53, 0x65, 0x93, 0xe5, 0x17, 0xd4, 0xac, 0xbc, 0x20, 0xc6,
+	0x91, 0x90, 0x41, 0x18, 0x78, 0x98, 0xab, 0x68, 0x71, 0x15, 0x45, 0x12, 0x5a, 0x84, 0x26, 0xa5,
+	0x21, 0xcf, 0xdc, 0x39, 0x87, 0x3d, 0xda, 0x5d, 0x58, 0x2d, 0xfb, 0x2a, 0xb7, 0xf1, 0x7d, 0x58,
+	0x13, 0x94, 0x93, 0x51, 0xe4, 0x9d, 0xf0, 0x73, 0x62, 0x14, 0xf4, 0xff, 0x34, 0xa0, 0x5b, 0x15,
+	0x94, 0x59, 0xfc, 0xba, 0x11, 0x78, 0xd5, 0xfd, 0xa1, 0x9b, 0xd0, 0xa1, 0x38, 0x08, 0xdd, 0xf8,
+	0xf4, 0x34, 0x25, 0xb4, 0x3b, 0xb3, 0xd3, 0xd8, 0x6d, 0x39, 0xc0, 0x48, 0x8f, 0x39, 0x05, 0xdd,
+	0x82, 0x45, 0x4f, 0x64, 0xb2, 0x9b, 0x90, 0x8b, 0x80, 0x9f, 0xec, 0x59, 0xee, 0xd8, 0x75, 0x2f,
+	0xcb, 0x70, 0x41, 0x46, 0x36, 0x2c, 0x04, 0xfe, 0x0b, 0x97, 0x43, 0x0b, 0x07, 0x86, 0x36, 0xd7,
+	0xd6, 0x09, 0xfc, 0x17, 0x1f, 0x05, 0x21, 0x39, 0x09, 0x5e, 0x12, 0xfb, 0x29, 0x6c, 0x8a, 0xcd,
+	0x3f, 0x8a, 0xbc, 0x84, 0xf4, 0x49, 0x44, 0x71, 0x78, 0x14, 0x0f, 0x46, 0x46, 0x29, 0xb0, 0x0e,
+	0xed, 0x34, 0x88, 0x3c, 0xe2, 0x46, 0x02, 0xa0, 0x5a, 0xce, 0x2c, 0xff, 0x7d, 0x9c, 0xda, 0xf7,
+	0x61, 0xab, 0x46, 0xaf, 0x8c, 0xec, 0x1b, 0x30, 0xcf, 0x1d, 0xf3, 0xe2, 0x88, 0x92, 0x88, 0x72,
+	0xdd, 0xf3, 0x4e, 0x87, 0xd1, 0x8e, 0x04, 0xc9, 0xfe, 0x2e, 0x20, 0xa1, 0xe3, 0x93, 0x78, 0x18,
+	0x99, 0x1d, 0xcd, 0x15, 0xb8, 0xa1, 0x88, 0xc8, 0xdc, 0x78, 0x1f, 0x96, 0x05, 0xf9, 0xd3, 0xa8,
+	0x6f, 0xac, 0x6b, 0x0d, 0x56, 0x4a, 0x42, 0x52, 0xdb, 0x7b, 0x99, 0x11, 0xf5, 0x62, 0xba, 0x54,
+	0xd9, 0x2a, 0x2c, 0xab, 0x32, 0x05, 0x14, 0x12, 0x0e, 0xe3, 0xe4, 0x9c, 0x5d, 0x39, 0xec, 0x22,
+	0x32, 0x46, 0x21, 0x8d, 0x64, 0xee, 0x63, 0x96, 0xd4, 0xfc, 0x32, 0x7a, 0x90, 0xe0, 0x20, 0x07,
+	0x8b, 0x55, 0x98, 0xf1, 0x70, 0xe4, 0x91, 0x90, 0xeb, 0x6c, 0x3b, 0xf2, 0x97, 0xbd, 0x01, 0xeb,
+	0x1a, 0x19, 0xa9, 0xf0, 0xd7, 0xb0, 0xc2, 0x8c, 0x1c, 0x13, 0xe2, 0x87, 0xe4, 0x7e, 0x18, 0xf7,
+	0x8c, 0x32, 0x64, 0x03, 0xe6, 0x22, 0x2e, 0xc1, 0x16, 0x45, 0x8a, 0xb4, 0x05, 0xe1, 0x91, 0x6f,
+	0x9f, 0xc0, 0x6a, 0x59, 0xa5, 0x4c, 0x8e, 0x9b, 0xd0, 0x91, 0x62, 0xbd, 0x30, 0xee, 0xc9, 0xdc,
+	0x80, 0x28, 0x67, 0x2c, 0x5e, 0x6b, 0x53, 0xea, 0xb5, 0xf6, 0xf7, 0x06, 0xac, 0xfe, 0x26, 0x09,
+	0x28, 0xb9, 0x42, 0x4f, 0xcb, 0xfe, 0x34, 0x2f, 0xf3, 0xa7, 0xa5, 0xf8, 0xa3, 0xad, 0x33, 0xa6,
+	0xb5, 0x75, 0xc6, 0x3a, 0xac, 0x55, 0x1c, 0x97, 0xc1, 0xff, 0x5b, 0x03, 0x96, 0xb2, 0xcb, 0xc6,
+	0xf0, 0x6c, 0xbe, 0x22, 0x38, 0x35, 0x6b, 0xc1, 0xa9, 0x35, 0x06, 0xa7, 0x5d, 0x58, 0x4c, 0xe3,
+	0x61, 0xe2, 0x11, 0xd7, 0xc7, 0x14, 0xbb, 0x51, 0xec, 0x13, 0x89, 0x5d, 0xd7, 0x04, 0xfd, 0x01,
+	0xa6, 0xf8, 0x38, 0xf6, 0x89, 0xfd, 0x53, 0x40, 0x45, 0x7f, 0xe5, 0x6b, 0xbd, 0x05, 0x4b, 0x21,
+	0x4e, 0xa9, 0x8b, 0x07, 0x03, 0x12, 0xf9, 0x2e, 0xa6, 0x0c, 0x38, 0x1a, 0x3c, 0xd6, 0xd7, 0xd8,
+	0xc2, 0x3d, 0x4e, 0xbf, 0x47, 0x8f, 0x53, 0xfb, 0x4f, 0x53, 0x70, 0x9d, 0xc9, 0x32, 0xa0, 0x32,
+	0xda, 0xef, 0x22, 0x34, 0xc9, 0x0b, 0x2a, 0x37, 0xca, 0x1e, 0xd1, 0x21, 0xdc, 0x90, 0x88, 0x18,
+	0xc4, 0xd1, 0x18, 0x2c, 0x9b, 0x5c, 0x10, 0x8d, 0x97, 0x72, 0xbc, 0xbc, 0x09, 0x9d, 0x94, 0xc6,
+	0x83, 0x0c, 0x7b, 0x5b, 0x02, 0x7b, 0x19, 0x49, 0x62, 0xaf, 0x1a, 0xd3, 0x69, 0x4d, 0x4c, 0xe7,
+	0x83, 0xd4, 0x25, 0x9e, 0x2b, 0xbc, 0xe2, 0xe8, 0xdd, 0x76, 0x20, 0x48, 0x1f, 0x7a, 0x22, 0x1a,
+	0xe8, 0x27, 0xb0, 0x29, 0xb3, 0x41, 0x06, 0x92, 0x63, 0x60, 0x14, 0x53, 0xf7, 0x34, 0x1e, 0x46,
+	0x3e, 0x47, 0xf2, 0xb6, 0xd3, 0x15, 0x3c, 0x27, 0x9c, 0x85, 0x45, 0xe0, 0x38, 0xa6, 0x1f, 0xb1,
+	0x75, 0xfb, 0x7b, 0xb0, 0x38, 0x8e, 0x8a, 0x39, 0x92, 0x7e, 0xd3, 0xc8, 0x2e, 0xc7, 0x27, 0x38,
+	0x08, 0x4f, 0x48, 0xe4, 0x93, 0xe4, 0x35, 0x11, 0x1e, 0xdd, 0x85, 0xe5, 0x80, 0x9d, 0x08, 0x1a,
+	0xf4, 0x49, 0x3c, 0xa4, 0x6e, 0x4a, 0xbc, 0x38, 0xf2, 0xd3, 0x2c, 0xbe, 0x6c, 0xed, 0x89, 0x58,
+	0x3a, 0x11, 0x2b, 0xf6, 0xef, 0xf3, 0x9b, 0xb6, 0xe8, 0xc5, 0xb8, 0x5e, 0x94, 0x47, 0xec, 0x8c,
+	0x60, 0x9f, 0x24, 0x72, 0x1b, 0xf3, 0x82, 0xf8, 0x0b, 0x4e, 0x2b, 0x9e, 0xc3, 0xd8, 0x1f, 0x75,
+	0xa7, 0x94, 0x73, 0x18, 0xfb, 0x23, 0x7e, 0xe5, 0xa5, 0x2e, 0x4f, 0x32, 0xef, 0x6c, 0x18, 0x9d,
+	0xcb, 0x92, 0xbe, 0x13, 0xa4, 0xbf, 0xc4, 0x29, 0x3d, 0x62, 0x24, 0x86, 0x10, 0xeb, 0x63, 0x37,
+	0x1c, 0xe2, 0x91, 0xe0, 0xe2, 0xff, 0x10, 0x0e, 0x26, 0x21, 0x93, 0x40, 0xf9, 0x6e, 0x90, 0x07,
+	0x0e, 0x89, 0xb5, 0x22, 0x20, 0x8f, 0x21, 0x5f, 0x75, 0x5c, 0x82, 0xc4, 0x9f, 0x1b, 0xd9, 0x9d,
+	0xfb, 0xd0, 0x3b, 0x39, 0xc3, 0x89, 0x9f, 0xfe, 0x9c, 0x44, 0x24, 0xc1, 0xf4, 0x6a, 0xea, 0xb9,
+	0x9b, 0xd0, 0xe1, 0xa7, 0x3e, 0xe5, 0xaa, 0xe5, 0xbe, 0x80, 0x91, 0x84, 0x31, 0xf6, 0x06, 0x07,
+	0x38, 0x09, 0xe8, 0x28, 0x63, 0x11, 0x48, 0x38, 0x2f, 0x88, 0x82, 0xc9, 0xde, 0x81, 0xed, 0x3a,
+	0x1f, 0xe5, 0x36, 0xbe, 0x84, 0x4d, 0x95, 0xc3, 0x21, 0xbd, 0x61, 0x10, 0xfa, 0x57, 0xb1, 0x09,
+	0xfb, 0x63, 0xd8, 0xaa, 0x51, 0x2e, 0xd3, 0x70, 0x0f, 0x96, 0x12, 0x4e, 0xa2, 0x62, 0x17, 0xf9,
+	0x67, 0xe6, 0x82, 0x73, 0x5d, 0x2e, 0x70, 0xc1, 0x47, 0x7e, 0x6a, 0xff, 0x2b, 0x4f, 0xa4, 0x4c,
+	0xdb, 0x95, 0xa1, 0xf3, 0x06, 0xcc, 0x8d, 0xcd, 0x37, 0xb9, 0xf9, 0x76, 0x2a, 0xed, 0xb2, 0x24,
+	0xf7, 0xe2, 0xc1, 0xc8, 0x25, 0x9e, 0x28, 0xee, 0x78, 0xa0, 0xdb, 0x4e, 0x87, 0x11, 0x1f, 0x7a,
+	0xbc, 0xb6, 0x33, 0x87, 0x6a, 0x96, 0x86, 0x5c, 0x9b, 0x04, 0x44, 0xe2, 0x73, 0x9d, 0xa9, 0x04,
+	0x2f, 0xc4, 0xd6, 0x8e, 0xb2, 0x25, 0xa6, 0x3a, 0x1d, 0xa7, 0xa1, 0xba, 0x6d, 0xf9, 0xfe, 0xbe,
+	0x86, 0x0d, 0x75, 0xd5, 0xbc, 0x4a, 0x7a, 0xad, 0xb0, 0xd8, 0xdb, 0xb0, 0xa9, 0x37, 0x2c, 0x1d,
+	0xbb, 0x28, 0xbb, 0x6d, 0x5c, 0x56, 0xbe, 0x9e, 0x5f, 0x5b, 0xb0, 0xa1, 0xb5, 0x2b, 0xdd, 0xfa,
+	0xac, 0xec, 0xf6, 0x2b, 0xd4, 0xa8, 0x97, 0x1b, 0xbe, 0x09, 0x5b, 0x35, 0x9a, 0xa5, 0xe9, 0x7f,
+	0xe4, 0x80, 0x2c, 0x39, 0x58, 0x2d, 0x62, 0x0c, 0x84, 0xd2, 0x6e, 0x56, 0x80, 0x49, 0xb3, 0xac,
+	0xba, 0x94, 0x17, 0xa8, 0xf8, 0xe4, 0x93, 0xbf, 0x94, 0xee, 0x44, 0x53, 0x76, 0x27, 0xb2, 0x5e,
+	0xce, 0x39, 0x11, 0x45, 0x51, 0x4b, 0xf4, 0x72, 0x3e, 0x26, 0x23, 0x16, 0xf2, 0xe7, 0x02, 0x1a,
+	0x58, 0xc8, 0x67, 0x04, 0xda, 0x8c, 0x29, 0xf6, 0x31, 0xac, 0x6b, 0x5c, 0x97, 0xa7, 0x18, 0x41,
+	0x8b, 0xa5, 0xbd, 0xbc, 0x43, 0xf8, 0x33, 0xda, 0x02, 0x08, 0x52, 0xd7, 0xe7, 0x39, 0xe1, 0xcb,
+	0xae, 0xd0, 0x5c, 0x20, 0x93, 0xc4, 0xb7, 0xff, 0x58, 0x38, 0xcc, 0xac, 0xf6, 0xba, 0xc2, 0xac,
+	0x2d, 0xee, 0xb2, 0xa9, 0xee, 0xb2, 0xb6, 0x6e, 0x2c, 0x1e, 0xb2, 0xa2, 0x3b, 0xf2, 0xcd, 0x3d,
+	0x2b, 0xbf, 0xda, 0x27, 0xf1, 0xd5, 0x7d, 0xba, 0xdb, 0xf7, 0x61, 0xbb, 0x4e, 0xbb, 0x0c, 0x70,
+	0xa9, 0x7a, 0x6c, 0x54, 0xaa, 0x47, 0xfb, 0x8b, 0x72, 0xd6, 0x3f, 0x25, 0x49, 0x70, 0x7a, 0x25,
+	0xe8, 0x68, 0x47, 0xb0, 0xa9, 0xd7, 0x2d, 0xbd, 0x1b, 0xa7, 0x60, 0x43, 0x9b, 0x82, 0x53, 0x85,
+	0x14, 0xb4, 0x61, 0xa1, 0x87, 0x7d, 0xb7, 0x7c, 0x8a, 0x3a, 0x3d, 0xec, 0xe7, 0x40, 0xff, 0x79,
+	0xd6, 0xd2, 0x79, 0xe8, 0x29, 0xad, 0xae, 0x2b, 0x09, 0xf5, 0x86, 0x56, 0xf5, 0xab, 0x75, 0xd1,
+	0x36, 0x4b, 0x3a, 0x5e, 0xa1, 0x8f, 0x36, 0xc9, 0x41, 0x06, 0x22, 0x7a, 0xe5, 0x85, 0xfb, 0x5a,
+	0x61, 0xf8, 0x55, 0x42, 0x06, 0x38, 0x21, 0xff, 0x1b, 0xeb, 0xb9, 0x72, 0x69, 0xfd, 0x8b, 0x4a,
+	0xfc, 0x8c, 0xfb, 0x78, 0x13, 0x8d, 0x7f, 0x08, 0x9b, 0x7a, 0xdd, 0xe3, 0xe6, 0x50, 0xb9, 0x46,
+	0x18, 0x83, 0xef, 0xb3, 0xb2, 0xe7, 0x4e, 0x1c, 0x86, 0x3d, 0x7c, 0x45, 0x69, 0xf3, 0x63, 0xd8,
+	0xae, 0xd3, 0x6e, 0xe2, 0x5c, 0x35, 0x63, 0xcc, 0xbb, 0x94, 0xdf, 0x22, 0x63, 0x4a, 0x7d, 0xcc,
+	0x1f, 0xc1, 0x06, 0x43, 0x6b, 0xb1, 0xc8, 0x3b, 0x51, 0xe6, 0xdd, 0xba, 0x7f, 0x4e, 0xc1, 0xa6,
+	0x5e, 0xd8, 0xa4, 0x63, 0xf7, 0x21, 0x58, 0x79, 0x47, 0x8c, 0x15, 0xea, 0x29, 0xc5, 0xfd, 0x41,
+	0x5e, 0xaa, 0x8b, 0x8a, 0x7e, 0x4d, 0xb6, 0xc7, 0x9e, 0x64, 0xeb, 0x59, 0xbd, 0x5e, 0x69, 0xa7,
+	0x35, 0x2b, 0xed, 0x34, 0x66, 0xc0, 0xc7, 0xb4, 0xce, 0x80, 0xf8, 0xa2, 0x5c, 0xf3, 0x31, 0xad,
+	0x33, 0x90, 0x0b, 0x73, 0x03, 0xe2, 0x4a, 0xec, 0x48, 0x7e, 0x6e, 0x60, 0x0b, 0x40, 0x7e, 0xec,
+	0x0d, 0xa3, 0xac, 0x3d, 0x38, 0x27, 0x3e, 0xf5, 0x86, 0x51, 0xed, 0x37, 0xef, 0x6c, 0xed, 0x37,
+	0xaf, 0xfa, 0x32, 0xdb, 0x95, 0x97, 0xf9, 0x19, 0xc0, 0x83, 0x20, 0x3d, 0x17, 0x41, 0x66, 0x1f,
+	0xd9, 0x7e, 0x90, 0x48, 0xb8, 0x67, 0x8f, 0x8c, 0x82, 0xc3, 0x50, 0x86, 0x8e, 0x3d, 0x32, 0x90,
+	0x1d, 0xa6, 0xc4, 0x97, 0xd1, 0xe1, 0xcf, 0x8c, 0x76, 0x9a, 0x10, 0x22, 0x03, 0xc0, 0x9f, 0xed,
+	0xbf, 0x34, 0x60, 0xee, 0x13, 0xd2, 0x97, 0x9a, 0xd9, 0x75, 0x1f, 0x27, 0xf1, 0x90, 0x06, 0x11,
+	0x11, 0x3d, 0x81, 0x69, 0xa7, 0x40, 0xf9, 0xf6, 0x76, 0x18, 0x2d, 0x25, 0xe1, 0xa9, 0x0c, 0x26,
+	0x7f, 0x66, 0xb4, 0x33, 0x82, 0x07, 0x32, 0x7e, 0xfc, 0x99, 0xcd, 0x54, 0x52, 0x8a, 0xbd, 0x73,
+	0x1e, 0xac, 0x96, 0x23, 0x7e, 0xbc, 0xf7, 0xef, 0x1d, 0x98, 0x2f, 0x7e, 0x83, 0xa1, 0x67, 0xd0,
+	0x29, 0x4c, 0x83, 0xd0, 0x5b, 0xd5, 0xa1, 0x4f, 0x75, 0x66, 0x65, 0xbd, 0x3d, 0x81, 0x4b, 0x1e,
+	0x8c, 0xef, 0xa0, 0x08, 0x96, 0x2a, 0x23, 0x15, 0xb4, 0x57, 0x95, 0xae, 0x1b, 0xd8, 0x58, 0xb7,
+	0x8d, 0x78, 0x73, 0x7b, 0x14, 0x6e, 0x68, 0x66, 0x24, 0x68, 0x7f, 0x82, 0x16, 0xe5, 0x7e, 0xb1,
+	0xee, 0x18, 0x72, 0xe7, 0x56, 0xbf, 0x02, 0x54, 0x1d, 0xa0, 0xa0, 0xdb, 0x13, 0xd5, 0x8c, 0x81,
+	0xdd, 0xda, 0x37, 0x63, 0xae, 0xdd, 0xa8, 0x80, 0xa4, 0x89, 0x1b, 0x55, 0x60, 0xd1, 0xba, 0x63,
+	0xc8, 0x9d, 0x5b, 0x3d, 0x87, 0xc5, 0xf2, 0xd8, 0x05, 0xdd, 0xaa, 0x1b, 0x13, 0x56, 0xa6, 0x3a,
+	0xd6, 0x9e, 0x09, 0x6b, 0x6e, 0x8c, 0xc0, 0x35, 0x75, 0x34, 0x82, 0xde, 0xad, 0xca, 0x6b, 0x07,
+	0x3d, 0xd6, 0xee, 0x64, 0xc6, 0xe2, 0x9e, 0xca, 0xe3, 0x12, 0xdd, 0x9e, 0x6a, 0x66, 0x31, 0xd6,
+	0x9e, 0x09, 0x6b, 0x6e, 0xec, 0xb7, 0xb0, 0xa2, 0x1d, 0x23, 0xa0, 0x83, 0x3a, 0x35, 0xfa, 0x39,
+	0x86, 0x75, 0x68, 0xcc, 0x9f, 0xd9, 0xbe, 0xdb, 0x60, 0x67, 0xbd, 0x30, 0x4d, 0xd0, 0x9d, 0xf5,
+	0xea, 0x7c, 0xc2, 0x7a, 0x7b, 0x02, 0x57, 0xbe, 0xb7, 0x1e, 0x2c, 0x28, 0xf3, 0x05, 0xf4, 0x4e,
+	0x9d, 0xa4, 0xfa, 0x45, 0x68, 0xbd, 0x3b, 0x91, 0x2f, 0xb7, 0xe1, 0x66, 0xe8, 0x25, 0xe1, 0xaa,
+	0xd6, 0x39, 0x15, 0xaf, 0xde, 0x99, 0xc4, 0xa6, 0x1c, 0xe5, 0xca, 0x14, 0x42, 0x7b, 0x94, 0xeb,
+	0xa6, 0x1c, 0xd6, 0xbe, 0x19, 0xb3, 0x82, 0x91, 0xe5, 0x31, 0x05, 0xaa, 0x4f, 0xab, 0xca, 0xfc,
+	0xc3, 0xba, 0x6d, 0xc4, 0x9b, 0xdb, 0xfb, 0x1c, 0x60, 0xdc, 0xcb, 0x46, 0x6f, 0xd6, 0x09, 0x17,
+	0xb3, 0xed, 0xad, 0xcb, 0x99, 0x72, 0xd5, 0x5f, 0xc3, 0xb2, 0xae, 0x98, 0x41, 0x1a, 0xa0, 0xb9,
+	0xa4, 0x62, 0xb2, 0x0e, 0x4c, 0xd9, 0x73, 0xc3, 0x9f, 0x42, 0x3b, 0xeb, 0x23, 0xa3, 0x37, 0xaa,
+	0xd2, 0xa5, 0xce, 0xbb, 0x65, 0x5f, 0xc6, 0x52, 0x38, 0x30, 0x7d, 0x58, 0x1c, 0x37, 0x28, 0x45,
+	0x83, 0xb7, 0x1e, 0x1b, 0x2a, 0xad, 0x68, 0x6b, 0xcf, 0x84, 0xb5, 0x60, 0x2e, 0x4f, 0xbe, 0x62,
+	0x3f, 0xb4, 0x3e, 0xf9, 0x34, 0xed, 0x5e, 0x6b, 0xdf, 0x8c, 0xb9, 0x08, 0xb2, 0xea, 0xcc, 0x4a,
+	0x07, 0xb2, 0xda, 0x41, 0x99, 0xb5, 0x3b, 0x99, 0x31, 0x37, 0x73, 0x06, 0xd7, 0x4b, 0xb3, 0x20,
+	0xa4, 0x11, 0xd7, 0xcf, 0xb9, 0xac, 0x5b, 0x06, 0x9c, 0xb9, 0xa5, 0xdf, 0xc1, 0xaa, 0xbe, 0x21,
+	0x8b, 0x6a, 0x21, 0xb3, 0xa6, 0xbd, 0x6c, 0xdd, 0x35, 0x17, 0xc8, 0xcd, 0xbf, 0x84, 0x15, 0x95,
+	0x47, 0x36, 0x64, 0xeb, 0x01, 0x5e, 0xdf, 0x16, 0xb6, 0x0e, 0x8d, 0xf9, 0xab, 0xd8, 0x55, 0xec,
+	0x63, 0xd6, 0xa7, 0x8f, 0xa6, 0xc9, 0x6b, 0xed, 0x9b, 0x31, 0x17, 0x0f, 0xbc, 0xae, 0x47, 0xa9,
+	0x3b, 0xf0, 0x97, 0x34, 0x51, 0xad, 0x03, 0x53, 0x76, 0xa5, 0xfe, 0xa9, 0x36, 0x21, 0xd1, 0x44,
+	0xff, 0x95, 0xab, 0xed, 0x8e, 0x21, 0x77, 0xfd, 0xdb, 0xcd, 0xae, 0xba, 0x89, 0x1b, 0x28, 0x5d,
+	0x79, 0x87, 0xc6, 0xfc, 0xb9, 0xed, 0x01, 0x2c, 0x29, 0x2c, 0xec, 0xac, 0xd5, 0x5f, 0x13, 0xd5,
+	0x06, 0xa8, 0x75, 0xdb, 0x88, 0x57, 0x07, 0x47, 0xc5, 0x96, 0xdd, 0x65, 0xf9, 0x54, 0xe9, 0x33,
+	0x5a, 0xfb, 0x66, 0xcc, 0xf5, 0xa7, 0x37, 0xeb, 0xd4, 0x4d, 0x3e, 0xbd, 0xa5, 0x8e, 0xa1, 0x75,
+	0xd7, 0x5c, 0x20, 0x37, 0x3f, 0x82, 0x65, 0x5d, 0x23, 0x6e, 0x72, 0x3a, 0x2b, 0xcd, 0x40, 0xeb,
+	0xc0, 0x94, 0xbd, 0x10, 0xec, 0xbc, 0xa0, 0x57, 0x1a, 0x67, 0xf5, 0x05, 0xbd, 0xae, 0x75, 0x67,
+	0xdd, 0x31, 0xe4, 0x56, 0x12, 0x5a, 0xd7, 0x0d, 0xd3, 0x26, 0xf4, 0x25, 0x3d, 0x39, 0xeb, 0xd0,
+	0x98, 0xbf, 0xde, 0xb6, 0xec, 0x85, 0x4d, 0xb6, 0xad, 0x76, 0xe4, 0xac, 0x43, 0x63, 0x7e, 0x05,
+	0xb7, 0x34, 0xad, 0x30, 0x74, 0xc7, 0x60, 0x1b, 0x85, 0xaf, 0xb6, 0x03, 0x53, 0x76, 0x25, 0xc1,
+	0xb5, 0x8d, 0x2e, 0x34, 0x71, 0x17, 0xa5, 0x86, 0x9b, 0x75, 0xd7, 0x5c, 0xe0, 0x92, 0xf7, 0x2d,
+	0x3f, 0x1c, 0x27, 0xef, 0x44, 0xfd, 0x74, 0x3c, 0x34, 0xe6, 0xcf, 0x6c, 0xf7, 0x66, 0xf8, 0x1f,
+	0x63, 0xdf, 0xff, 0xef, 0x00, 0xae, 0xce, 0xce, 0x5a, 0x2f, 0x2b, 0x00, 0x00,
 }
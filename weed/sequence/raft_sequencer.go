@@ -0,0 +1,138 @@
+package sequence
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// RaftSequencer hands out file ids from ranges reserved through the raft log.
+// The end of each range is committed before any id in the range is used. After a restart or a leader change,
+// the new leader starts after the largest reserved id, so the ids are never reused.
+// The ids left in an unfinished range are skipped.
+// The largest reserved id is also kept in a file outside of the raft log,
+// so it survives the raft log being cleared when the masters are changed.
+type RaftSequencer struct {
+	counter     uint64
+	rangeEnd    uint64 // the last id of the range reserved by this master
+	reservedMax uint64 // the largest id reserved by any master, updated atomically from the raft log
+	step        uint64
+	reserveFn   func(max uint64) error
+
+	sequenceLock sync.Mutex
+
+	maxFile     string // empty to not persist the largest reserved id
+	maxFileLock sync.Mutex
+}
+
+// NewRaftSequencer loads the largest reserved id from maxFile, if it exists
+func NewRaftSequencer(step uint64, maxFile string) (*RaftSequencer, error) {
+	if step == 0 {
+		step = 1
+	}
+	m := &RaftSequencer{counter: 1, step: step, maxFile: maxFile}
+	if maxFile == "" {
+		return m, nil
+	}
+	data, err := ioutil.ReadFile(maxFile)
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", maxFile, err)
+	}
+	if m.reservedMax, err = strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", maxFile, err)
+	}
+	glog.V(0).Infof("file ids are reserved up to %d in %s", m.reservedMax, maxFile)
+	return m, nil
+}
+
+// SetReserveFn sets the function to commit the end of a new id range to the raft log
+func (m *RaftSequencer) SetReserveFn(reserveFn func(max uint64) error) {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	m.reserveFn = reserveFn
+}
+
+// ApplyReservedMax is called when a reserved range is applied from the raft log, on every master
+func (m *RaftSequencer) ApplyReservedMax(max uint64) {
+	for {
+		current := atomic.LoadUint64(&m.reservedMax)
+		if current >= max {
+			return
+		}
+		if atomic.CompareAndSwapUint64(&m.reservedMax, current, max) {
+			break
+		}
+	}
+	if err := m.saveReservedMax(); err != nil {
+		glog.Errorf("save the reserved file id %d: %v", max, err)
+	}
+}
+
+// ReservedMax returns the largest id reserved by any master
+func (m *RaftSequencer) ReservedMax() uint64 {
+	return atomic.LoadUint64(&m.reservedMax)
+}
+
+func (m *RaftSequencer) saveReservedMax() error {
+	if m.maxFile == "" {
+		return nil
+	}
+	m.maxFileLock.Lock()
+	defer m.maxFileLock.Unlock()
+	tmpFile := m.maxFile + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, []byte(strconv.FormatUint(atomic.LoadUint64(&m.reservedMax), 10)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, m.maxFile)
+}
+
+// NextFileId returns 0 ids if the range can not be reserved, e.g., this master is not the leader any more
+func (m *RaftSequencer) NextFileId(count uint64) (uint64, uint64) {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+
+	if m.counter+count-1 > m.rangeEnd || m.rangeEnd == 0 {
+		if m.reserveFn == nil {
+			glog.V(0).Infof("file id sequencer is not ready")
+			return 0, 0
+		}
+		start := m.counter
+		if reservedMax := atomic.LoadUint64(&m.reservedMax); start <= reservedMax {
+			start = reservedMax + 1
+		}
+		rangeEnd := start + count + m.step - 1
+		if err := m.reserveFn(rangeEnd); err != nil {
+			glog.V(0).Infof("reserve file ids up to %d: %v", rangeEnd, err)
+			return 0, 0
+		}
+		m.counter, m.rangeEnd = start, rangeEnd
+	}
+
+	ret := m.counter
+	m.counter += count
+	return ret, count
+}
+
+// SetMax skips the ids seen from the volume servers, which can be written before the ranges are reserved
+func (m *RaftSequencer) SetMax(seenValue uint64) {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	if m.counter <= seenValue {
+		m.counter = seenValue + 1
+	}
+}
+
+func (m *RaftSequencer) Peek() uint64 {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	return m.counter
+}
@@ -0,0 +1,88 @@
+package sequence
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestRaftSequencerNotReusedAfterLeaderChange(t *testing.T) {
+	var reserved []uint64
+	reserveFn := func(max uint64) error {
+		reserved = append(reserved, max)
+		return nil
+	}
+
+	leader, _ := NewRaftSequencer(100, "")
+	leader.SetReserveFn(reserveFn)
+	start, count := leader.NextFileId(10)
+	if start != 1 || count != 10 {
+		t.Fatalf("unexpected first ids %d, %d", start, count)
+	}
+	if len(reserved) != 1 || reserved[0] != 110 {
+		t.Fatalf("unexpected reserved ranges %v", reserved)
+	}
+
+	// the new leader replays the raft log
+	newLeader, _ := NewRaftSequencer(100, "")
+	newLeader.SetReserveFn(reserveFn)
+	newLeader.ApplyReservedMax(reserved[0])
+	start, _ = newLeader.NextFileId(1)
+	if start != 111 {
+		t.Fatalf("expected the new leader to start after 110, got %d", start)
+	}
+
+	failing, _ := NewRaftSequencer(100, "")
+	failing.SetReserveFn(func(max uint64) error { return fmt.Errorf("not leader") })
+	if _, count = failing.NextFileId(1); count != 0 {
+		t.Fatalf("expected no ids without reserving, got %d", count)
+	}
+}
+
+func TestRaftSequencerReservedMaxPersisted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sequence")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	maxFile := path.Join(dir, "max_file_id")
+
+	seq, err := NewRaftSequencer(100, maxFile)
+	if err != nil {
+		t.Fatalf("new raft sequencer: %v", err)
+	}
+	seq.ApplyReservedMax(110)
+	seq.ApplyReservedMax(50)
+
+	// the raft log is cleared, and the master restarts
+	restarted, err := NewRaftSequencer(100, maxFile)
+	if err != nil {
+		t.Fatalf("restart raft sequencer: %v", err)
+	}
+	restarted.SetReserveFn(func(max uint64) error { return nil })
+	if start, _ := restarted.NextFileId(1); start != 111 {
+		t.Fatalf("expected the restarted master to start after 110, got %d", start)
+	}
+
+	ioutil.WriteFile(maxFile, []byte("bad"), 0644)
+	if _, err = NewRaftSequencer(100, maxFile); err == nil {
+		t.Fatalf("expected a broken %s to be rejected", maxFile)
+	}
+}
+
+func TestSnowflakeSequencerIncreasing(t *testing.T) {
+	if _, err := NewSnowflakeSequencer(1024); err == nil {
+		t.Fatalf("expected node id 1024 to be rejected")
+	}
+	seq, _ := NewSnowflakeSequencer(3)
+	var last uint64
+	for i := 0; i < 10000; i++ {
+		start, count := seq.NextFileId(1000)
+		if start <= last || count != 1000 {
+			t.Fatalf("file id %d count %d after %d", start, count, last)
+		}
+		last = start + count - 1
+	}
+}
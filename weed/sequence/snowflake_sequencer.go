@@ -0,0 +1,68 @@
+package sequence
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	snowflakeEpochMs   = 1546300800000 // 2019-01-01 00:00:00 UTC
+	snowflakeNodeBits  = 10
+	snowflakeStepBits  = 12
+	snowflakeMaxNodeId = 1<<snowflakeNodeBits - 1
+	snowflakeMaxStep   = 1<<snowflakeStepBits - 1
+)
+
+// SnowflakeSequencer generates the file ids from the time in milliseconds, the node id, and a step within the millisecond.
+// It needs no coordination between the masters, as long as each master has a unique node id.
+// If the clock goes backwards, the ids continue from the last used millisecond.
+type SnowflakeSequencer struct {
+	nodeId       uint64
+	lastMs       uint64
+	step         uint64
+	sequenceLock sync.Mutex
+}
+
+func NewSnowflakeSequencer(nodeId int) (*SnowflakeSequencer, error) {
+	if nodeId < 0 || nodeId > snowflakeMaxNodeId {
+		return nil, fmt.Errorf("snowflake node id %d should be within [0, %d]", nodeId, snowflakeMaxNodeId)
+	}
+	return &SnowflakeSequencer{nodeId: uint64(nodeId)}, nil
+}
+
+// NextFileId returns at most 4096 ids, all within one millisecond
+func (m *SnowflakeSequencer) NextFileId(count uint64) (uint64, uint64) {
+	if count > snowflakeMaxStep+1 {
+		count = snowflakeMaxStep + 1
+	}
+
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+
+	ms := uint64(time.Now().UnixNano()/int64(time.Millisecond)) - snowflakeEpochMs
+	if ms <= m.lastMs {
+		ms = m.lastMs
+		if m.step+count-1 > snowflakeMaxStep {
+			ms++
+			m.step = 0
+		}
+	} else {
+		m.step = 0
+	}
+	m.lastMs = ms
+
+	ret := ms<<(snowflakeNodeBits+snowflakeStepBits) | m.nodeId<<snowflakeStepBits | m.step
+	m.step += count
+	return ret, count
+}
+
+// SetMax is not needed, since the ids always increase with the time
+func (m *SnowflakeSequencer) SetMax(seenValue uint64) {
+}
+
+func (m *SnowflakeSequencer) Peek() uint64 {
+	m.sequenceLock.Lock()
+	defer m.sequenceLock.Unlock()
+	return m.lastMs<<(snowflakeNodeBits+snowflakeStepBits) | m.nodeId<<snowflakeStepBits | m.step
+}
@@ -118,6 +118,8 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 		return nil, fmt.Errorf("can not create entry with empty attributes")
 	}
 
+	ctx = filer2.WithOrigins(ctx, req.Origins)
+
 	err = fs.filer.CreateEntry(ctx, &filer2.Entry{
 		FullPath: fullpath,
 		Attr:     filer2.PbToEntryAttribute(req.Entry.Attributes),
@@ -125,7 +127,7 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 	})
 
 	if err == nil {
-		fs.filer.DeleteChunks(fullpath, garbages)
+		fs.filer.DeleteChunks(fullpath, filer2.NonDedupChunks(garbages))
 	}
 
 	return &filer_pb.CreateEntryResponse{}, err
@@ -139,11 +141,12 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		return &filer_pb.UpdateEntryResponse{}, fmt.Errorf("not found %s: %v", fullpath, err)
 	}
 
-	// remove old chunks if not included in the new ones
-	unusedChunks := filer2.MinusChunks(entry.Chunks, req.Entry.Chunks)
-
 	chunks, garbages := filer2.CompactFileChunks(req.Entry.Chunks)
 
+	// remove old chunks if not included in the new ones, the deduplicated chunks are released in UpdateEntry
+	unusedChunks := filer2.NonDedupChunks(filer2.MinusChunks(entry.Chunks, chunks))
+	garbages = filer2.NonDedupChunks(filer2.MinusChunks(garbages, entry.Chunks))
+
 	newEntry := &filer2.Entry{
 		FullPath: filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name))),
 		Attr:     entry.Attr,
@@ -173,17 +176,20 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		return &filer_pb.UpdateEntryResponse{}, err
 	}
 
+	ctx = filer2.WithOrigins(ctx, req.Origins)
+
 	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err == nil {
 		fs.filer.DeleteChunks(entry.FullPath, unusedChunks)
 		fs.filer.DeleteChunks(entry.FullPath, garbages)
 	}
 
-	fs.filer.NotifyUpdateEvent(entry, newEntry, true)
+	fs.filer.NotifyUpdateEvent(ctx, entry, newEntry, true)
 
 	return &filer_pb.UpdateEntryResponse{}, err
 }
 
 func (fs *FilerServer) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntryRequest) (resp *filer_pb.DeleteEntryResponse, err error) {
+	ctx = filer2.WithOrigins(ctx, req.Origins)
 	err = fs.filer.DeleteEntryMetaAndData(ctx, filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name))), req.IsRecursive, req.IsDeleteData)
 	return &filer_pb.DeleteEntryResponse{}, err
 }
@@ -39,10 +39,10 @@ func (fs *FilerServer) AtomicRenameEntry(ctx context.Context, req *filer_pb.Atom
 	}
 
 	for _, entry := range events.newEntries {
-		fs.filer.NotifyUpdateEvent(nil, entry, false)
+		fs.filer.NotifyUpdateEvent(ctx, nil, entry, false)
 	}
 	for _, entry := range events.oldEntries {
-		fs.filer.NotifyUpdateEvent(entry, nil, false)
+		fs.filer.NotifyUpdateEvent(ctx, entry, nil, false)
 	}
 
 	return &filer_pb.AtomicRenameEntryResponse{}, nil
@@ -0,0 +1,45 @@
+package weed_server
+
+import (
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (fs *FilerServer) SubscribeMetadata(req *filer_pb.SubscribeMetadataRequest, stream filer_pb.SeaweedFiler_SubscribeMetadataServer) error {
+
+	glog.V(0).Infof("%s subscribes to the metadata changes under %s since %d", req.ClientName, req.PathPrefix, req.SinceNs)
+	defer glog.V(0).Infof("%s unsubscribed from the metadata changes", req.ClientName)
+
+	err := fs.filer.MetaLog.Subscribe(stream.Context(), req.SinceNs, func(event *filer_pb.SubscribeMetadataResponse) error {
+		if !eventHasPathPrefix(event, req.PathPrefix) {
+			return nil
+		}
+		return stream.Send(event)
+	})
+
+	if err == filer2.ErrMetaLogTruncated {
+		// the subscriber should rescan the directories, instead of following the changes
+		return status.Errorf(codes.OutOfRange, "the metadata changes since %d are not kept", req.SinceNs)
+	}
+	return err
+}
+
+// eventHasPathPrefix checks the old and the new path of the changed entry
+func eventHasPathPrefix(event *filer_pb.SubscribeMetadataResponse, pathPrefix string) bool {
+	if pathPrefix == "" || pathPrefix == "/" {
+		return true
+	}
+	notification := event.EventNotification
+	if notification.OldEntry != nil && strings.HasPrefix(string(filer2.NewFullPath(event.Directory, notification.OldEntry.Name)), pathPrefix) {
+		return true
+	}
+	if notification.NewEntry != nil && strings.HasPrefix(string(filer2.NewFullPath(notification.NewParentPath, notification.NewEntry.Name)), pathPrefix) {
+		return true
+	}
+	return false
+}
@@ -44,6 +44,7 @@ type FilerOption struct {
 	DefaultLevelDbDir  string
 	DisableHttp        bool
 	Port               int
+	MetaLogDir         string
 }
 
 type FilerServer struct {
@@ -66,6 +67,10 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	fs.filer = filer2.NewFiler(option.Masters, fs.grpcDialOption)
 
+	if fs.filer.MetaLog, err = filer2.NewMetaLog(option.MetaLogDir); err != nil {
+		return nil, fmt.Errorf("metadata log %s: %v", option.MetaLogDir, err)
+	}
+
 	go fs.filer.KeepConnectedToMaster()
 
 	v := viper.GetViper()
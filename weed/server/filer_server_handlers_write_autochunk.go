@@ -30,11 +30,19 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 	// autoChunking can be set at the command-line level or as a query param. Query param overrides command-line
 	query := r.URL.Query()
 
+	// deduplicated files always go through chunking, so that the filer sees the chunk content
+	dedup := fs.filer.IsDedupEnabled(filer2.FullPath(r.URL.Path), collection) &&
+		query.Get("ttl") == "" &&
+		strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data")
+
 	parsedMaxMB, _ := strconv.ParseInt(query.Get("maxMB"), 10, 32)
 	maxMB := int32(parsedMaxMB)
 	if maxMB <= 0 && fs.option.MaxMB > 0 {
 		maxMB = int32(fs.option.MaxMB)
 	}
+	if maxMB <= 0 && dedup {
+		maxMB = 32
+	}
 	if maxMB <= 0 {
 		glog.V(4).Infoln("AutoChunking not enabled")
 		return false
@@ -46,7 +54,7 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 	contentLength := int64(0)
 	if contentLengthHeader := r.Header["Content-Length"]; len(contentLengthHeader) == 1 {
 		contentLength, _ = strconv.ParseInt(contentLengthHeader[0], 10, 64)
-		if contentLength <= int64(chunkSize) {
+		if contentLength <= int64(chunkSize) && !dedup {
 			glog.V(4).Infoln("Content-Length of", contentLength, "is less than the chunk size of", chunkSize, "so autoChunking will be skipped.")
 			return false
 		}
@@ -57,7 +65,7 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 		return false
 	}
 
-	reply, err := fs.doAutoChunk(ctx, w, r, contentLength, chunkSize, replication, collection, dataCenter)
+	reply, err := fs.doAutoChunk(ctx, w, r, contentLength, chunkSize, replication, collection, dataCenter, dedup)
 	if err != nil {
 		writeJsonError(w, r, http.StatusInternalServerError, err)
 	} else if reply != nil {
@@ -67,7 +75,7 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 }
 
 func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request,
-	contentLength int64, chunkSize int32, replication string, collection string, dataCenter string) (filerResult *FilerPostResult, replyerr error) {
+	contentLength int64, chunkSize int32, replication string, collection string, dataCenter string, dedup bool) (filerResult *FilerPostResult, replyerr error) {
 
 	stats.FilerRequestCounter.WithLabelValues("postAutoChunk").Inc()
 	start := time.Now()
@@ -90,7 +98,12 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		fileName = path.Base(fileName)
 	}
 
-	var fileChunks []*filer_pb.FileChunk
+	var fileChunks, uploadedChunks, pinnedChunks []*filer_pb.FileChunk
+	dedupChunks := make(map[string]*filer_pb.FileChunk)
+	defer func() {
+		// the entry has its own references to the reused chunks now, or failed to be saved
+		fs.filer.DeleteChunks(filer2.FullPath(r.URL.Path), pinnedChunks)
+	}()
 
 	totalBytesRead := int64(0)
 	tmpBufferSize := int32(1024 * 1024)
@@ -116,27 +129,60 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 
 		if chunkBufOffset >= chunkSize || readFully || (chunkBufOffset > 0 && bytesRead == 0) {
 			writtenChunks = writtenChunks + 1
-			fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
-			if assignErr != nil {
-				return nil, assignErr
-			}
 
-			// upload the chunk to the volume server
-			chunkName := fileName + "_chunk_" + strconv.FormatInt(int64(len(fileChunks)+1), 10)
-			uploadErr := fs.doUpload(urlLocation, w, r, chunkBuf[0:chunkBufOffset], chunkName, "application/octet-stream", fileId, auth)
-			if uploadErr != nil {
-				return nil, uploadErr
+			// reuse an existing chunk with the same content
+			var contentHash string
+			var existingChunk *filer_pb.FileChunk
+			if dedup {
+				contentHash = filer2.DedupChunkHash(collection, chunkBuf[0:chunkBufOffset])
+				if existingChunk = dedupChunks[contentHash]; existingChunk == nil {
+					// a chunk being deleted is not found, and the content is uploaded as a new chunk
+					if existingChunk = fs.filer.PinDedupChunk(ctx, contentHash); existingChunk != nil {
+						pinnedChunks = append(pinnedChunks, existingChunk)
+						dedupChunks[contentHash] = existingChunk
+					}
+				}
 			}
 
-			// Save to chunk manifest structure
-			fileChunks = append(fileChunks,
-				&filer_pb.FileChunk{
-					FileId: fileId,
-					Offset: chunkOffset,
-					Size:   uint64(chunkBufOffset),
-					Mtime:  time.Now().UnixNano(),
-				},
-			)
+			if existingChunk != nil {
+				stats.FilerRequestCounter.WithLabelValues("postAutoChunkDedup").Inc()
+				fileChunks = append(fileChunks,
+					&filer_pb.FileChunk{
+						FileId:      existingChunk.GetFileIdString(),
+						Offset:      chunkOffset,
+						Size:        existingChunk.Size,
+						Mtime:       time.Now().UnixNano(),
+						ETag:        existingChunk.ETag,
+						ContentHash: contentHash,
+					},
+				)
+			} else {
+				fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
+				if assignErr != nil {
+					return nil, assignErr
+				}
+
+				// upload the chunk to the volume server
+				chunkName := fileName + "_chunk_" + strconv.FormatInt(int64(len(fileChunks)+1), 10)
+				uploadErr := fs.doUpload(urlLocation, w, r, chunkBuf[0:chunkBufOffset], chunkName, "application/octet-stream", fileId, auth)
+				if uploadErr != nil {
+					return nil, uploadErr
+				}
+
+				// Save to chunk manifest structure
+				chunk := &filer_pb.FileChunk{
+					FileId:      fileId,
+					Offset:      chunkOffset,
+					Size:        uint64(chunkBufOffset),
+					Mtime:       time.Now().UnixNano(),
+					ContentHash: contentHash,
+				}
+				fileChunks = append(fileChunks, chunk)
+				uploadedChunks = append(uploadedChunks, chunk)
+				if dedup {
+					dedupChunks[contentHash] = chunk
+				}
+			}
 
 			// reset variables for the next chunk
 			chunkBufOffset = 0
@@ -177,7 +223,7 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		Chunks: fileChunks,
 	}
 	if dbErr := fs.filer.CreateEntry(ctx, entry); dbErr != nil {
-		fs.filer.DeleteChunks(entry.FullPath, entry.Chunks)
+		fs.filer.DeleteChunks(entry.FullPath, uploadedChunks)
 		replyerr = dbErr
 		filerResult.Error = dbErr.Error()
 		glog.V(0).Infof("failing to write %s to filer server : %v", path, dbErr)
@@ -22,6 +22,7 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 		if dn != nil {
 
 			glog.V(0).Infof("unregister disconnected volume server %s:%d", dn.Ip, dn.Port)
+			t.PublishDataNodeEvent(topology.EventNodeLeft, dn)
 			t.UnRegisterDataNode(dn)
 
 			message := &master_pb.VolumeLocation{
@@ -70,6 +71,8 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 				int(heartbeat.Port), heartbeat.PublicUrl,
 				int64(heartbeat.MaxVolumeCount))
 			glog.V(0).Infof("added volume server %v:%d", heartbeat.GetIp(), heartbeat.GetPort())
+			t.PublishDataNodeEvent(topology.EventNodeJoined, dn)
+			t.Repair.DataNodeFound(dn.Url())
 			if err := stream.Send(&master_pb.HeartbeatResponse{
 				VolumeSizeLimit: uint64(ms.option.VolumeSizeLimitMB) * 1024 * 1024,
 			}); err != nil {
@@ -95,6 +98,15 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 		}
 
 		if len(heartbeat.Volumes) > 0 || heartbeat.HasNoVolumes {
+			// the free slots shrink when the volume server is low in disk space
+			dn.UpdateDiskSpace(int64(heartbeat.MaxVolumeCount), heartbeat.LowDiskSpaceDirs)
+			dn.UpdateLabels(heartbeat.Labels)
+			var volumesSize uint64
+			for _, v := range heartbeat.Volumes {
+				volumesSize += v.Size
+			}
+			dn.UpdateLoad(heartbeat.FreeDiskBytes, heartbeat.TotalDiskBytes, volumesSize)
+
 			// process heartbeat.Volumes
 			newVolumes, deletedVolumes := t.SyncDataNodeRegistration(heartbeat.Volumes, dn)
 
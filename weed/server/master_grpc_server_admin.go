@@ -0,0 +1,103 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+// the admin lock expires if the holder does not renew it within this duration
+const adminLockLeaseDuration = 10 * time.Second
+
+// adminLock keeps the shells and the maintenance scripts from changing the cluster at the same time.
+// It is kept in the leader's memory only, so it is released when the leader changes.
+type adminLock struct {
+	token     int64
+	holder    string
+	lockedAt  time.Time
+	renewedAt time.Time
+}
+
+type adminLocks struct {
+	sync.Mutex
+	locks map[string]*adminLock
+}
+
+func newAdminLocks() *adminLocks {
+	return &adminLocks{
+		locks: make(map[string]*adminLock),
+	}
+}
+
+func (locks *adminLocks) lease(lockName, clientName string, previousToken int64, now time.Time) (*adminLock, error) {
+	locks.Lock()
+	defer locks.Unlock()
+
+	lock, found := locks.locks[lockName]
+	if found && now.Sub(lock.renewedAt) < adminLockLeaseDuration {
+		if previousToken != 0 && lock.token == previousToken {
+			lock.renewedAt = now
+			return lock, nil
+		}
+		return nil, fmt.Errorf("%s is locked by %s since %v", lockName, lock.holder, lock.lockedAt.Format(time.RFC3339))
+	}
+	if previousToken != 0 {
+		// the lease has expired or the leader has changed, others may have changed the cluster in between
+		return nil, fmt.Errorf("the lease of %s by %s has expired", lockName, clientName)
+	}
+
+	lock = &adminLock{
+		token:     rand.Int63(),
+		holder:    clientName,
+		lockedAt:  now,
+		renewedAt: now,
+	}
+	locks.locks[lockName] = lock
+	glog.V(0).Infof("%s is locked by %s", lockName, clientName)
+	return lock, nil
+}
+
+func (locks *adminLocks) release(lockName string, previousToken int64) {
+	locks.Lock()
+	defer locks.Unlock()
+
+	if lock, found := locks.locks[lockName]; found && lock.token == previousToken {
+		delete(locks.locks, lockName)
+		glog.V(0).Infof("%s is unlocked by %s", lockName, lock.holder)
+	}
+}
+
+func (ms *MasterServer) LeaseAdminToken(ctx context.Context, req *master_pb.LeaseAdminTokenRequest) (*master_pb.LeaseAdminTokenResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	lock, err := ms.adminLocks.lease(req.LockName, req.ClientName, req.PreviousToken, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &master_pb.LeaseAdminTokenResponse{
+		Token:           lock.token,
+		LockTsNs:        lock.lockedAt.UnixNano(),
+		LeaseDurationNs: int64(adminLockLeaseDuration),
+	}, nil
+}
+
+func (ms *MasterServer) ReleaseAdminToken(ctx context.Context, req *master_pb.ReleaseAdminTokenRequest) (*master_pb.ReleaseAdminTokenResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	ms.adminLocks.release(req.LockName, req.PreviousToken)
+
+	return &master_pb.ReleaseAdminTokenResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package weed_server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdminLockLease(t *testing.T) {
+	locks := newAdminLocks()
+	now := time.Now()
+
+	lock, err := locks.lease("admin", "shell1", 0, now)
+	if err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	token := lock.token
+
+	if _, err = locks.lease("admin", "shell2", 0, now.Add(time.Second)); err == nil {
+		t.Errorf("shell2 should not get the lock held by shell1")
+	}
+
+	// renewing keeps the lock from expiring
+	if _, err = locks.lease("admin", "shell1", token, now.Add(adminLockLeaseDuration-time.Second)); err != nil {
+		t.Errorf("renew: %v", err)
+	}
+	if _, err = locks.lease("admin", "shell2", 0, now.Add(adminLockLeaseDuration+time.Second)); err == nil {
+		t.Errorf("shell2 should not get the renewed lock")
+	}
+
+	// the lock expires without renewing, and can not be renewed afterwards
+	if _, err = locks.lease("admin", "shell1", token, now.Add(3*adminLockLeaseDuration)); err == nil {
+		t.Errorf("shell1 should not renew the expired lock")
+	}
+	lock, err = locks.lease("admin", "shell2", 0, now.Add(3*adminLockLeaseDuration))
+	if err != nil {
+		t.Fatalf("lease the expired lock: %v", err)
+	}
+	if _, err = locks.lease("admin", "shell1", token, now.Add(3*adminLockLeaseDuration)); err == nil {
+		t.Errorf("shell1 should not renew the lock held by shell2")
+	}
+
+	// releasing with an old token does nothing
+	locks.release("admin", token)
+	if _, err = locks.lease("admin", "shell1", 0, now.Add(3*adminLockLeaseDuration)); err == nil {
+		t.Errorf("shell1 should not get the lock held by shell2")
+	}
+
+	locks.release("admin", lock.token)
+	if _, err = locks.lease("admin", "shell1", 0, now.Add(3*adminLockLeaseDuration)); err != nil {
+		t.Errorf("lease the released lock: %v", err)
+	}
+}
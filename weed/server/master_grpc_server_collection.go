@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 )
 
 func (ms *MasterServer) CollectionList(ctx context.Context, req *master_pb.CollectionListRequest) (*master_pb.CollectionListResponse, error) {
@@ -18,8 +19,17 @@ func (ms *MasterServer) CollectionList(ctx context.Context, req *master_pb.Colle
 	resp := &master_pb.CollectionListResponse{}
 	collections := ms.Topo.ListCollections(req.IncludeNormalVolumes, req.IncludeEcVolumes)
 	for _, c := range collections {
+		quota, _ := ms.Topo.Quotas.Get(c)
+		usage := ms.Topo.CollectionUsage(c)
+		placement := ms.Topo.Placements.Get(c)
 		resp.Collections = append(resp.Collections, &master_pb.Collection{
-			Name: c,
+			Name:               c,
+			QuotaBytes:         quota.MaxBytes,
+			QuotaVolumes:       quota.MaxVolumes,
+			UsedBytes:          usage.UsedBytes,
+			VolumeCount:        usage.VolumeCount,
+			RequiredLabels:     placement.RequiredLabels,
+			AntiAffinityLabels: placement.AntiAffinityLabels,
 		})
 	}
 
@@ -49,6 +59,37 @@ func (ms *MasterServer) CollectionDelete(ctx context.Context, req *master_pb.Col
 	return resp, nil
 }
 
+func (ms *MasterServer) CollectionQuotaSet(ctx context.Context, req *master_pb.CollectionQuotaSetRequest) (*master_pb.CollectionQuotaSetResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	err := ms.Topo.SetCollectionQuota(req.Name, topology.CollectionQuota{
+		MaxBytes:   req.QuotaBytes,
+		MaxVolumes: req.QuotaVolumes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &master_pb.CollectionQuotaSetResponse{}, nil
+}
+
+func (ms *MasterServer) CollectionPlacementSet(ctx context.Context, req *master_pb.CollectionPlacementSetRequest) (*master_pb.CollectionPlacementSetResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	err := ms.Topo.SetCollectionPlacement(req.Name, topology.NewPlacementConstraint(req.RequiredLabels, req.AntiAffinityLabels))
+	if err != nil {
+		return nil, err
+	}
+
+	return &master_pb.CollectionPlacementSetResponse{}, nil
+}
+
 func (ms *MasterServer) doDeleteNormalCollection(collectionName string) error {
 
 	collection, ok := ms.Topo.FindCollection(collectionName)
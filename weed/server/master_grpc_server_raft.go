@@ -0,0 +1,118 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func (ms *MasterServer) RaftListClusterServers(ctx context.Context, req *master_pb.RaftListClusterServersRequest) (*master_pb.RaftListClusterServersResponse, error) {
+
+	raftServer := ms.Topo.RaftServer
+	if raftServer == nil {
+		return nil, fmt.Errorf("raft server is not ready")
+	}
+
+	resp := &master_pb.RaftListClusterServersResponse{
+		Leader:      raftServer.Leader(),
+		Term:        raftServer.Term(),
+		CommitIndex: raftServer.CommitIndex(),
+		Server:      raftServer.Name(),
+		State:       raftServer.State(),
+	}
+
+	resp.ClusterServers = append(resp.ClusterServers, &master_pb.RaftListClusterServersResponse_ClusterServer{
+		Address:     raftServer.Name(),
+		GrpcAddress: util.ServerToGrpcAddress(raftServer.Name()),
+		IsLeader:    raftServer.Name() == resp.Leader,
+	})
+	for _, peer := range raftServer.Peers() {
+		clusterServer := &master_pb.RaftListClusterServersResponse_ClusterServer{
+			Address:     peer.Name,
+			GrpcAddress: peer.ConnectionString,
+			IsLeader:    peer.Name == resp.Leader,
+		}
+		if lastActivity := peer.LastActivity(); !lastActivity.IsZero() {
+			clusterServer.LastActivityNs = lastActivity.UnixNano()
+		}
+		resp.ClusterServers = append(resp.ClusterServers, clusterServer)
+	}
+
+	return resp, nil
+}
+
+func (ms *MasterServer) RaftAddServer(ctx context.Context, req *master_pb.RaftAddServerRequest) (*master_pb.RaftAddServerResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	raftServer := ms.Topo.RaftServer
+	if req.Address == "" {
+		return nil, fmt.Errorf("missing master address")
+	}
+	if _, found := raftServer.Peers()[req.Address]; found || req.Address == raftServer.Name() {
+		return nil, fmt.Errorf("master %s is already in the cluster", req.Address)
+	}
+
+	// an unreachable master would only lower the number of failures the cluster can tolerate
+	if err := operation.WithMasterServerClient(req.Address, ms.grpcDialOpiton, func(client master_pb.SeaweedClient) error {
+		pingCtx, cancel := context.WithTimeout(ctx, raftServer.ElectionTimeout())
+		defer cancel()
+		_, err := client.GetMasterConfiguration(pingCtx, &master_pb.GetMasterConfigurationRequest{})
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("master %s is not reachable: %v", req.Address, err)
+	}
+
+	if _, err := raftServer.Do(&RaftAddServerCommand{
+		Name:             req.Address,
+		ConnectionString: util.ServerToGrpcAddress(req.Address),
+	}); err != nil {
+		return nil, fmt.Errorf("add master %s: %v", req.Address, err)
+	}
+
+	return &master_pb.RaftAddServerResponse{}, nil
+}
+
+func (ms *MasterServer) RaftRemoveServer(ctx context.Context, req *master_pb.RaftRemoveServerRequest) (*master_pb.RaftRemoveServerResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	raftServer := ms.Topo.RaftServer
+	if req.Address == raftServer.Name() {
+		return nil, fmt.Errorf("can not remove the leader %s, stop it to elect a new leader first", req.Address)
+	}
+	peers := raftServer.Peers()
+	if _, found := peers[req.Address]; !found {
+		return nil, fmt.Errorf("master %s is not in the cluster", req.Address)
+	}
+
+	// the remaining masters should still have a quorum after the removal
+	if !req.Force {
+		aliveCount := 1 // the leader itself
+		for name, peer := range peers {
+			if name != req.Address && time.Since(peer.LastActivity()) < raftServer.ElectionTimeout() {
+				aliveCount++
+			}
+		}
+		if quorum := len(peers)/2 + 1; aliveCount < quorum {
+			return nil, fmt.Errorf("only %d masters would be alive, less than the quorum %d, use -force to remove anyway", aliveCount, quorum)
+		}
+	}
+
+	if _, err := raftServer.Do(&RaftRemoveServerCommand{
+		Name: req.Address,
+	}); err != nil {
+		return nil, fmt.Errorf("remove master %s: %v", req.Address, err)
+	}
+
+	return &master_pb.RaftRemoveServerResponse{}, nil
+}
@@ -3,10 +3,12 @@ package weed_server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/topology"
@@ -18,6 +20,12 @@ func (ms *MasterServer) LookupVolume(ctx context.Context, req *master_pb.LookupV
 		return nil, raft.NotLeaderError
 	}
 
+	stats.MasterRequestCounter.WithLabelValues("grpcLookup").Inc()
+	start := time.Now()
+	defer func() {
+		stats.MasterRequestHistogram.WithLabelValues("grpcLookup").Observe(time.Since(start).Seconds())
+	}()
+
 	resp := &master_pb.LookupVolumeResponse{}
 	volumeLocations := ms.lookupVolumeId(req.VolumeIds, req.Collection)
 
@@ -45,6 +53,12 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 		return nil, raft.NotLeaderError
 	}
 
+	stats.MasterRequestCounter.WithLabelValues("grpcAssign").Inc()
+	start := time.Now()
+	defer func() {
+		stats.MasterRequestHistogram.WithLabelValues("grpcAssign").Observe(time.Since(start).Seconds())
+	}()
+
 	if req.Count == 0 {
 		req.Count = 1
 	}
@@ -70,6 +84,12 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 		Rack:             req.Rack,
 		DataNode:         req.DataNode,
 	}
+	requestPlacement := topology.NewPlacementConstraint(req.RequiredLabels, req.AntiAffinityLabels)
+	option.Placement = ms.Topo.Placements.Get(option.Collection).Merge(requestPlacement)
+
+	if err = ms.Topo.CheckCollectionQuota(option.Collection); err != nil {
+		return nil, err
+	}
 
 	if !ms.Topo.HasWritableVolume(option) {
 		if ms.Topo.FreeSpace() <= 0 {
@@ -142,6 +162,21 @@ func (ms *MasterServer) VolumeList(ctx context.Context, req *master_pb.VolumeLis
 	return resp, nil
 }
 
+func (ms *MasterServer) MaxVolumeIdRaise(ctx context.Context, req *master_pb.MaxVolumeIdRaiseRequest) (*master_pb.MaxVolumeIdRaiseResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	if err := ms.Topo.RaiseMaxVolumeId(needle.VolumeId(req.MaxVolumeId)); err != nil {
+		return nil, err
+	}
+
+	return &master_pb.MaxVolumeIdRaiseResponse{
+		MaxVolumeId: uint32(ms.Topo.GetMaxVolumeId()),
+	}, nil
+}
+
 func (ms *MasterServer) LookupEcVolume(ctx context.Context, req *master_pb.LookupEcVolumeRequest) (*master_pb.LookupEcVolumeResponse, error) {
 
 	if !ms.Topo.IsLeader() {
@@ -158,7 +193,7 @@ func (ms *MasterServer) LookupEcVolume(ctx context.Context, req *master_pb.Looku
 
 	resp.VolumeId = req.VolumeId
 
-	for shardId, shardLocations := range ecLocations.Locations {
+	for shardId, shardLocations := range ecLocations.ShardLocations() {
 		var locations []*master_pb.Location
 		for _, dn := range shardLocations {
 			locations = append(locations, &master_pb.Location{
@@ -184,3 +219,18 @@ func (ms *MasterServer) GetMasterConfiguration(ctx context.Context, req *master_
 
 	return resp, nil
 }
+
+func (ms *MasterServer) VolumeRepair(ctx context.Context, req *master_pb.VolumeRepairRequest) (*master_pb.VolumeRepairResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	if req.Pause {
+		ms.Topo.Repair.SetPaused(true)
+	} else if req.Resume {
+		ms.Topo.Repair.SetPaused(false)
+	}
+
+	return ms.Topo.Repair.ToVolumeRepairResponse(), nil
+}
@@ -0,0 +1,59 @@
+package weed_server
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"google.golang.org/grpc/peer"
+)
+
+// WatchTopology streams the topology events, e.g., volume servers joining or leaving, and volumes created or deleted.
+// The stream ends when this master is no longer the leader, after sending the leader_changed event.
+func (ms *MasterServer) WatchTopology(req *master_pb.WatchTopologyRequest, stream master_pb.Seaweed_WatchTopologyServer) error {
+
+	if !ms.Topo.IsLeader() {
+		return raft.NotLeaderError
+	}
+
+	pr, ok := peer.FromContext(stream.Context())
+	if !ok || pr.Addr == net.Addr(nil) {
+		return fmt.Errorf("failed to get peer address")
+	}
+	clientName := req.ClientName + "@" + pr.Addr.String()
+
+	eventTypes := make(map[string]bool)
+	for _, eventType := range req.EventTypes {
+		eventTypes[eventType] = true
+	}
+
+	glog.V(0).Infof("+ topology watcher %v", clientName)
+	events, cancel := ms.Topo.WatchEvents(clientName)
+	defer func() {
+		glog.V(0).Infof("- topology watcher %v", clientName)
+		cancel()
+	}()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case event := <-events:
+			if len(eventTypes) > 0 && !eventTypes[event.Type] {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if !ms.Topo.IsLeader() {
+				return raft.NotLeaderError
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
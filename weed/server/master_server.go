@@ -4,11 +4,12 @@ import (
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/shell"
 	"google.golang.org/grpc"
+	"hash/fnv"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"os"
-	"regexp"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,6 +20,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gorilla/mux"
@@ -56,6 +58,8 @@ type MasterServer struct {
 	clientChans     map[string]chan *master_pb.VolumeLocation
 
 	grpcDialOpiton grpc.DialOption
+
+	adminLocks *adminLocks
 }
 
 func NewMasterServer(r *mux.Router, option *MasterOption) *MasterServer {
@@ -77,12 +81,16 @@ func NewMasterServer(r *mux.Router, option *MasterOption) *MasterServer {
 		option:          option,
 		preallocateSize: preallocateSize,
 		clientChans:     make(map[string]chan *master_pb.VolumeLocation),
+		adminLocks:      newAdminLocks(),
 		grpcDialOpiton:  security.LoadClientTLS(v.Sub("grpc"), "master"),
 	}
 	ms.bounedLeaderChan = make(chan int, 16)
-	seq := sequence.NewMemorySequencer()
+	seq := ms.createSequencer(v)
 	ms.Topo = topology.NewTopology("topo", seq, uint64(ms.option.VolumeSizeLimitMB)*1024*1024, ms.option.PulseSeconds)
-	ms.vg = topology.NewDefaultVolumeGrowth()
+	if raftSequencer, ok := seq.(*sequence.RaftSequencer); ok {
+		raftSequencer.SetReserveFn(ms.Topo.ReserveFileIds)
+	}
+	ms.vg = createVolumeGrowth(v)
 	glog.V(0).Infoln("Volume Size Limit is", ms.option.VolumeSizeLimitMB, "MB")
 
 	ms.guard = security.NewGuard(ms.option.WhiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
@@ -105,19 +113,85 @@ func NewMasterServer(r *mux.Router, option *MasterOption) *MasterServer {
 		r.HandleFunc("/{fileId}", ms.proxyToLeader(ms.redirectHandler))
 	}
 
+	v.SetDefault("master.repair.enabled", false)
+	v.SetDefault("master.repair.grace_period_minutes", 30)
+	v.SetDefault("master.repair.max_concurrent_tasks", 1)
+	ms.Topo.Repair.Configure(v.GetBool("master.repair.enabled"),
+		time.Duration(v.GetInt("master.repair.grace_period_minutes"))*time.Minute,
+		v.GetInt("master.repair.max_concurrent_tasks"))
+
 	ms.Topo.StartRefreshWritableVolumes(ms.grpcDialOpiton, ms.option.GarbageThreshold, ms.preallocateSize)
 
 	ms.startAdminScripts()
 
+	go stats.LoopPushingMetric("master", stats.SourceName(ms.option.Port), stats.MasterGather,
+		func() (addr string, intervalSeconds int) {
+			// refresh the gauges right before each push
+			ms.Topo.UpdateMetrics()
+			return ms.option.MetricsAddress, ms.option.MetricsIntervalSec
+		})
+
 	return ms
 }
 
+func (ms *MasterServer) createSequencer(v *viper.Viper) sequence.Sequencer {
+	v.SetDefault("master.sequencer.type", "memory")
+	v.SetDefault("master.sequencer.raft_step", 10000)
+	sequencerType := strings.ToLower(v.GetString("master.sequencer.type"))
+	glog.V(0).Infof("file id sequencer type: %s", sequencerType)
+	switch sequencerType {
+	case "raft":
+		seq, err := sequence.NewRaftSequencer(uint64(v.GetInt64("master.sequencer.raft_step")), path.Join(ms.option.MetaFolder, "max_file_id"))
+		if err != nil {
+			glog.Fatalf("create raft sequencer: %v", err)
+		}
+		return seq
+	case "snowflake":
+		snowflakeId := v.GetInt("master.sequencer.snowflake_id")
+		if snowflakeId == 0 {
+			h := fnv.New32a()
+			h.Write([]byte(stats.SourceName(ms.option.Port)))
+			snowflakeId = int(h.Sum32()%1023) + 1
+			glog.V(0).Infof("snowflake id %d is derived from %s", snowflakeId, stats.SourceName(ms.option.Port))
+		}
+		seq, err := sequence.NewSnowflakeSequencer(snowflakeId)
+		if err != nil {
+			glog.Fatalf("create snowflake sequencer: %v", err)
+		}
+		return seq
+	case "memory":
+		return sequence.NewMemorySequencer()
+	}
+	glog.Fatalf("unknown file id sequencer type: %s", sequencerType)
+	return nil
+}
+
+func createVolumeGrowth(v *viper.Viper) *topology.VolumeGrowth {
+	v.SetDefault("master.volume_growth.strategy", "random")
+	v.SetDefault("master.volume_growth.write_load_reference_mbps", 10)
+	strategyName := strings.ToLower(v.GetString("master.volume_growth.strategy"))
+	glog.V(0).Infof("volume growth strategy: %s", strategyName)
+	switch strategyName {
+	case "random":
+		return topology.NewDefaultVolumeGrowth()
+	case "weighted":
+		writeLoadReference := v.GetFloat64("master.volume_growth.write_load_reference_mbps") * 1024 * 1024
+		return topology.NewVolumeGrowth(topology.NewWeightedPlacementStrategy(writeLoadReference))
+	}
+	strategy, err := topology.GetPlacementStrategy(strategyName)
+	if err != nil {
+		glog.Fatalf("volume growth: %v", err)
+	}
+	return topology.NewVolumeGrowth(strategy)
+}
+
 func (ms *MasterServer) SetRaftServer(raftServer *RaftServer) {
 	ms.Topo.RaftServer = raftServer.raftServer
 	ms.Topo.RaftServer.AddEventListener(raft.LeaderChangeEventType, func(e raft.Event) {
 		glog.V(0).Infof("event: %+v", e)
 		if ms.Topo.RaftServer.Leader() != "" {
 			glog.V(0).Infoln("[", ms.Topo.RaftServer.Name(), "]", ms.Topo.RaftServer.Leader(), "becomes leader.")
+			ms.Topo.PublishLeaderEvent(ms.Topo.RaftServer.Leader())
 		}
 	})
 	ms.Topo.RaftServer.AddEventListener(raft.StateChangeEventType, func(e raft.Event) {
@@ -175,8 +249,6 @@ func (ms *MasterServer) startAdminScripts() {
 		return
 	}
 
-	scriptLines := strings.Split(adminScripts, "\n")
-
 	masterAddress := "localhost:" + strconv.Itoa(ms.option.Port)
 
 	var shellOptions shell.ShellOptions
@@ -187,8 +259,8 @@ func (ms *MasterServer) startAdminScripts() {
 	shellOptions.Directory = "/"
 
 	commandEnv := shell.NewCommandEnv(shellOptions)
-
-	reg, _ := regexp.Compile(`'.*?'|".*?"|\S+`)
+	// wait for the shells changing the cluster
+	commandEnv.AdminLock = shell.NewAdminLock("maintenance", true)
 
 	go commandEnv.MasterClient.KeepConnectedToMaster()
 
@@ -198,27 +270,20 @@ func (ms *MasterServer) startAdminScripts() {
 		c := time.Tick(time.Duration(sleepMinutes) * time.Minute)
 		for _ = range c {
 			if ms.Topo.IsLeader() {
-				for _, line := range scriptLines {
-
-					cmds := reg.FindAllString(line, -1)
-					if len(cmds) == 0 {
-						continue
-					}
-					args := make([]string, len(cmds[1:]))
-					for i := range args {
-						args[i] = strings.Trim(string(cmds[1+i]), "\"'")
-					}
-					cmd := strings.ToLower(cmds[0])
-
-					for _, c := range shell.Commands {
-						if c.Name() == cmd {
-							glog.V(0).Infof("executing: %s %v", cmd, args)
-							if err := c.Do(args, commandEnv, os.Stdout); err != nil {
-								glog.V(0).Infof("error: %v", err)
-							}
-						}
-					}
+				// hold the admin lock for the whole scripts, so no shell commands run in between
+				commandEnv.AdminLock.Acquire(commandEnv)
+				err := shell.RunScript(commandEnv, adminScripts, os.Stdout, shell.ScriptOptions{
+					OnError: func(err error) {
+						glog.V(0).Infof("error: %v", err)
+					},
+					OnCommand: func(name string, args []string) {
+						glog.V(0).Infof("executing: %s %v", name, args)
+					},
+				})
+				if err != nil {
+					glog.V(0).Infof("admin scripts: %v", err)
 				}
+				commandEnv.AdminLock.Release(commandEnv)
 			}
 		}
 	}()
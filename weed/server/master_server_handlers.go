@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/security"
@@ -44,6 +45,12 @@ func (ms *MasterServer) lookupVolumeId(vids []string, collection string) (volume
 // If "fileId" is provided, this returns the fileId location and a JWT to update or delete the file.
 // If "volumeId" is provided, this only returns the volumeId location
 func (ms *MasterServer) dirLookupHandler(w http.ResponseWriter, r *http.Request) {
+	stats.MasterRequestCounter.WithLabelValues("lookup").Inc()
+	start := time.Now()
+	defer func() {
+		stats.MasterRequestHistogram.WithLabelValues("lookup").Observe(time.Since(start).Seconds())
+	}()
+
 	vid := r.FormValue("volumeId")
 	if vid != "" {
 		// backward compatible
@@ -76,6 +83,12 @@ func (ms *MasterServer) dirLookupHandler(w http.ResponseWriter, r *http.Request)
 
 func (ms *MasterServer) dirAssignHandler(w http.ResponseWriter, r *http.Request) {
 	stats.AssignRequest()
+	stats.MasterRequestCounter.WithLabelValues("assign").Inc()
+	start := time.Now()
+	defer func() {
+		stats.MasterRequestHistogram.WithLabelValues("assign").Observe(time.Since(start).Seconds())
+	}()
+
 	requestedCount, e := strconv.ParseUint(r.FormValue("count"), 10, 64)
 	if e != nil || requestedCount == 0 {
 		requestedCount = 1
@@ -87,6 +100,11 @@ func (ms *MasterServer) dirAssignHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err = ms.Topo.CheckCollectionQuota(option.Collection); err != nil {
+		writeJsonQuiet(w, r, http.StatusForbidden, operation.AssignResult{Error: err.Error()})
+		return
+	}
+
 	if !ms.Topo.HasWritableVolume(option) {
 		if ms.Topo.FreeSpace() <= 0 {
 			writeJsonQuiet(w, r, http.StatusNotFound, operation.AssignResult{Error: "No free volumes left!"})
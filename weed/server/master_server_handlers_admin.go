@@ -168,5 +168,11 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 		Rack:             r.FormValue("rack"),
 		DataNode:         r.FormValue("dataNode"),
 	}
+	requiredLabels, err := util.ParseLabels(r.FormValue("requiredLabels"))
+	if err != nil {
+		return nil, err
+	}
+	requestPlacement := topology.NewPlacementConstraint(requiredLabels, util.ParseLabelKeys(r.FormValue("antiAffinityLabels")))
+	volumeGrowOption.Placement = ms.Topo.Placements.Get(volumeGrowOption.Collection).Merge(requestPlacement)
 	return volumeGrowOption, nil
 }
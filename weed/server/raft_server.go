@@ -16,8 +16,14 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/topology"
 )
 
+const (
+	raftSnapshotInterval = time.Minute
+	raftSnapshotEntries  = 1000
+)
+
 type RaftServer struct {
 	peers      []string // initial peers to join with
+	join       bool     // join an existing cluster, instead of bootstrapping a new one
 	raftServer raft.Server
 	dataDir    string
 	serverAddr string
@@ -25,9 +31,10 @@ type RaftServer struct {
 	*raft.GrpcServer
 }
 
-func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr string, dataDir string, topo *topology.Topology, pulseSeconds int) *RaftServer {
+func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, join bool, serverAddr string, dataDir string, topo *topology.Topology, pulseSeconds int) *RaftServer {
 	s := &RaftServer{
 		peers:      peers,
+		join:       join,
 		serverAddr: serverAddr,
 		dataDir:    dataDir,
 		topo:       topo,
@@ -38,27 +45,45 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 	}
 
 	raft.RegisterCommand(&topology.MaxVolumeIdCommand{})
+	raft.RegisterCommand(&topology.MaxFileIdCommand{})
+	raft.RegisterCommand(&topology.CollectionQuotaCommand{})
+	raft.RegisterCommand(&topology.CollectionPlacementCommand{})
+	raft.RegisterCommand(&RaftAddServerCommand{})
+	raft.RegisterCommand(&RaftRemoveServerCommand{})
 
 	var err error
 	transporter := raft.NewGrpcTransporter(grpcDialOption)
 	glog.V(0).Infof("Starting RaftServer with %v", serverAddr)
 
-	// Clear old cluster configurations if peers are changed
-	if oldPeers, changed := isPeersChanged(s.dataDir, serverAddr, s.peers); changed {
+	// Clear old cluster configurations if peers are changed, unless the masters are changed at runtime
+	if members, found := loadRaftMembership(s.dataDir); found {
+		glog.V(0).Infof("use the masters %v changed at runtime, instead of -peers %v", members, s.peers)
+		s.peers = members
+	} else if oldPeers, changed := isPeersChanged(s.dataDir, serverAddr, s.peers); changed {
 		glog.V(0).Infof("Peers Change: %v => %v", oldPeers, s.peers)
 		os.RemoveAll(path.Join(s.dataDir, "conf"))
 		os.RemoveAll(path.Join(s.dataDir, "log"))
 		os.RemoveAll(path.Join(s.dataDir, "snapshot"))
 	}
 
-	s.raftServer, err = raft.NewServer(s.serverAddr, s.dataDir, transporter, nil, topo, "")
+	s.raftServer, err = raft.NewServer(s.serverAddr, s.dataDir, transporter, topology.NewStateMachine(topo), topo, "")
 	if err != nil {
 		glog.V(0).Infoln(err)
 		return nil
 	}
+	// load the snapshot before the log is opened, so only the log entries after the snapshot are applied
+	if err = os.MkdirAll(path.Join(s.dataDir, "snapshot"), 0700); err != nil {
+		glog.V(0).Infoln(err)
+		return nil
+	}
+	if err = s.raftServer.LoadSnapshot(); err != nil {
+		glog.V(0).Infof("load raft snapshot: %v", err)
+		return nil
+	}
 	s.raftServer.SetHeartbeatInterval(500 * time.Millisecond)
 	s.raftServer.SetElectionTimeout(time.Duration(pulseSeconds) * 500 * time.Millisecond)
 	s.raftServer.Start()
+	go s.compactLog()
 
 	for _, peer := range s.peers {
 		s.raftServer.AddPeer(peer, util.ServerToGrpcAddress(peer))
@@ -66,7 +91,7 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 
 	s.GrpcServer = raft.NewGrpcServer(s.raftServer)
 
-	if s.raftServer.IsLogEmpty() && isTheFirstOne(serverAddr, s.peers) {
+	if isBootstrapNeeded(s.raftServer.IsLogEmpty(), s.join, serverAddr, s.peers) {
 		// Initialize the server by joining itself.
 		glog.V(0).Infoln("Initializing new cluster")
 
@@ -86,6 +111,26 @@ func NewRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr st
 	return s
 }
 
+// compactLog takes a raft snapshot after enough log entries are committed, and drops the older log entries
+func (s *RaftServer) compactLog() {
+	var snapshotIndex uint64
+	for range time.Tick(raftSnapshotInterval) {
+		if !s.raftServer.Running() {
+			return
+		}
+		commitIndex := s.raftServer.CommitIndex()
+		if commitIndex < snapshotIndex+raftSnapshotEntries {
+			continue
+		}
+		if err := s.raftServer.TakeSnapshot(); err != nil {
+			glog.V(0).Infof("take raft snapshot: %v", err)
+			continue
+		}
+		glog.V(1).Infof("raft snapshot taken at %d", commitIndex)
+		snapshotIndex = commitIndex
+	}
+}
+
 func (s *RaftServer) Peers() (members []string) {
 	peers := s.raftServer.Peers()
 
@@ -124,6 +169,13 @@ func isPeersChanged(dir string, self string, peers []string) (oldPeers []string,
 
 }
 
+// isBootstrapNeeded is true only for the first master of a new cluster.
+// A master joining an existing cluster starts with an empty log, and waits to be added by the leader,
+// otherwise it could become the leader of a separate cluster.
+func isBootstrapNeeded(isLogEmpty bool, join bool, self string, peers []string) bool {
+	return isLogEmpty && !join && isTheFirstOne(self, peers)
+}
+
 func isTheFirstOne(self string, peers []string) bool {
 	sort.Strings(peers)
 	if len(peers) <= 0 {
@@ -0,0 +1,101 @@
+package weed_server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// the masters added or removed at runtime are saved in the raft data directory,
+// and take precedence over the -peers option when the master restarts
+const raftMembershipFile = "members"
+
+// RaftAddServerCommand adds a master to the raft cluster, and persists the membership on every master
+type RaftAddServerCommand struct {
+	Name             string `json:"name"`
+	ConnectionString string `json:"connectionString"`
+}
+
+func (c *RaftAddServerCommand) CommandName() string {
+	return "AddServer"
+}
+
+// NodeName makes it a raft.JoinCommand, so the quorum is recalculated after it is committed
+func (c *RaftAddServerCommand) NodeName() string {
+	return c.Name
+}
+
+func (c *RaftAddServerCommand) Apply(server raft.Server) (interface{}, error) {
+	if err := server.AddPeer(c.Name, c.ConnectionString); err != nil {
+		return nil, err
+	}
+	glog.V(0).Infof("master %s is added to the cluster", c.Name)
+	return nil, saveRaftMembership(server)
+}
+
+// RaftRemoveServerCommand removes a master from the raft cluster, and persists the membership on every master
+type RaftRemoveServerCommand struct {
+	Name string `json:"name"`
+}
+
+func (c *RaftRemoveServerCommand) CommandName() string {
+	return "RemoveServer"
+}
+
+func (c *RaftRemoveServerCommand) NodeName() string {
+	return c.Name
+}
+
+func (c *RaftRemoveServerCommand) Apply(server raft.Server) (interface{}, error) {
+	if c.Name == server.Name() {
+		glog.V(0).Infof("this master %s is removed from the cluster, and should be stopped", c.Name)
+		return nil, nil
+	}
+	if _, found := server.Peers()[c.Name]; !found {
+		return nil, nil
+	}
+	if err := server.RemovePeer(c.Name); err != nil {
+		return nil, err
+	}
+	glog.V(0).Infof("master %s is removed from the cluster", c.Name)
+	return nil, saveRaftMembership(server)
+}
+
+func raftMembers(server raft.Server) (members []string) {
+	members = append(members, server.Name())
+	for name := range server.Peers() {
+		members = append(members, name)
+	}
+	sort.Strings(members)
+	return
+}
+
+func saveRaftMembership(server raft.Server) error {
+	b, err := json.Marshal(raftMembers(server))
+	if err != nil {
+		return err
+	}
+	membershipPath := path.Join(server.Path(), raftMembershipFile)
+	if err = ioutil.WriteFile(membershipPath+".tmp", b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(membershipPath+".tmp", membershipPath)
+}
+
+// loadRaftMembership returns the masters saved by the runtime membership changes, if any
+func loadRaftMembership(dataDir string) (members []string, found bool) {
+	b, err := ioutil.ReadFile(path.Join(dataDir, raftMembershipFile))
+	if err != nil {
+		return nil, false
+	}
+	if err = json.Unmarshal(b, &members); err != nil {
+		glog.V(0).Infof("failed to read raft membership in %s: %v", dataDir, err)
+		return nil, false
+	}
+	return members, true
+}
@@ -0,0 +1,70 @@
+package weed_server
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/raft"
+	"google.golang.org/grpc"
+)
+
+func TestIsBootstrapNeeded(t *testing.T) {
+	peers := []string{"localhost:9333", "localhost:9334", "localhost:9335"}
+	if !isBootstrapNeeded(true, false, "localhost:9333", peers) {
+		t.Errorf("the first master of a new cluster should bootstrap it")
+	}
+	if isBootstrapNeeded(true, false, "localhost:9334", peers) {
+		t.Errorf("only the first master should bootstrap the new cluster")
+	}
+	if isBootstrapNeeded(false, false, "localhost:9333", peers) {
+		t.Errorf("a master with raft log should not bootstrap again")
+	}
+	// the new master sorts first, but joins the existing cluster
+	newPeers := append([]string{"localhost:9330"}, peers...)
+	if isBootstrapNeeded(true, true, "localhost:9330", newPeers) {
+		t.Errorf("a joining master should not bootstrap a separate cluster")
+	}
+}
+
+func TestRaftMembershipCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	server, err := raft.NewServer("localhost:9333", dir, raft.NewGrpcTransporter(grpc.WithInsecure()), nil, nil, "")
+	if err != nil {
+		t.Fatalf("new raft server: %v", err)
+	}
+	if _, found := loadRaftMembership(dir); found {
+		t.Fatalf("unexpected membership before any change")
+	}
+
+	for _, name := range []string{"localhost:9335", "localhost:9334"} {
+		if _, err = (&RaftAddServerCommand{Name: name, ConnectionString: name}).Apply(server); err != nil {
+			t.Fatalf("add %s: %v", name, err)
+		}
+	}
+	members, found := loadRaftMembership(dir)
+	if expected := []string{"localhost:9333", "localhost:9334", "localhost:9335"}; !found || !reflect.DeepEqual(members, expected) {
+		t.Errorf("members %v, expected %v", members, expected)
+	}
+
+	if _, err = (&RaftRemoveServerCommand{Name: "localhost:9334"}).Apply(server); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	// removing an unknown master or this master itself does not change the membership
+	if _, err = (&RaftRemoveServerCommand{Name: "localhost:9336"}).Apply(server); err != nil {
+		t.Fatalf("remove unknown master: %v", err)
+	}
+	if _, err = (&RaftRemoveServerCommand{Name: "localhost:9333"}).Apply(server); err != nil {
+		t.Fatalf("remove self: %v", err)
+	}
+	members, _ = loadRaftMembership(dir)
+	if expected := []string{"localhost:9333", "localhost:9335"}; !reflect.DeepEqual(members, expected) {
+		t.Errorf("members %v, expected %v", members, expected)
+	}
+}
@@ -110,3 +110,19 @@ func (vs *VolumeServer) VolumeMarkReadonly(ctx context.Context, req *volume_serv
 	return resp, err
 
 }
+
+func (vs *VolumeServer) VolumeServerDrain(ctx context.Context, req *volume_server_pb.VolumeServerDrainRequest) (*volume_server_pb.VolumeServerDrainResponse, error) {
+
+	resp := &volume_server_pb.VolumeServerDrainResponse{}
+
+	err := vs.store.SetDraining(!req.Cancel)
+
+	if err != nil {
+		glog.Errorf("volume server drain %v: %v", req, err)
+	} else {
+		glog.V(2).Infof("volume server drain %v", req)
+	}
+
+	return resp, err
+
+}
@@ -16,6 +16,11 @@ func (vs *VolumeServer) BatchDelete(ctx context.Context, req *volume_server_pb.B
 
 	now := uint64(time.Now().Unix())
 
+	deleteNeedle := vs.store.DeleteVolumeNeedle
+	if req.IgnoreReadOnly {
+		deleteNeedle = vs.store.DeleteReadOnlyVolumeNeedle
+	}
+
 	for _, fid := range req.FileIds {
 		vid, id_cookie, err := operation.ParseFileId(fid)
 		if err != nil {
@@ -40,7 +45,8 @@ func (vs *VolumeServer) BatchDelete(ctx context.Context, req *volume_server_pb.B
 			continue
 		}
 
-		if n.IsChunkedManifest() {
+		// the raw needle deletions from volume.fsck and volume.mirror also delete the chunk manifests
+		if !req.SkipCookieCheck && n.IsChunkedManifest() {
 			resp.Results = append(resp.Results, &volume_server_pb.DeleteResult{
 				FileId: fid,
 				Status: http.StatusNotAcceptable,
@@ -49,7 +55,7 @@ func (vs *VolumeServer) BatchDelete(ctx context.Context, req *volume_server_pb.B
 			continue
 		}
 
-		if n.Cookie != cookie {
+		if !req.SkipCookieCheck && n.Cookie != cookie {
 			resp.Results = append(resp.Results, &volume_server_pb.DeleteResult{
 				FileId: fid,
 				Status: http.StatusBadRequest,
@@ -58,7 +64,7 @@ func (vs *VolumeServer) BatchDelete(ctx context.Context, req *volume_server_pb.B
 			break
 		}
 		n.LastModified = now
-		if size, err := vs.store.DeleteVolumeNeedle(volumeId, n); err != nil {
+		if size, err := deleteNeedle(volumeId, n); err != nil {
 			resp.Results = append(resp.Results, &volume_server_pb.DeleteResult{
 				FileId: fid,
 				Status: http.StatusInternalServerError,
@@ -51,8 +51,16 @@ func (vs *VolumeServer) VolumeEcShardsGenerate(ctx context.Context, req *volume_
 		return nil, fmt.Errorf("WriteSortedEcxFile %s: %v", baseFileName, err)
 	}
 
+	scheme := erasure_coding.DefaultEcScheme
+	if req.DataShards != 0 || req.ParityShards != 0 {
+		var err error
+		if scheme, err = erasure_coding.NewEcScheme(int(req.DataShards), int(req.ParityShards)); err != nil {
+			return nil, err
+		}
+	}
+
 	// write .ec01 ~ .ec14 files
-	if err := erasure_coding.WriteEcFiles(baseFileName); err != nil {
+	if err := erasure_coding.WriteEcFiles(baseFileName, scheme); err != nil {
 		return nil, fmt.Errorf("WriteEcFiles %s: %v", baseFileName, err)
 	}
 
@@ -92,6 +100,10 @@ func (vs *VolumeServer) VolumeEcShardsRebuild(ctx context.Context, req *volume_s
 // VolumeEcShardsCopy copy the .ecx and some ec data slices
 func (vs *VolumeServer) VolumeEcShardsCopy(ctx context.Context, req *volume_server_pb.VolumeEcShardsCopyRequest) (*volume_server_pb.VolumeEcShardsCopyResponse, error) {
 
+	if req.CopyCompactedFiles {
+		return vs.copyCompactedEcFiles(ctx, req)
+	}
+
 	location := vs.store.FindFreeLocation()
 	if location == nil {
 		return nil, fmt.Errorf("no space left")
@@ -122,6 +134,26 @@ func (vs *VolumeServer) VolumeEcShardsCopy(ctx context.Context, req *volume_serv
 			return err
 		}
 
+		// copy ecm file, missing for volumes encoded before the ec schemes were configurable
+		copyFileClient, err := client.CopyFile(ctx, &volume_server_pb.CopyFileRequest{
+			VolumeId:                 req.VolumeId,
+			Ext:                      ".ecm",
+			CompactionRevision:       math.MaxUint32,
+			StopOffset:               math.MaxInt64,
+			Collection:               req.Collection,
+			IsEcVolume:               true,
+			IgnoreSourceFileNotFound: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to start copying volume %d .ecm file: %v", req.VolumeId, err)
+		}
+		if err = writeToFile(copyFileClient, baseFileName+".ecm", util.NewWriteThrottler(vs.compactionBytePerSecond), false); err != nil {
+			return fmt.Errorf("failed to copy %s.ecm file: %v", baseFileName, err)
+		}
+		if fi, statErr := os.Stat(baseFileName + ".ecm"); statErr == nil && fi.Size() == 0 {
+			os.Remove(baseFileName + ".ecm")
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -131,25 +163,62 @@ func (vs *VolumeServer) VolumeEcShardsCopy(ctx context.Context, req *volume_serv
 	return &volume_server_pb.VolumeEcShardsCopyResponse{}, nil
 }
 
+// copyCompactedEcFiles copies the vacuumed generation of the .ecx, .ecm and ec shard files, next to the current ones
+func (vs *VolumeServer) copyCompactedEcFiles(ctx context.Context, req *volume_server_pb.VolumeEcShardsCopyRequest) (*volume_server_pb.VolumeEcShardsCopyResponse, error) {
+
+	baseFileName := erasure_coding.EcShardBaseFileName(req.Collection, int(req.VolumeId))
+
+	found := false
+	for _, location := range vs.store.Locations {
+		if util.FileExists(path.Join(location.Directory, baseFileName+".ecx")) {
+			found = true
+			baseFileName = path.Join(location.Directory, baseFileName)
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("ec volume %d .ecx file not found", req.VolumeId)
+	}
+
+	exts := []string{erasure_coding.CompactedExt + ".ecx", erasure_coding.CompactedExt + ".ecm"}
+	for _, shardId := range req.ShardIds {
+		exts = append(exts, erasure_coding.CompactedExt+erasure_coding.ToExt(int(shardId)))
+	}
+
+	err := operation.WithVolumeServerClient(req.SourceDataNode, vs.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		for _, ext := range exts {
+			if err := vs.doCopyFile(ctx, client, true, req.Collection, req.VolumeId, math.MaxUint32, math.MaxInt64, baseFileName, ext, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		erasure_coding.CleanupCompactedEcFiles(baseFileName)
+		return nil, fmt.Errorf("copy compacted ec volume %d: %v", req.VolumeId, err)
+	}
+
+	return &volume_server_pb.VolumeEcShardsCopyResponse{}, nil
+}
+
 // VolumeEcShardsDelete local delete the .ecx and some ec data slices if not needed
 // the shard should not be mounted before calling this.
 func (vs *VolumeServer) VolumeEcShardsDelete(ctx context.Context, req *volume_server_pb.VolumeEcShardsDeleteRequest) (*volume_server_pb.VolumeEcShardsDeleteResponse, error) {
 
 	baseFilename := erasure_coding.EcShardBaseFileName(req.Collection, int(req.VolumeId))
 
-	found := false
+	var ecxLocation *storage.DiskLocation
 	for _, location := range vs.store.Locations {
 		if util.FileExists(path.Join(location.Directory, baseFilename+".ecx")) {
-			found = true
-			baseFilename = path.Join(location.Directory, baseFilename)
+			ecxLocation = location
 			for _, shardId := range req.ShardIds {
-				os.Remove(baseFilename + erasure_coding.ToExt(int(shardId)))
+				os.Remove(path.Join(location.Directory, baseFilename+erasure_coding.ToExt(int(shardId))))
 			}
 			break
 		}
 	}
 
-	if !found {
+	if ecxLocation == nil {
 		return nil, nil
 	}
 
@@ -167,6 +236,9 @@ func (vs *VolumeServer) VolumeEcShardsDelete(ctx context.Context, req *volume_se
 				hasEcxFile = true
 				continue
 			}
+			if fileInfo.Name() == baseFilename+".ecj" || fileInfo.Name() == baseFilename+".ecm" {
+				continue
+			}
 			if strings.HasPrefix(fileInfo.Name(), baseFilename+".ec") {
 				existingShardCount++
 			}
@@ -174,12 +246,14 @@ func (vs *VolumeServer) VolumeEcShardsDelete(ctx context.Context, req *volume_se
 	}
 
 	if hasEcxFile && existingShardCount == 0 {
-		if err := os.Remove(baseFilename + ".ecx"); err != nil {
+		ecxBaseFilename := path.Join(ecxLocation.Directory, baseFilename)
+		if err := os.Remove(ecxBaseFilename + ".ecx"); err != nil {
 			return nil, err
 		}
-		if err := os.Remove(baseFilename + ".ecj"); err != nil {
+		if err := os.Remove(ecxBaseFilename + ".ecj"); err != nil && !os.IsNotExist(err) {
 			return nil, err
 		}
+		os.Remove(ecxBaseFilename + ".ecm")
 	}
 
 	return &volume_server_pb.VolumeEcShardsDeleteResponse{}, nil
@@ -229,6 +303,10 @@ func (vs *VolumeServer) VolumeEcShardRead(req *volume_server_pb.VolumeEcShardRea
 	if !found {
 		return fmt.Errorf("VolumeEcShardRead not found ec volume id %d", req.VolumeId)
 	}
+	if ecVolume.Generation != req.Generation {
+		// the shards are being swapped to the vacuumed generation
+		return vs.readEcShardOfGeneration(ecVolume, req, stream)
+	}
 	ecShard, found := ecVolume.FindEcVolumeShard(erasure_coding.ShardId(req.ShardId))
 	if !found {
 		return fmt.Errorf("not found ec shard %d.%d", req.VolumeId, req.ShardId)
@@ -243,6 +321,40 @@ func (vs *VolumeServer) VolumeEcShardRead(req *volume_server_pb.VolumeEcShardRea
 		}
 	}
 
+	return sendEcShardData(ecShard, req, stream)
+
+}
+
+// readEcShardOfGeneration reads the shard from the backup or the compacted files of the requested generation,
+// which are kept until all the volume servers have swapped to the vacuumed generation.
+func (vs *VolumeServer) readEcShardOfGeneration(ecVolume *erasure_coding.EcVolume, req *volume_server_pb.VolumeEcShardReadRequest, stream volume_server_pb.VolumeServer_VolumeEcShardReadServer) error {
+
+	baseFileName, found := erasure_coding.FindGenerationBaseFileName(ecVolume.FileName(), req.Generation)
+	if !found {
+		return fmt.Errorf("ec volume %d generation %d, expecting %d", req.VolumeId, ecVolume.Generation, req.Generation)
+	}
+
+	shardFile, err := os.Open(baseFileName + erasure_coding.ToExt(int(req.ShardId)))
+	if err != nil {
+		return fmt.Errorf("not found ec shard %d.%d generation %d: %v", req.VolumeId, req.ShardId, req.Generation, err)
+	}
+	defer shardFile.Close()
+
+	if req.FileKey != 0 {
+		_, size, _ := erasure_coding.FindNeedleFromEcxFile(baseFileName, types.Uint64ToNeedleId(req.FileKey))
+		if size == types.TombstoneFileSize {
+			return stream.Send(&volume_server_pb.VolumeEcShardReadResponse{
+				IsDeleted: true,
+			})
+		}
+	}
+
+	return sendEcShardData(shardFile, req, stream)
+
+}
+
+func sendEcShardData(ecShard io.ReaderAt, req *volume_server_pb.VolumeEcShardReadRequest, stream volume_server_pb.VolumeServer_VolumeEcShardReadServer) error {
+
 	bufSize := req.Size
 	if bufSize > BufferSizeLimit {
 		bufSize = BufferSizeLimit
@@ -311,3 +423,81 @@ func (vs *VolumeServer) VolumeEcBlobDelete(ctx context.Context, req *volume_serv
 
 	return resp, nil
 }
+
+// VolumeEcShardsToVolume generates the .dat and .idx files from the .ec00 ~ .ec09, .ecx and .ecj files, and mounts the volume
+// the ec shards are not deleted, and should be unmounted and deleted after the volume is mounted.
+func (vs *VolumeServer) VolumeEcShardsToVolume(ctx context.Context, req *volume_server_pb.VolumeEcShardsToVolumeRequest) (*volume_server_pb.VolumeEcShardsToVolumeResponse, error) {
+
+	if v := vs.store.GetVolume(needle.VolumeId(req.VolumeId)); v != nil {
+		return nil, fmt.Errorf("volume %d already exists", req.VolumeId)
+	}
+
+	baseFileName := erasure_coding.EcShardBaseFileName(req.Collection, int(req.VolumeId))
+
+	found := false
+	for _, location := range vs.store.Locations {
+		if util.FileExists(path.Join(location.Directory, baseFileName+".ecx")) {
+			found = true
+			baseFileName = path.Join(location.Directory, baseFileName)
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("ec volume %d .ecx file not found", req.VolumeId)
+	}
+
+	scheme, err := erasure_coding.LoadEcScheme(baseFileName)
+	if err != nil {
+		return nil, err
+	}
+	for shardId := 0; shardId < scheme.DataShards; shardId++ {
+		if !util.FileExists(baseFileName + erasure_coding.ToExt(shardId)) {
+			return nil, fmt.Errorf("ec volume %d missing data shard %d in the same folder as the .ecx file", req.VolumeId, shardId)
+		}
+	}
+
+	// calculate .dat file size
+	datFileSize, err := erasure_coding.FindDatFileSize(baseFileName)
+	if err != nil {
+		return nil, fmt.Errorf("FindDatFileSize %s: %v", baseFileName, err)
+	}
+
+	// write .dat file from .ec00 ~ .ec09 files
+	if err := erasure_coding.WriteDatFile(baseFileName, datFileSize); err != nil {
+		return nil, fmt.Errorf("WriteDatFile %s: %v", baseFileName, err)
+	}
+
+	// write .idx file from .ecx and .ecj files
+	if err := erasure_coding.WriteIdxFileFromEcIndex(baseFileName); err != nil {
+		return nil, fmt.Errorf("WriteIdxFileFromEcIndex %s: %v", baseFileName, err)
+	}
+
+	if err := vs.store.MountVolume(needle.VolumeId(req.VolumeId)); err != nil {
+		return nil, fmt.Errorf("mount volume %d: %v", req.VolumeId, err)
+	}
+	v := vs.store.GetVolume(needle.VolumeId(req.VolumeId))
+	if v == nil {
+		return nil, fmt.Errorf("volume %d is not loaded", req.VolumeId)
+	}
+
+	return &volume_server_pb.VolumeEcShardsToVolumeResponse{
+		Replication: v.ReplicaPlacement.String(),
+	}, nil
+}
+
+// VolumeEcShardsVerify recomputes the parity of the ec volume stripe by stripe, and streams back the mismatched stripes
+func (vs *VolumeServer) VolumeEcShardsVerify(req *volume_server_pb.VolumeEcShardsVerifyRequest, stream volume_server_pb.VolumeServer_VolumeEcShardsVerifyServer) error {
+
+	glog.V(0).Infof("VolumeEcShardsVerify: %v", req)
+
+	return vs.store.VerifyEcShards(stream.Context(), needle.VolumeId(req.VolumeId), func(offset, size int64, badShardIds []erasure_coding.ShardId) error {
+		resp := &volume_server_pb.VolumeEcShardsVerifyResponse{
+			Offset: offset,
+			Size:   size,
+		}
+		for _, shardId := range badShardIds {
+			resp.BadShardIds = append(resp.BadShardIds, uint32(shardId))
+		}
+		return stream.Send(resp)
+	})
+}
@@ -0,0 +1,111 @@
+package weed_server
+
+import (
+	"context"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func (vs *VolumeServer) VacuumEcVolumeCheck(ctx context.Context, req *volume_server_pb.VacuumEcVolumeCheckRequest) (*volume_server_pb.VacuumEcVolumeCheckResponse, error) {
+
+	resp := &volume_server_pb.VacuumEcVolumeCheckResponse{}
+
+	garbageRatio, err := vs.store.CheckCompactEcVolume(req.Collection, needle.VolumeId(req.VolumeId))
+
+	resp.GarbageRatio = garbageRatio
+
+	if err != nil {
+		glog.V(3).Infof("check ec volume %d: %v", req.VolumeId, err)
+	}
+
+	return resp, err
+
+}
+
+func (vs *VolumeServer) VacuumEcVolumeCompact(ctx context.Context, req *volume_server_pb.VacuumEcVolumeCompactRequest) (*volume_server_pb.VacuumEcVolumeCompactResponse, error) {
+
+	resp := &volume_server_pb.VacuumEcVolumeCompactResponse{}
+
+	err := vs.store.CompactEcVolume(req.Collection, needle.VolumeId(req.VolumeId))
+
+	if err != nil {
+		glog.Errorf("compact ec volume %d: %v", req.VolumeId, err)
+	} else {
+		glog.V(1).Infof("compact ec volume %d", req.VolumeId)
+	}
+
+	return resp, err
+
+}
+
+func (vs *VolumeServer) VacuumEcVolumePrepare(ctx context.Context, req *volume_server_pb.VacuumEcVolumePrepareRequest) (*volume_server_pb.VacuumEcVolumePrepareResponse, error) {
+
+	resp := &volume_server_pb.VacuumEcVolumePrepareResponse{}
+
+	err := vs.store.PrepareCompactEcVolume(req.Collection, needle.VolumeId(req.VolumeId))
+
+	if err != nil {
+		glog.Errorf("prepare ec volume %d: %v", req.VolumeId, err)
+	} else {
+		glog.V(1).Infof("prepare ec volume %d", req.VolumeId)
+	}
+
+	return resp, err
+
+}
+
+func (vs *VolumeServer) VacuumEcVolumeCommit(ctx context.Context, req *volume_server_pb.VacuumEcVolumeCommitRequest) (*volume_server_pb.VacuumEcVolumeCommitResponse, error) {
+
+	resp := &volume_server_pb.VacuumEcVolumeCommitResponse{}
+
+	shardIds, err := vs.store.CommitCompactEcVolume(req.Collection, needle.VolumeId(req.VolumeId))
+	for _, shardId := range shardIds {
+		resp.ShardIds = append(resp.ShardIds, uint32(shardId))
+	}
+
+	if err != nil {
+		glog.Errorf("commit ec volume %d: %v", req.VolumeId, err)
+	} else {
+		glog.V(1).Infof("commit ec volume %d shards %v", req.VolumeId, shardIds)
+	}
+
+	return resp, err
+
+}
+
+func (vs *VolumeServer) VacuumEcVolumeRollback(ctx context.Context, req *volume_server_pb.VacuumEcVolumeRollbackRequest) (*volume_server_pb.VacuumEcVolumeRollbackResponse, error) {
+
+	resp := &volume_server_pb.VacuumEcVolumeRollbackResponse{}
+
+	shardIds, err := vs.store.RollbackCompactEcVolume(req.Collection, needle.VolumeId(req.VolumeId))
+	for _, shardId := range shardIds {
+		resp.ShardIds = append(resp.ShardIds, uint32(shardId))
+	}
+
+	if err != nil {
+		glog.Errorf("rollback ec volume %d: %v", req.VolumeId, err)
+	} else {
+		glog.V(1).Infof("rollback ec volume %d shards %v", req.VolumeId, shardIds)
+	}
+
+	return resp, err
+
+}
+
+func (vs *VolumeServer) VacuumEcVolumeCleanup(ctx context.Context, req *volume_server_pb.VacuumEcVolumeCleanupRequest) (*volume_server_pb.VacuumEcVolumeCleanupResponse, error) {
+
+	resp := &volume_server_pb.VacuumEcVolumeCleanupResponse{}
+
+	err := vs.store.CleanupCompactEcVolume(req.Collection, needle.VolumeId(req.VolumeId))
+
+	if err != nil {
+		glog.Errorf("cleanup ec volume %d: %v", req.VolumeId, err)
+	} else {
+		glog.V(1).Infof("cleanup ec volume %d", req.VolumeId)
+	}
+
+	return resp, err
+
+}
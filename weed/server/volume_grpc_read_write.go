@@ -0,0 +1,50 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func (vs *VolumeServer) ReadNeedleBlob(ctx context.Context, req *volume_server_pb.ReadNeedleBlobRequest) (resp *volume_server_pb.ReadNeedleBlobResponse, err error) {
+	resp = &volume_server_pb.ReadNeedleBlobResponse{}
+
+	blob, version, err := vs.store.ReadVolumeNeedleBlob(needle.VolumeId(req.VolumeId), types.NeedleId(req.NeedleId))
+	if err != nil {
+		return nil, fmt.Errorf("read needle %d of volume %d: %v", req.NeedleId, req.VolumeId, err)
+	}
+
+	resp.NeedleBlob = blob
+	resp.Version = uint32(version)
+
+	return resp, nil
+}
+
+func (vs *VolumeServer) WriteNeedleBlob(ctx context.Context, req *volume_server_pb.WriteNeedleBlobRequest) (resp *volume_server_pb.WriteNeedleBlobResponse, err error) {
+	resp = &volume_server_pb.WriteNeedleBlobResponse{}
+
+	if len(req.NeedleBlob) < types.NeedleHeaderSize {
+		return nil, fmt.Errorf("write needle %d of volume %d: blob of %d bytes is too short", req.NeedleId, req.VolumeId, len(req.NeedleBlob))
+	}
+	n := new(needle.Needle)
+	n.ParseNeedleHeader(req.NeedleBlob)
+	if err = n.ReadBytes(req.NeedleBlob, 0, n.Size, needle.Version(req.Version)); err != nil {
+		return nil, fmt.Errorf("write needle %d of volume %d: %v", req.NeedleId, req.VolumeId, err)
+	}
+	if n.Id != types.NeedleId(req.NeedleId) {
+		return nil, fmt.Errorf("write needle %d of volume %d: blob has needle %d", req.NeedleId, req.VolumeId, n.Id)
+	}
+
+	write := vs.store.WriteVolumeNeedle
+	if req.IgnoreReadOnly {
+		write = vs.store.WriteReadOnlyVolumeNeedle
+	}
+	if _, _, err = write(needle.VolumeId(req.VolumeId), n); err != nil {
+		return nil, fmt.Errorf("write needle %d of volume %d: %v", req.NeedleId, req.VolumeId, err)
+	}
+
+	return resp, nil
+}
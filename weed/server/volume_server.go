@@ -3,6 +3,8 @@ package weed_server
 import (
 	"fmt"
 	"net/http"
+	"path/filepath"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/stats"
 	"google.golang.org/grpc"
@@ -10,6 +12,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/spf13/viper"
 )
 
@@ -27,13 +30,15 @@ type VolumeServer struct {
 	FixJpgOrientation       bool
 	ReadRedirect            bool
 	compactionBytePerSecond int64
+	writeQuorum             int
+	hintedHandoff           *topology.HintedHandoff
 	MetricsAddress          string
 	MetricsIntervalSec      int
 }
 
 func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	port int, publicUrl string,
-	folders []string, maxCounts []int,
+	folders []string, maxCounts []int, minFreeSpacePercents []float32,
 	needleMapKind storage.NeedleMapType,
 	masterNodes []string, pulseSeconds int,
 	dataCenter string, rack string,
@@ -41,6 +46,10 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	fixJpgOrientation bool,
 	readRedirect bool,
 	compactionMBPerSecond int,
+	writeQuorum int,
+	ecRecoveryCacheSizeMB int,
+	ecRecoveryTimeout time.Duration,
+	labels map[string]string,
 ) *VolumeServer {
 
 	v := viper.GetViper()
@@ -62,12 +71,24 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 		ReadRedirect:            readRedirect,
 		grpcDialOption:          security.LoadClientTLS(viper.Sub("grpc"), "volume"),
 		compactionBytePerSecond: int64(compactionMBPerSecond) * 1024 * 1024,
+		writeQuorum:             writeQuorum,
 	}
 	vs.SeedMasterNodes = masterNodes
-	vs.store = storage.NewStore(vs.grpcDialOption, port, ip, publicUrl, folders, maxCounts, vs.needleMapKind)
+	vs.store = storage.NewStore(vs.grpcDialOption, port, ip, publicUrl, folders, maxCounts, minFreeSpacePercents, vs.needleMapKind)
+	vs.store.SetEcRecovery(ecRecoveryCacheSizeMB, ecRecoveryTimeout)
+	vs.store.SetLabels(labels)
 
 	vs.guard = security.NewGuard(whiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
 
+	if writeQuorum > 0 {
+		if hintedHandoff, err := topology.NewHintedHandoff(filepath.Join(folders[0], "hinted_handoff"), vs.store, security.SigningKey(signingKey), expiresAfterSec); err != nil {
+			glog.Errorf("failed to open hinted handoff in %s: %v", folders[0], err)
+		} else {
+			vs.hintedHandoff = hintedHandoff
+			go vs.hintedHandoff.Replay()
+		}
+	}
+
 	handleStaticResources(adminMux)
 	if signingKey == "" || enableUiAccess {
 		// only expose the volume server details for safe environments
@@ -97,5 +118,8 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 func (vs *VolumeServer) Shutdown() {
 	glog.V(0).Infoln("Shutting down volume server...")
 	vs.store.Close()
+	if vs.hintedHandoff != nil {
+		vs.hintedHandoff.Close()
+	}
 	glog.V(0).Infoln("Shut down successfully!")
 }
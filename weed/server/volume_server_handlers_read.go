@@ -20,7 +20,9 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/images"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -84,6 +86,14 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 	var count int
 	if hasVolume {
 		count, err = vs.store.ReadVolumeNeedle(volumeId, n)
+		if err == storage.ErrorNotFound && vs.writeQuorum > 0 {
+			// only the quorum writes can leave a replica without the needle
+			if repairErr := topology.ReadRepair(vs.GetMaster(), vs.grpcDialOption, vs.store, volumeId, n); repairErr == nil {
+				count, err = vs.store.ReadVolumeNeedle(volumeId, n)
+			} else if repairErr != storage.ErrorNotFound {
+				glog.V(0).Infof("read %s: %v", r.URL.Path, repairErr)
+			}
+		}
 	} else if hasEcVolume {
 		count, err = vs.store.ReadEcShardNeedle(context.Background(), volumeId, n)
 	}
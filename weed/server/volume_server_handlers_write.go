@@ -50,7 +50,7 @@ func (vs *VolumeServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ret := operation.UploadResult{}
-	_, isUnchanged, writeError := topology.ReplicatedWrite(vs.GetMaster(), vs.store, volumeId, needle, r)
+	_, isUnchanged, writeError := topology.ReplicatedWrite(vs.GetMaster(), vs.store, vs.writeQuorum, vs.hintedHandoff, volumeId, needle, r)
 	httpStatus := http.StatusCreated
 	if isUnchanged {
 		httpStatus = http.StatusNotModified
@@ -136,7 +136,7 @@ func (vs *VolumeServer) DeleteHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	_, err := topology.ReplicatedDelete(vs.GetMaster(), vs.store, volumeId, n, r)
+	_, err := topology.ReplicatedDelete(vs.GetMaster(), vs.store, vs.writeQuorum, vs.hintedHandoff, volumeId, n, r)
 
 	writeDeleteResult(err, count, w, r)
 
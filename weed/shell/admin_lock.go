@@ -0,0 +1,175 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+const (
+	adminLockName          = "admin"
+	adminLockRenewInterval = 3 * time.Second
+	adminLockRetryInterval = 10 * time.Second
+)
+
+// the commands changing the volumes or ec shards, which run with the admin lock held
+var mutatingCommands = map[string]bool{
+	"collection.delete":      true,
+	"ec.balance":             true,
+	"ec.decode":              true,
+	"ec.encode":              true,
+	"ec.rebuild":             true,
+	"ec.verify":              true,
+	"volume.balance":         true,
+	"volume.check.disk":      true,
+	"volume.copy":            true,
+	"volume.delete":          true,
+	"volume.fix.replication": true,
+	"volume.fsck":            true,
+	"volume.mount":           true,
+	"volume.move":            true,
+	"volume.server.evacuate": true,
+	"volume.unmount":         true,
+}
+
+// AdminLock is a lease on the leader master, renewed in the background while held
+type AdminLock struct {
+	clientName string
+	// wait for the lock held by others, instead of failing
+	wait bool
+
+	mu       sync.Mutex
+	token    int64
+	stopChan chan struct{}
+}
+
+func NewAdminLock(clientName string, wait bool) *AdminLock {
+	if hostname, err := os.Hostname(); err == nil {
+		clientName = clientName + "@" + hostname
+	}
+	return &AdminLock{clientName: clientName, wait: wait}
+}
+
+func (l *AdminLock) IsLocked() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.token != 0
+}
+
+// Acquire leases the lock, waiting for the other holders if l.wait is set
+func (l *AdminLock) Acquire(commandEnv *CommandEnv) error {
+	for {
+		token, err := l.lease(commandEnv, 0)
+		if err == nil {
+			l.mu.Lock()
+			l.token = token
+			l.stopChan = make(chan struct{})
+			go l.keepRenewing(commandEnv, l.stopChan)
+			l.mu.Unlock()
+			return nil
+		}
+		if !l.wait {
+			return err
+		}
+		glog.V(0).Infof("waiting for the admin lock: %v", err)
+		time.Sleep(adminLockRetryInterval)
+	}
+}
+
+func (l *AdminLock) Release(commandEnv *CommandEnv) {
+	l.mu.Lock()
+	token := l.token
+	if token == 0 {
+		l.mu.Unlock()
+		return
+	}
+	close(l.stopChan)
+	l.token = 0
+	l.mu.Unlock()
+
+	ctx := context.Background()
+	err := commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.ReleaseAdminToken(ctx, &master_pb.ReleaseAdminTokenRequest{
+			PreviousToken: token,
+			LockName:      adminLockName,
+		})
+		return err
+	})
+	if err != nil {
+		glog.V(0).Infof("release the admin lock: %v", err)
+	}
+}
+
+func (l *AdminLock) lease(commandEnv *CommandEnv, previousToken int64) (token int64, err error) {
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err := client.LeaseAdminToken(ctx, &master_pb.LeaseAdminTokenRequest{
+			PreviousToken: previousToken,
+			LockName:      adminLockName,
+			ClientName:    l.clientName,
+		})
+		if err != nil {
+			return err
+		}
+		token = resp.Token
+		return nil
+	})
+	return
+}
+
+func (l *AdminLock) keepRenewing(commandEnv *CommandEnv, stopChan chan struct{}) {
+	ticker := time.NewTicker(adminLockRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			l.mu.Lock()
+			token := l.token
+			l.mu.Unlock()
+			newToken, err := l.lease(commandEnv, token)
+			if err != nil {
+				// the lease may be taken by others already, stop the mutating commands
+				glog.Errorf("lost the admin lock: %v", err)
+				l.mu.Lock()
+				if l.token == token {
+					l.token = 0
+				}
+				l.mu.Unlock()
+				return
+			}
+			l.mu.Lock()
+			if l.token == token {
+				l.token = newToken
+			}
+			l.mu.Unlock()
+		}
+	}
+}
+
+// RunCommand runs the command, holding the admin lock if the command changes the cluster
+func RunCommand(commandEnv *CommandEnv, c command, args []string, writer io.Writer) error {
+	if mutatingCommands[c.Name()] && !commandEnv.AdminLock.IsLocked() {
+		if err := commandEnv.AdminLock.Acquire(commandEnv); err != nil {
+			return fmt.Errorf("%s needs the admin lock: %v", c.Name(), err)
+		}
+		defer commandEnv.AdminLock.Release(commandEnv)
+	}
+	return c.Do(args, commandEnv, writer)
+}
+
+// confirmIsLocked is checked by the mutating commands between the steps,
+// so the command stops once the admin lock is lost
+func (ce *CommandEnv) confirmIsLocked() error {
+	if ce.AdminLock.IsLocked() {
+		return nil
+	}
+	return fmt.Errorf("the admin lock is lost, stopped")
+}
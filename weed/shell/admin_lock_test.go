@@ -0,0 +1,27 @@
+package shell
+
+import (
+	"testing"
+)
+
+func TestConfirmIsLocked(t *testing.T) {
+	commandEnv := &CommandEnv{AdminLock: NewAdminLock("test", false)}
+	if err := commandEnv.confirmIsLocked(); err == nil {
+		t.Errorf("the lock is not acquired yet")
+	}
+
+	commandEnv.AdminLock.token = 123
+	if err := commandEnv.confirmIsLocked(); err != nil {
+		t.Errorf("the lock is held: %v", err)
+	}
+
+	// a failed renewal clears the token
+	commandEnv.AdminLock.token = 0
+	if err := commandEnv.confirmIsLocked(); err == nil {
+		t.Errorf("the lock is lost")
+	}
+
+	if !mutatingCommands["ec.verify"] {
+		t.Errorf("ec.verify deletes shards with -rebuild, and needs the admin lock")
+	}
+}
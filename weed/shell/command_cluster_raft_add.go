@@ -0,0 +1,63 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandRaftServerAdd{})
+}
+
+type commandRaftServerAdd struct {
+}
+
+func (c *commandRaftServerAdd) Name() string {
+	return "cluster.raft.add"
+}
+
+func (c *commandRaftServerAdd) Help() string {
+	return `add a master to the raft cluster
+
+	cluster.raft.add -address=<ip:port>
+
+	1. start the new master with -join, and -peers listing the current masters and itself
+	2. run this command to add the new master through the raft log
+
+	With -join, the new master does not bootstrap a new cluster, and waits to be added by this command.
+
+	The membership is saved in the -mdir folder of every master, and takes precedence over -peers after restarts.
+	The volume servers, filers, and clients only know the masters in their own -mserver option.
+
+`
+}
+
+func (c *commandRaftServerAdd) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	raftAddCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	address := raftAddCommand.String("address", "", "the master address as <ip:port>")
+	if err = raftAddCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *address == "" {
+		return fmt.Errorf("missing -address")
+	}
+
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.RaftAddServer(ctx, &master_pb.RaftAddServerRequest{
+			Address: *address,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "master %s is added\n", *address)
+	return nil
+}
@@ -0,0 +1,65 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandRaftClusterPs{})
+}
+
+type commandRaftClusterPs struct {
+}
+
+func (c *commandRaftClusterPs) Name() string {
+	return "cluster.raft.ps"
+}
+
+func (c *commandRaftClusterPs) Help() string {
+	return `list the masters in the raft cluster
+
+	cluster.raft.ps
+
+	The leader, the term, and the last heartbeat response of each master are shown.
+
+`
+}
+
+func (c *commandRaftClusterPs) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	raftPsCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	if err = raftPsCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	var resp *master_pb.RaftListClusterServersResponse
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.RaftListClusterServers(ctx, &master_pb.RaftListClusterServersRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "leader:%s term:%d commit index:%d, from %s as %s\n", resp.Leader, resp.Term, resp.CommitIndex, resp.Server, resp.State)
+	for _, server := range resp.ClusterServers {
+		role := "follower"
+		if server.IsLeader {
+			role = "leader"
+		}
+		fmt.Fprintf(writer, "  %s grpc:%s %s", server.Address, server.GrpcAddress, role)
+		if server.LastActivityNs > 0 {
+			fmt.Fprintf(writer, " last response:%v ago", time.Since(time.Unix(0, server.LastActivityNs)).Round(time.Millisecond))
+		}
+		fmt.Fprintf(writer, "\n")
+	}
+
+	return nil
+}
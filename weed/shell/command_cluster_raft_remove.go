@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandRaftServerRemove{})
+}
+
+type commandRaftServerRemove struct {
+}
+
+func (c *commandRaftServerRemove) Name() string {
+	return "cluster.raft.remove"
+}
+
+func (c *commandRaftServerRemove) Help() string {
+	return `remove a master from the raft cluster
+
+	cluster.raft.remove -address=<ip:port> [-force]
+
+	The leader can not be removed. Stop it first, and remove it from the newly elected leader.
+	Without -force, the removal is refused if the remaining alive masters can not form a quorum.
+	Stop the removed master afterwards.
+
+`
+}
+
+func (c *commandRaftServerRemove) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	raftRemoveCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	address := raftRemoveCommand.String("address", "", "the master address as <ip:port>")
+	force := raftRemoveCommand.Bool("force", false, "remove even if the remaining alive masters can not form a quorum")
+	if err = raftRemoveCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *address == "" {
+		return fmt.Errorf("missing -address")
+	}
+
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.RaftRemoveServer(ctx, &master_pb.RaftRemoveServerRequest{
+			Address: *address,
+			Force:   *force,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "master %s is removed\n", *address)
+	return nil
+}
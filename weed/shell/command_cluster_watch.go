@@ -0,0 +1,124 @@
+package shell
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandClusterWatch{})
+}
+
+type commandClusterWatch struct {
+}
+
+func (c *commandClusterWatch) Name() string {
+	return "cluster.watch"
+}
+
+func (c *commandClusterWatch) Help() string {
+	return `print the topology events as they happen
+
+	cluster.watch [-types=node_joined,node_left,...] [-duration=0] [-json]
+
+	The event types are:
+		node_joined, node_left
+		volume_created, volume_deleted, volume_read_only, volume_writable
+		ec_shards_added, ec_shards_removed
+		leader_changed
+		vacuum_started, vacuum_finished
+
+	An ec shard move shows as ec_shards_added on one volume server and ec_shards_removed on another.
+	The watch reconnects to the new leader after the leader changes.
+	It runs until interrupted, or for the duration if set.
+
+`
+}
+
+func (c *commandClusterWatch) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	watchCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	types := watchCommand.String("types", "", "comma separated event types, empty for all")
+	duration := watchCommand.Duration("duration", 0, "stop watching after this duration, 0 to watch until interrupted")
+	if err = watchCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	if *duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *duration)
+		defer cancel()
+	}
+
+	req := &master_pb.WatchTopologyRequest{
+		ClientName: "shell",
+		EventTypes: util.ParseLabelKeys(*types),
+	}
+
+	for ctx.Err() == nil {
+		err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+			stream, err := client.WatchTopology(ctx, req)
+			if err != nil {
+				return err
+			}
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				if err = printTopologyEvent(writer, event, commandEnv.IsJsonOutput()); err != nil {
+					return err
+				}
+			}
+		})
+		if ctx.Err() != nil {
+			break
+		}
+		fmt.Fprintf(writer, "reconnecting after: %v\n", err)
+		time.Sleep(time.Second)
+	}
+
+	return nil
+}
+
+func printTopologyEvent(writer io.Writer, event *master_pb.TopologyEvent, asJson bool) error {
+	if asJson {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(writer, "%s\n", b)
+		return err
+	}
+
+	parts := []string{time.Unix(0, event.TsNs).Format("2006-01-02 15:04:05.000"), event.Type}
+	if event.DataNode != "" {
+		parts = append(parts, fmt.Sprintf("node:%s/%s/%s", event.DataCenter, event.Rack, event.DataNode))
+	}
+	if event.VolumeId != 0 {
+		parts = append(parts, fmt.Sprintf("volume:%d", event.VolumeId))
+	}
+	if event.Collection != "" {
+		parts = append(parts, fmt.Sprintf("collection:%s", event.Collection))
+	}
+	if len(event.EcShardIds) > 0 {
+		parts = append(parts, fmt.Sprintf("shards:%v", event.EcShardIds))
+	}
+	if event.Leader != "" {
+		parts = append(parts, fmt.Sprintf("leader:%s", event.Leader))
+	}
+	if event.Message != "" {
+		parts = append(parts, event.Message)
+	}
+	_, err := fmt.Fprintf(writer, "%s\n", strings.Join(parts, " "))
+	return err
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"io"
+	"strings"
 )
 
 func init() {
@@ -19,19 +21,25 @@ func (c *commandCollectionList) Name() string {
 }
 
 func (c *commandCollectionList) Help() string {
-	return `list all collections`
+	return `list all collections, with their usage, quotas and placement constraints
+
+	The usage counts each volume once, no matter how many replicas it has.
+	The bytes of the ec volumes are not counted.
+`
 }
 
 func (c *commandCollectionList) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
 
-	collections, err := ListCollectionNames(commandEnv, true, true)
+	collections, err := ListCollections(commandEnv, true, true)
 
 	if err != nil {
 		return err
 	}
 
 	for _, c := range collections {
-		fmt.Fprintf(writer, "collection:\"%s\"\n", c)
+		fmt.Fprintf(writer, "collection:\"%s\" volumes:%s size:%s%s\n", c.Name,
+			usageAgainstQuota(c.VolumeCount, c.QuotaVolumes, 1), usageAgainstQuota(c.UsedBytes, c.QuotaBytes, 1024*1024)+"MB",
+			placementString(c.RequiredLabels, c.AntiAffinityLabels))
 	}
 
 	fmt.Fprintf(writer, "Total %d collections.\n", len(collections))
@@ -39,7 +47,57 @@ func (c *commandCollectionList) Do(args []string, commandEnv *CommandEnv, writer
 	return nil
 }
 
-func ListCollectionNames(commandEnv *CommandEnv, includeNormalVolumes, includeEcVolumes bool) (collections []string, err error) {
+func (c *commandCollectionList) DoStructured(args []string, commandEnv *CommandEnv) (records []Record, err error) {
+
+	collections, err := ListCollections(commandEnv, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range collections {
+		records = append(records, Record{
+			"name":               c.Name,
+			"volumeCount":        c.VolumeCount,
+			"usedBytes":          c.UsedBytes,
+			"quotaVolumes":       c.QuotaVolumes,
+			"quotaBytes":         c.QuotaBytes,
+			"requiredLabels":     util.FormatLabels(c.RequiredLabels),
+			"antiAffinityLabels": strings.Join(c.AntiAffinityLabels, ","),
+		})
+	}
+
+	return records, nil
+}
+
+func usageAgainstQuota(used, quota, unit uint64) string {
+	if quota == 0 {
+		return fmt.Sprintf("%d", used/unit)
+	}
+	return fmt.Sprintf("%d/%d", used/unit, quota/unit)
+}
+
+func placementString(requiredLabels map[string]string, antiAffinityLabels []string) (s string) {
+	if len(requiredLabels) > 0 {
+		s += " labels:" + util.FormatLabels(requiredLabels)
+	}
+	if len(antiAffinityLabels) > 0 {
+		s += " antiAffinity:" + strings.Join(antiAffinityLabels, ",")
+	}
+	return
+}
+
+func ListCollectionNames(commandEnv *CommandEnv, includeNormalVolumes, includeEcVolumes bool) (collectionNames []string, err error) {
+	collections, err := ListCollections(commandEnv, includeNormalVolumes, includeEcVolumes)
+	if err != nil {
+		return
+	}
+	for _, c := range collections {
+		collectionNames = append(collectionNames, c.Name)
+	}
+	return
+}
+
+func ListCollections(commandEnv *CommandEnv, includeNormalVolumes, includeEcVolumes bool) (collections []*master_pb.Collection, err error) {
 	var resp *master_pb.CollectionListResponse
 	ctx := context.Background()
 	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
@@ -52,8 +110,5 @@ func ListCollectionNames(commandEnv *CommandEnv, includeNormalVolumes, includeEc
 	if err != nil {
 		return
 	}
-	for _, c := range resp.Collections {
-		collections = append(collections, c.Name)
-	}
-	return
+	return resp.Collections, nil
 }
@@ -0,0 +1,74 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandCollectionPlacementSet{})
+}
+
+type commandCollectionPlacementSet struct {
+}
+
+func (c *commandCollectionPlacementSet) Name() string {
+	return "collection.placement.set"
+}
+
+func (c *commandCollectionPlacementSet) Help() string {
+	return `set the placement constraint of a collection
+
+	collection.placement.set -collection=<collection_name> [-requiredLabels=key1=value1,key2=value2] [-antiAffinityLabels=key1,key2]
+
+	The volume servers report their labels, set by "weed volume -labels=key1=value1,key2=value2".
+	New volumes of the collection are only created on the volume servers with all the required labels,
+	and the replicas of one volume are on the volume servers with different values of each anti affinity label.
+	Existing volumes are not moved. Setting both to empty removes the constraint.
+
+	The assign requests can add more constraints with "requiredLabels" and "antiAffinityLabels" parameters.
+
+`
+}
+
+func (c *commandCollectionPlacementSet) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	placementCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := placementCommand.String("collection", "", "the collection name")
+	requiredLabelsText := placementCommand.String("requiredLabels", "", "the required labels, as key1=value1,key2=value2")
+	antiAffinityLabelsText := placementCommand.String("antiAffinityLabels", "", "the anti affinity label keys, as key1,key2")
+	if err = placementCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	requiredLabels, err := util.ParseLabels(*requiredLabelsText)
+	if err != nil {
+		return err
+	}
+	antiAffinityLabels := util.ParseLabelKeys(*antiAffinityLabelsText)
+
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.CollectionPlacementSet(ctx, &master_pb.CollectionPlacementSetRequest{
+			Name:               *collection,
+			RequiredLabels:     requiredLabels,
+			AntiAffinityLabels: antiAffinityLabels,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(requiredLabels) == 0 && len(antiAffinityLabels) == 0 {
+		fmt.Fprintf(writer, "removed the placement constraint of collection \"%s\"\n", *collection)
+		return nil
+	}
+	fmt.Fprintf(writer, "collection \"%s\" placement:%s\n", *collection, placementString(requiredLabels, antiAffinityLabels))
+	return nil
+}
@@ -0,0 +1,74 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandCollectionQuotaSet{})
+}
+
+type commandCollectionQuotaSet struct {
+}
+
+func (c *commandCollectionQuotaSet) Name() string {
+	return "collection.quota.set"
+}
+
+func (c *commandCollectionQuotaSet) Help() string {
+	return `set the quota of a collection
+
+	collection.quota.set -collection=<collection_name> [-maxMB=0] [-maxVolumes=0]
+
+	0 means unlimited. Setting both to 0 removes the quota.
+
+	Over the size quota, the master rejects new file id assignments to the collection.
+	At the volume count quota, the master stops growing volumes for the collection.
+	The usage is refreshed every pulse, so the collection can go a bit over the size quota.
+	Use "collection.list" to see the usage against the quotas.
+
+`
+}
+
+func (c *commandCollectionQuotaSet) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	quotaCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := quotaCommand.String("collection", "", "the collection name")
+	maxMB := quotaCommand.Uint64("maxMB", 0, "the size quota in MB, 0 means unlimited")
+	maxVolumes := quotaCommand.Uint64("maxVolumes", 0, "the volume count quota, 0 means unlimited")
+	if err = quotaCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.CollectionQuotaSet(ctx, &master_pb.CollectionQuotaSetRequest{
+			Name:         *collection,
+			QuotaBytes:   *maxMB * 1024 * 1024,
+			QuotaVolumes: *maxVolumes,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if *maxMB == 0 && *maxVolumes == 0 {
+		fmt.Fprintf(writer, "removed the quota of collection \"%s\"\n", *collection)
+		return nil
+	}
+	fmt.Fprintf(writer, "collection \"%s\" quota: %s MB, %s volumes\n", *collection, quotaString(*maxMB), quotaString(*maxVolumes))
+	return nil
+}
+
+func quotaString(quota uint64) string {
+	if quota == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", quota)
+}
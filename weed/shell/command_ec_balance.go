@@ -25,7 +25,12 @@ func (c *commandEcBalance) Name() string {
 func (c *commandEcBalance) Help() string {
 	return `balance all ec shards among all racks and volume servers
 
-	ec.balance [-c EACH_COLLECTION|<collection_name>] [-force] [-dataCenter <data_center>]
+	ec.balance [-c EACH_COLLECTION|<collection_name>] [-force] [-dataCenter <data_center>] [-report]
+
+	The shards of one ec volume are kept within the parity shard count in each rack and each data center,
+	so that losing a whole rack or data center does not make the ec volume unreadable.
+	If there are not enough racks or data centers, the shards are spread as evenly as possible.
+	With -report, only list the ec volumes violating this placement policy.
 
 	Algorithm:
 
@@ -44,10 +49,21 @@ func (c *commandEcBalance) Help() string {
 		for each volume:
 			doBalanceEcShardsAcrossRacks(volumeId)
 
+		for each volume:
+			doBalanceEcShardsAcrossDataCenters(volumeId)
+
 		for each volume:
 			doBalanceEcShardsWithinRacks(volumeId)
 	}
 
+	// move ec shards out of data centers and racks over the limit
+	func doBalanceEcShardsAcrossDataCenters(volumeId){
+		for each ec shard in data centers or racks over the limit {
+			destVolumeServer = pick the volume server in the data center, rack with the least shards, under the limits
+			move the ec shard to destVolumeServer
+		}
+	}
+
 	// spread ec shards into more racks
 	func doBalanceEcShardsAcrossRacks(volumeId){
 		tracks rack~volumeIdShardCount mapping
@@ -103,14 +119,29 @@ func (c *commandEcBalance) Do(args []string, commandEnv *CommandEnv, writer io.W
 	collection := balanceCommand.String("collection", "EACH_COLLECTION", "collection name, or \"EACH_COLLECTION\" for each collection")
 	dc := balanceCommand.String("dataCenter", "", "only apply the balancing for this dataCenter")
 	applyBalancing := balanceCommand.Bool("force", false, "apply the balancing plan")
+	reportOnly := balanceCommand.Bool("report", false, "only list the ec volumes violating the rack and data center placement policy")
 	if err = balanceCommand.Parse(args); err != nil {
 		return nil
 	}
 
 	ctx := context.Background()
 
+	if *reportOnly {
+		return reportEcPlacement(ctx, commandEnv, *collection, *dc, writer)
+	}
+
+	topoInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+	ecSchemes := collectEcSchemes(topoInfo)
+	scheme, found := ecSchemes[*collection]
+	if !found {
+		scheme = smallestEcScheme(ecSchemes)
+	}
+
 	// collect all ec nodes
-	allEcNodes, totalFreeEcSlots, err := collectEcNodes(ctx, commandEnv, *dc)
+	allEcNodes, totalFreeEcSlots, err := collectEcNodes(ctx, commandEnv, *dc, scheme)
 	if err != nil {
 		return err
 	}
@@ -174,6 +205,10 @@ func balanceEcVolumes(commandEnv *CommandEnv, collection string, allEcNodes []*E
 		return fmt.Errorf("balance across racks collection %s ec shards: %v", collection, err)
 	}
 
+	if err := balanceEcShardsAcrossDataCenters(ctx, commandEnv, allEcNodes, collection, applyBalancing); err != nil {
+		return fmt.Errorf("balance across data centers collection %s ec shards: %v", collection, err)
+	}
+
 	if err := balanceEcShardsWithinRacks(ctx, commandEnv, allEcNodes, racks, collection, applyBalancing); err != nil {
 		return fmt.Errorf("balance across racks collection %s ec shards: %v", collection, err)
 	}
@@ -196,7 +231,7 @@ func deleteDuplicatedEcShards(ctx context.Context, commandEnv *CommandEnv, allEc
 func doDeduplicateEcShards(ctx context.Context, commandEnv *CommandEnv, collection string, vid needle.VolumeId, locations []*EcNode, applyBalancing bool) error {
 
 	// check whether this volume has ecNodes that are over average
-	shardToLocations := make([][]*EcNode, erasure_coding.TotalShardsCount)
+	shardToLocations := make([][]*EcNode, erasure_coding.MaxShardCount)
 	for _, ecNode := range locations {
 		shardBits := findEcVolumeShards(ecNode, vid)
 		for _, shardId := range shardBits.ShardIds() {
@@ -242,7 +277,7 @@ func balanceEcShardsAcrossRacks(ctx context.Context, commandEnv *CommandEnv, all
 func doBalanceEcShardsAcrossRacks(ctx context.Context, commandEnv *CommandEnv, collection string, vid needle.VolumeId, locations []*EcNode, racks map[RackId]*EcRack, applyBalancing bool) error {
 
 	// calculate average number of shards an ec rack should have for one volume
-	averageShardsPerEcRack := ceilDivide(erasure_coding.TotalShardsCount, len(racks))
+	averageShardsPerEcRack := ceilDivide(findEcVolumeScheme(locations, vid).TotalShards(), len(racks))
 
 	// see the volume's shards are in how many racks, and how many in each rack
 	rackToShardCount := groupByCount(locations, func(ecNode *EcNode) (id string, count int) {
@@ -266,6 +301,11 @@ func doBalanceEcShardsAcrossRacks(ctx context.Context, commandEnv *CommandEnv, c
 
 	for shardId, ecNode := range ecShardsToMove {
 		rackId := pickOneRack(racks, rackToShardCount, averageShardsPerEcRack)
+		if rackId == "" {
+			fmt.Printf("no rack to move ec shard %d.%d out of %s\n", vid, shardId, ecNode.rack)
+			ecNode.addEcVolumeShards(vid, collection, []uint32{uint32(shardId)})
+			continue
+		}
 		var possibleDestinationEcNodes []*EcNode
 		for _, n := range racks[rackId].ecNodes {
 			possibleDestinationEcNodes = append(possibleDestinationEcNodes, n)
@@ -21,6 +21,10 @@ func moveMountedShardToEcNode(ctx context.Context, commandEnv *CommandEnv, exist
 
 	if applyBalancing {
 
+		if err = commandEnv.confirmIsLocked(); err != nil {
+			return
+		}
+
 		// ask destination node to copy shard and the ecx file from source node, and mount it
 		copiedShardIds, err = oneServerCopyAndMountEcShardsFromSource(ctx, commandEnv.option.GrpcDialOption, destinationEcNode, uint32(shardId), 1, vid, collection, existingLocation.info.Id)
 		if err != nil {
@@ -147,16 +151,49 @@ func swap(data []*CandidateEcNode, i, j int) {
 	data[j] = t
 }
 
-func countShards(ecShardInfos []*master_pb.VolumeEcShardInformationMessage) (count int) {
-	for _, ecShardInfo := range ecShardInfos {
-		shardBits := erasure_coding.ShardBits(ecShardInfo.EcIndexBits)
-		count += shardBits.ShardIdCount()
+// findEcVolumeScheme returns the ec scheme of the volume reported by the ec nodes.
+// Volume servers before the ec schemes were configurable do not report it, which means the default 10+4.
+func findEcVolumeScheme(ecNodes []*EcNode, vid needle.VolumeId) erasure_coding.EcScheme {
+	for _, ecNode := range ecNodes {
+		for _, shardInfo := range ecNode.info.EcShardInfos {
+			if needle.VolumeId(shardInfo.Id) == vid && shardInfo.DataShards != 0 {
+				return erasure_coding.ToEcScheme(shardInfo.DataShards, shardInfo.ParityShards)
+			}
+		}
 	}
-	return
+	return erasure_coding.DefaultEcScheme
+}
+
+// collectEcSchemes returns the ec scheme of each collection with ec volumes
+func collectEcSchemes(topoInfo *master_pb.TopologyInfo) map[string]erasure_coding.EcScheme {
+	ecSchemes := make(map[string]erasure_coding.EcScheme)
+	eachDataNode(topoInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, shardInfo := range dn.EcShardInfos {
+			scheme := erasure_coding.ToEcScheme(shardInfo.DataShards, shardInfo.ParityShards)
+			if existing, found := ecSchemes[shardInfo.Collection]; !found || scheme.DataShards < existing.DataShards {
+				ecSchemes[shardInfo.Collection] = scheme
+			}
+		}
+	})
+	return ecSchemes
+}
+
+// smallestEcScheme returns the scheme with the fewest data shards, which has the fewest free shard slots.
+// It is used to count the free shard slots when working on the ec volumes of several collections.
+func smallestEcScheme(ecSchemes map[string]erasure_coding.EcScheme) erasure_coding.EcScheme {
+	smallest := erasure_coding.DefaultEcScheme
+	for _, scheme := range ecSchemes {
+		if scheme.DataShards < smallest.DataShards {
+			smallest = scheme
+		}
+	}
+	return smallest
 }
 
-func countFreeShardSlots(dn *master_pb.DataNodeInfo) (count int) {
-	return int(dn.FreeVolumeCount)*10 - countShards(dn.EcShardInfos)
+// countFreeShardSlots counts the shards of the scheme the volume server can take.
+// The free volume count from the master already excludes the slots taken by the existing ec shards.
+func countFreeShardSlots(dn *master_pb.DataNodeInfo, scheme erasure_coding.EcScheme) (count int) {
+	return int(dn.FreeVolumeCount) * scheme.DataShards
 }
 
 type RackId string
@@ -174,7 +211,7 @@ type EcRack struct {
 	freeEcSlot int
 }
 
-func collectEcNodes(ctx context.Context, commandEnv *CommandEnv, selectedDataCenter string) (ecNodes []*EcNode, totalFreeEcSlots int, err error) {
+func collectEcNodes(ctx context.Context, commandEnv *CommandEnv, selectedDataCenter string, scheme erasure_coding.EcScheme) (ecNodes []*EcNode, totalFreeEcSlots int, err error) {
 
 	// list all possible locations
 	var resp *master_pb.VolumeListResponse
@@ -191,7 +228,7 @@ func collectEcNodes(ctx context.Context, commandEnv *CommandEnv, selectedDataCen
 		if selectedDataCenter != "" && selectedDataCenter != dc {
 			return
 		}
-		if freeEcSlots := countFreeShardSlots(dn); freeEcSlots > 0 {
+		if freeEcSlots := countFreeShardSlots(dn, scheme); freeEcSlots > 0 {
 			ecNodes = append(ecNodes, &EcNode{
 				info:       dn,
 				dc:         dc,
@@ -0,0 +1,317 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	Commands = append(Commands, &commandEcDecode{})
+}
+
+type commandEcDecode struct {
+}
+
+func (c *commandEcDecode) Name() string {
+	return "ec.decode"
+}
+
+func (c *commandEcDecode) Help() string {
+	return `decode an erasure coded volume into a normal volume
+
+	ec.decode [-collection=""] [-volumeId=<volume_id>]
+
+	This command will:
+	1. pick the volume server with the most data shards of the ec volume and one free volume slot
+	2. copy the missing data shards to this volume server
+	3. generate the .dat and .idx files from the data shards, .ecx and .ecj files, and mount the volume
+	4. replicate the volume as its replica placement
+	5. unmount and delete all the ec shards
+
+	All the data shards need to exist. If any data shard is lost, run "ec.rebuild -force" first.
+	Without -volumeId, all ec volumes in the collection are decoded.
+
+`
+}
+
+func (c *commandEcDecode) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	decodeCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeId := decodeCommand.Int("volumeId", 0, "the volume id")
+	collection := decodeCommand.String("collection", "", "the collection name")
+	if err = decodeCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	vid := needle.VolumeId(*volumeId)
+
+	// collect topology information
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+
+	// volumeId is provided
+	if vid != 0 {
+		return doEcDecode(ctx, commandEnv, topologyInfo, *collection, vid, writer)
+	}
+
+	// apply to all ec volumes in the collection
+	volumeIds := collectEcShardIds(topologyInfo, *collection)
+	fmt.Fprintf(writer, "ec decode volumes: %v\n", volumeIds)
+	for _, vid := range volumeIds {
+		if err = doEcDecode(ctx, commandEnv, topologyInfo, *collection, vid, writer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func doEcDecode(ctx context.Context, commandEnv *CommandEnv, topoInfo *master_pb.TopologyInfo, collection string, vid needle.VolumeId, writer io.Writer) (err error) {
+
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
+	// find the ec shard locations
+	ecNodes, allLocations := collectEcVolumeNodes(topoInfo, vid)
+	if len(ecNodes) == 0 {
+		return fmt.Errorf("ec volume %d not found", vid)
+	}
+	scheme := findEcVolumeScheme(ecNodes, vid)
+
+	// pick the volume server to generate the volume
+	targetNode, err := pickEcDecodeTarget(ecNodes, vid, scheme)
+	if err != nil {
+		return fmt.Errorf("ec volume %d: %v", vid, err)
+	}
+
+	// collect the missing data shards to the target server
+	err = collectEcDataShards(ctx, commandEnv.option.GrpcDialOption, ecNodes, collection, vid, scheme, targetNode, writer)
+	if err != nil {
+		return fmt.Errorf("collect data shards for ec volume %d to %s: %v", vid, targetNode.info.Id, err)
+	}
+
+	// generate a normal volume from the data shards
+	replication, err := generateNormalVolume(ctx, commandEnv.option.GrpcDialOption, collection, vid, targetNode.info.Id)
+	if err != nil {
+		return fmt.Errorf("generate normal volume %d on %s: %v", vid, targetNode.info.Id, err)
+	}
+	fmt.Fprintf(writer, "generated volume %d replication %s on %s\n", vid, replication, targetNode.info.Id)
+	targetNode.info.FreeVolumeCount--
+
+	// replicate the volume before deleting the ec shards, so the data stays redundant
+	replicaPlacement, err := storage.NewReplicaPlacementFromString(replication)
+	if err != nil {
+		return err
+	}
+	err = replicateDecodedVolume(ctx, commandEnv.option.GrpcDialOption, vid, replicaPlacement, newLocation(targetNode.dc, string(targetNode.rack), targetNode.info), allLocations, writer)
+	if err != nil {
+		return fmt.Errorf("replicate volume %d: %v, the ec shards are kept", vid, err)
+	}
+
+	// delete the ec shards
+	err = deleteEcVolumeShards(ctx, commandEnv.option.GrpcDialOption, ecNodes, collection, vid, scheme, targetNode)
+	if err != nil {
+		return fmt.Errorf("delete ec shards of volume %d: %v", vid, err)
+	}
+
+	return nil
+}
+
+func collectTopologyInfo(ctx context.Context, commandEnv *CommandEnv) (topoInfo *master_pb.TopologyInfo, err error) {
+
+	var resp *master_pb.VolumeListResponse
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return
+	}
+
+	return resp.TopologyInfo, nil
+}
+
+func collectEcShardIds(topoInfo *master_pb.TopologyInfo, selectedCollection string) (vids []needle.VolumeId) {
+
+	vidMap := make(map[uint32]bool)
+	eachDataNode(topoInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, v := range dn.EcShardInfos {
+			if v.Collection == selectedCollection {
+				vidMap[v.Id] = true
+			}
+		}
+	})
+
+	for vid := range vidMap {
+		vids = append(vids, needle.VolumeId(vid))
+	}
+
+	return
+}
+
+// collectEcVolumeNodes returns the volume servers with the ec volume shards, and all the volume servers
+func collectEcVolumeNodes(topoInfo *master_pb.TopologyInfo, vid needle.VolumeId) (ecNodes []*EcNode, allLocations []location) {
+	eachDataNode(topoInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		allLocations = append(allLocations, newLocation(dc, string(rack), dn))
+		if findEcVolumeShards(&EcNode{info: dn}, vid) == 0 {
+			return
+		}
+		ecNodes = append(ecNodes, &EcNode{
+			info: dn,
+			dc:   dc,
+			rack: rack,
+		})
+	})
+	scheme := findEcVolumeScheme(ecNodes, vid)
+	for _, ecNode := range ecNodes {
+		ecNode.freeEcSlot = countFreeShardSlots(ecNode.info, scheme)
+	}
+	return
+}
+
+// pickEcDecodeTarget picks the volume server with the most data shards, to copy the least data
+func pickEcDecodeTarget(ecNodes []*EcNode, vid needle.VolumeId, scheme erasure_coding.EcScheme) (target *EcNode, err error) {
+	maxDataShardCount := -1
+	for _, ecNode := range ecNodes {
+		if ecNode.info.FreeVolumeCount <= 0 {
+			continue
+		}
+		if dataShardCount := countEcDataShards(findEcVolumeShards(ecNode, vid), scheme); dataShardCount > maxDataShardCount {
+			target, maxDataShardCount = ecNode, dataShardCount
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("no volume server with the ec shards has a free volume slot")
+	}
+	return target, nil
+}
+
+func countEcDataShards(shardBits erasure_coding.ShardBits, scheme erasure_coding.EcScheme) (count int) {
+	for _, shardId := range shardBits.ShardIds() {
+		if int(shardId) < scheme.DataShards {
+			count++
+		}
+	}
+	return
+}
+
+func collectEcDataShards(ctx context.Context, grpcDialOption grpc.DialOption, ecNodes []*EcNode, collection string, vid needle.VolumeId, scheme erasure_coding.EcScheme, targetNode *EcNode, writer io.Writer) error {
+
+	targetShardBits := findEcVolumeShards(targetNode, vid)
+
+	for shardId := 0; shardId < scheme.DataShards; shardId++ {
+		if targetShardBits.HasShardId(erasure_coding.ShardId(shardId)) {
+			continue
+		}
+		sourceNode := findEcShardLocation(ecNodes, vid, erasure_coding.ShardId(shardId))
+		if sourceNode == nil {
+			return fmt.Errorf("data shard %d.%d is missing, please run ec.rebuild first", vid, shardId)
+		}
+
+		fmt.Fprintf(writer, "copy %d.%d %s => %s\n", vid, shardId, sourceNode.info.Id, targetNode.info.Id)
+		err := operation.WithVolumeServerClient(targetNode.info.Id, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, copyErr := volumeServerClient.VolumeEcShardsCopy(ctx, &volume_server_pb.VolumeEcShardsCopyRequest{
+				VolumeId:       uint32(vid),
+				Collection:     collection,
+				ShardIds:       []uint32{uint32(shardId)},
+				CopyEcxFile:    false,
+				SourceDataNode: sourceNode.info.Id,
+			})
+			return copyErr
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func generateNormalVolume(ctx context.Context, grpcDialOption grpc.DialOption, collection string, vid needle.VolumeId, sourceVolumeServer string) (replication string, err error) {
+
+	err = operation.WithVolumeServerClient(sourceVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		resp, genErr := volumeServerClient.VolumeEcShardsToVolume(ctx, &volume_server_pb.VolumeEcShardsToVolumeRequest{
+			VolumeId:   uint32(vid),
+			Collection: collection,
+		})
+		if genErr == nil {
+			replication = resp.Replication
+		}
+		return genErr
+	})
+
+	return
+}
+
+func deleteEcVolumeShards(ctx context.Context, grpcDialOption grpc.DialOption, ecNodes []*EcNode, collection string, vid needle.VolumeId, scheme erasure_coding.EcScheme, targetNode *EcNode) error {
+
+	for _, ecNode := range ecNodes {
+		shardIds := findEcVolumeShards(ecNode, vid).ShardIds()
+		var mountedShardIds []uint32
+		for _, shardId := range shardIds {
+			mountedShardIds = append(mountedShardIds, uint32(shardId))
+		}
+
+		if err := unmountEcShards(ctx, grpcDialOption, vid, ecNode.info.Id, mountedShardIds); err != nil {
+			return err
+		}
+
+		toBeDeletedShardIds := mountedShardIds
+		if ecNode == targetNode {
+			// also delete the copied data shards
+			toBeDeletedShardIds = nil
+			for shardId := 0; shardId < scheme.TotalShards(); shardId++ {
+				toBeDeletedShardIds = append(toBeDeletedShardIds, uint32(shardId))
+			}
+		}
+		if err := sourceServerDeleteEcShards(ctx, grpcDialOption, collection, vid, ecNode.info.Id, toBeDeletedShardIds); err != nil {
+			return err
+		}
+
+		ecNode.deleteEcVolumeShards(vid, mountedShardIds)
+	}
+
+	return nil
+}
+
+func replicateDecodedVolume(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, replicaPlacement *storage.ReplicaPlacement, source location, allLocations []location, writer io.Writer) error {
+
+	volumeInfo := &master_pb.VolumeInformationMessage{Id: uint32(vid)}
+	existingLocations := []location{source}
+	source.dataNode.VolumeInfos = append(source.dataNode.VolumeInfos, volumeInfo)
+	keepDataNodesSorted(allLocations)
+
+	for len(existingLocations) < replicaPlacement.GetCopyCount() {
+		dst, found := pickVolumeDestination(volumeInfo, replicaPlacement, existingLocations, allLocations)
+		if !found {
+			return fmt.Errorf("failed to place volume %d replica as %s, existing:%+v", vid, replicaPlacement, existingLocations)
+		}
+
+		fmt.Fprintf(writer, "replicating volume %d %s from %s to %s ...\n", vid, replicaPlacement, source.dataNode.Id, dst.dataNode.Id)
+		if _, err := copyVolume(ctx, grpcDialOption, vid, source.dataNode.Id, dst.dataNode.Id); err != nil {
+			return err
+		}
+
+		// adjust the destination for the following replicas
+		dst.dataNode.FreeVolumeCount--
+		dst.dataNode.VolumeInfos = append(dst.dataNode.VolumeInfos, volumeInfo)
+		existingLocations = append(existingLocations, dst)
+		keepDataNodesSorted(allLocations)
+	}
+
+	return nil
+}
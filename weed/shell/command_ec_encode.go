@@ -31,23 +31,31 @@ func (c *commandEcEncode) Name() string {
 func (c *commandEcEncode) Help() string {
 	return `apply erasure coding to a volume
 
-	ec.encode [-collection=""] [-fullPercent=95] [-quietFor=1h]
-	ec.encode [-collection=""] [-volumeId=<volume_id>]
+	ec.encode [-collection=""] [-fullPercent=95] [-quietFor=1h] [-scheme=10+4]
+	ec.encode [-collection=""] [-volumeId=<volume_id>] [-scheme=10+4]
 
 	This command will:
 	1. freeze one volume
 	2. apply erasure coding to the volume
 	3. move the encoded shards to multiple volume servers
 
-	The erasure coding is 10.4. So ideally you have more than 14 volume servers, and you can afford
+	The erasure coding is 10.4 by default. So ideally you have more than 14 volume servers, and you can afford
 	to lose 4 volume servers.
 
+	For smaller clusters, choose a scheme with fewer shards for the collection, e.g. -scheme=4+2 or -scheme=6+3.
+	The scheme is kept with each encoded volume, so different collections can use different schemes.
+	All the ec volumes of one collection use the same scheme. Once a collection has ec volumes,
+	-scheme can be omitted, and a different scheme is refused.
+
 	If the number of volumes are not high, the worst case is that you only have 4 volume servers,
 	and the shards are spread as 4,4,3,3, respectively. You can afford to lose one volume server.
 
 	If you only have less than 4 volume servers, with erasure coding, at least you can afford to
 	have 4 corrupted shard files.
 
+	The shards are spread across data centers and racks first. No rack or data center gets more shards than
+	the parity shard count if there are enough of them, so losing a whole rack or data center is also fine.
+
 `
 }
 
@@ -58,16 +66,35 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	collection := encodeCommand.String("collection", "", "the collection name")
 	fullPercentage := encodeCommand.Float64("fullPercent", 95, "the volume reaches the percentage of max volume size")
 	quietPeriod := encodeCommand.Duration("quietFor", time.Hour, "select volumes without no writes for this period")
+	schemeString := encodeCommand.String("scheme", erasure_coding.DefaultEcScheme.String(), "data shards+parity shards, e.g. 4+2, 6+3, 10+4, 12+4")
 	if err = encodeCommand.Parse(args); err != nil {
 		return nil
 	}
+	scheme, err := erasure_coding.ParseEcScheme(*schemeString)
+	if err != nil {
+		return err
+	}
+	isSchemeSet := false
+	encodeCommand.Visit(func(f *flag.Flag) {
+		if f.Name == "scheme" {
+			isSchemeSet = true
+		}
+	})
 
 	ctx := context.Background()
 	vid := needle.VolumeId(*volumeId)
 
+	topoInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+	if scheme, err = chooseCollectionEcScheme(collectEcSchemes(topoInfo), *collection, scheme, isSchemeSet); err != nil {
+		return err
+	}
+
 	// volumeId is provided
 	if vid != 0 {
-		return doEcEncode(ctx, commandEnv, *collection, vid)
+		return doEcEncode(ctx, commandEnv, *collection, vid, scheme)
 	}
 
 	// apply to all volumes in the collection
@@ -77,7 +104,7 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	}
 	fmt.Printf("ec encode volumes: %v\n", volumeIds)
 	for _, vid := range volumeIds {
-		if err = doEcEncode(ctx, commandEnv, *collection, vid); err != nil {
+		if err = doEcEncode(ctx, commandEnv, *collection, vid, scheme); err != nil {
 			return err
 		}
 	}
@@ -85,7 +112,24 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	return nil
 }
 
-func doEcEncode(ctx context.Context, commandEnv *CommandEnv, collection string, vid needle.VolumeId) (err error) {
+// chooseCollectionEcScheme keeps one ec scheme for each collection.
+// The scheme of the existing ec volumes is used unless a different one is asked for, which is an error.
+func chooseCollectionEcScheme(ecSchemes map[string]erasure_coding.EcScheme, collection string, scheme erasure_coding.EcScheme, isSchemeSet bool) (erasure_coding.EcScheme, error) {
+	existing, found := ecSchemes[collection]
+	if !found {
+		return scheme, nil
+	}
+	if isSchemeSet && existing != scheme {
+		return scheme, fmt.Errorf("collection %s is erasure coded with scheme %s, not %s", collection, existing, scheme)
+	}
+	return existing, nil
+}
+
+func doEcEncode(ctx context.Context, commandEnv *CommandEnv, collection string, vid needle.VolumeId, scheme erasure_coding.EcScheme) (err error) {
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return
+	}
+
 	// find volume location
 	locations := commandEnv.MasterClient.GetLocations(uint32(vid))
 	if len(locations) == 0 {
@@ -99,13 +143,13 @@ func doEcEncode(ctx context.Context, commandEnv *CommandEnv, collection string,
 	}
 
 	// generate ec shards
-	err = generateEcShards(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(vid), collection, locations[0].Url)
+	err = generateEcShards(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(vid), collection, scheme, locations[0].Url)
 	if err != nil {
 		return fmt.Errorf("generate ec shards for volume %d on %s: %v", vid, locations[0].Url, err)
 	}
 
 	// balance the ec shards to current cluster
-	err = spreadEcShards(ctx, commandEnv, vid, collection, locations)
+	err = spreadEcShards(ctx, commandEnv, vid, collection, scheme, locations)
 	if err != nil {
 		return fmt.Errorf("spread ec shards for volume %d from %s: %v", vid, locations[0].Url, err)
 	}
@@ -133,12 +177,14 @@ func markVolumeReadonly(ctx context.Context, grpcDialOption grpc.DialOption, vol
 	return nil
 }
 
-func generateEcShards(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, collection string, sourceVolumeServer string) error {
+func generateEcShards(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, collection string, scheme erasure_coding.EcScheme, sourceVolumeServer string) error {
 
 	err := operation.WithVolumeServerClient(sourceVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
 		_, genErr := volumeServerClient.VolumeEcShardsGenerate(ctx, &volume_server_pb.VolumeEcShardsGenerateRequest{
-			VolumeId:   uint32(volumeId),
-			Collection: collection,
+			VolumeId:     uint32(volumeId),
+			Collection:   collection,
+			DataShards:   uint32(scheme.DataShards),
+			ParityShards: uint32(scheme.ParityShards),
 		})
 		return genErr
 	})
@@ -147,26 +193,25 @@ func generateEcShards(ctx context.Context, grpcDialOption grpc.DialOption, volum
 
 }
 
-func spreadEcShards(ctx context.Context, commandEnv *CommandEnv, volumeId needle.VolumeId, collection string, existingLocations []wdclient.Location) (err error) {
+func spreadEcShards(ctx context.Context, commandEnv *CommandEnv, volumeId needle.VolumeId, collection string, scheme erasure_coding.EcScheme, existingLocations []wdclient.Location) (err error) {
 
-	allEcNodes, totalFreeEcSlots, err := collectEcNodes(ctx, commandEnv, "")
+	allEcNodes, totalFreeEcSlots, err := collectEcNodes(ctx, commandEnv, "", scheme)
 	if err != nil {
 		return err
 	}
 
-	if totalFreeEcSlots < erasure_coding.TotalShardsCount {
+	if totalFreeEcSlots < scheme.TotalShards() {
 		return fmt.Errorf("not enough free ec shard slots. only %d left", totalFreeEcSlots)
 	}
-	allocatedDataNodes := allEcNodes
-	if len(allocatedDataNodes) > erasure_coding.TotalShardsCount {
-		allocatedDataNodes = allocatedDataNodes[:erasure_coding.TotalShardsCount]
-	}
 
-	// calculate how many shards to allocate for these servers
-	allocated := balancedEcDistribution(allocatedDataNodes)
+	// calculate how many shards to allocate for these servers, within the rack and data center limits
+	allocated, err := placeEcShards(allEcNodes, scheme)
+	if err != nil {
+		return err
+	}
 
 	// ask the data nodes to copy from the source volume server
-	copiedShardIds, err := parallelCopyEcShardsFromSource(ctx, commandEnv.option.GrpcDialOption, allocatedDataNodes, allocated, volumeId, collection, existingLocations[0])
+	copiedShardIds, err := parallelCopyEcShardsFromSource(ctx, commandEnv.option.GrpcDialOption, allEcNodes, allocated, volumeId, collection, existingLocations[0])
 	if err != nil {
 		return err
 	}
@@ -236,24 +281,6 @@ func parallelCopyEcShardsFromSource(ctx context.Context, grpcDialOption grpc.Dia
 	return
 }
 
-func balancedEcDistribution(servers []*EcNode) (allocated []int) {
-	allocated = make([]int, len(servers))
-	allocatedCount := 0
-	for allocatedCount < erasure_coding.TotalShardsCount {
-		for i, server := range servers {
-			if server.freeEcSlot-allocated[i] > 0 {
-				allocated[i] += 1
-				allocatedCount += 1
-			}
-			if allocatedCount >= erasure_coding.TotalShardsCount {
-				break
-			}
-		}
-	}
-
-	return allocated
-}
-
 func collectVolumeIdsForEcEncode(ctx context.Context, commandEnv *CommandEnv, selectedCollection string, fullPercentage float64, quietPeriod time.Duration) (vids []needle.VolumeId, err error) {
 
 	var resp *master_pb.VolumeListResponse
@@ -0,0 +1,255 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+/*
+
+The ec shards of one volume are placed so that no rack and no data center holds more shards than the parity shard count.
+Losing a whole rack or data center still leaves enough shards to read the volume.
+
+If there are not enough racks or data centers to meet the limit, the shards are spread across them as evenly as possible.
+
+*/
+
+type ecPlacement struct {
+	rackLimit      int
+	dcLimit        int
+	rackShardCount map[string]int
+	dcShardCount   map[string]int
+	nodeShardCount map[string]int
+}
+
+// newEcPlacement calculates the shard limits from the racks and data centers of all the ec nodes
+func newEcPlacement(allEcNodes []*EcNode, scheme erasure_coding.EcScheme) *ecPlacement {
+	racks, dcs := make(map[string]bool), make(map[string]bool)
+	for _, ecNode := range allEcNodes {
+		racks[ecRackKey(ecNode)] = true
+		dcs[ecNode.dc] = true
+	}
+	return &ecPlacement{
+		rackLimit:      ecShardLimit(len(racks), scheme),
+		dcLimit:        ecShardLimit(len(dcs), scheme),
+		rackShardCount: make(map[string]int),
+		dcShardCount:   make(map[string]int),
+		nodeShardCount: make(map[string]int),
+	}
+}
+
+// ecShardLimit returns the max number of shards of one volume in one of the domainCount racks or data centers
+func ecShardLimit(domainCount int, scheme erasure_coding.EcScheme) int {
+	if domainCount <= 1 {
+		return scheme.TotalShards()
+	}
+	if domainCount*scheme.ParityShards >= scheme.TotalShards() {
+		return scheme.ParityShards
+	}
+	return ceilDivide(scheme.TotalShards(), domainCount)
+}
+
+// the rack ids are only unique within one data center
+func ecRackKey(ecNode *EcNode) string {
+	return ecNode.dc + ":" + string(ecNode.rack)
+}
+
+func (p *ecPlacement) countEcShards(ecNodes []*EcNode, vid needle.VolumeId) *ecPlacement {
+	for _, ecNode := range ecNodes {
+		p.addShards(ecNode, findEcVolumeShards(ecNode, vid).ShardIdCount())
+	}
+	return p
+}
+
+func (p *ecPlacement) addShards(ecNode *EcNode, count int) {
+	p.rackShardCount[ecRackKey(ecNode)] += count
+	p.dcShardCount[ecNode.dc] += count
+	p.nodeShardCount[ecNode.info.Id] += count
+}
+
+func (p *ecPlacement) canAddShard(ecNode *EcNode) bool {
+	return p.rackShardCount[ecRackKey(ecNode)] < p.rackLimit && p.dcShardCount[ecNode.dc] < p.dcLimit
+}
+
+// pickEcNode picks the ec node in the data center, rack, and ec node with the least shards, under the limits
+func (p *ecPlacement) pickEcNode(ecNodes []*EcNode, skipFn func(ecNode *EcNode) bool) *EcNode {
+	var candidates []*EcNode
+	for _, ecNode := range ecNodes {
+		if ecNode.freeEcSlot <= 0 || !p.canAddShard(ecNode) || skipFn(ecNode) {
+			continue
+		}
+		candidates = append(candidates, ecNode)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if p.dcShardCount[a.dc] != p.dcShardCount[b.dc] {
+			return p.dcShardCount[a.dc] < p.dcShardCount[b.dc]
+		}
+		if p.rackShardCount[ecRackKey(a)] != p.rackShardCount[ecRackKey(b)] {
+			return p.rackShardCount[ecRackKey(a)] < p.rackShardCount[ecRackKey(b)]
+		}
+		if p.nodeShardCount[a.info.Id] != p.nodeShardCount[b.info.Id] {
+			return p.nodeShardCount[a.info.Id] < p.nodeShardCount[b.info.Id]
+		}
+		return a.freeEcSlot > b.freeEcSlot
+	})
+	return candidates[0]
+}
+
+// placeEcShards allocates the shards of a new ec volume to the ec nodes one by one, and returns the shard count for each ec node
+func placeEcShards(ecNodes []*EcNode, scheme erasure_coding.EcScheme) (allocated []int, err error) {
+	p := newEcPlacement(ecNodes, scheme)
+	allocatedCount := make(map[*EcNode]int)
+	for shardId := 0; shardId < scheme.TotalShards(); shardId++ {
+		ecNode := p.pickEcNode(ecNodes, func(ecNode *EcNode) bool {
+			return ecNode.freeEcSlot-allocatedCount[ecNode] <= 0
+		})
+		if ecNode == nil {
+			return nil, fmt.Errorf("no free slot for ec shard %d with at most %d shards per rack and %d shards per data center", shardId, p.rackLimit, p.dcLimit)
+		}
+		p.addShards(ecNode, 1)
+		allocatedCount[ecNode]++
+	}
+	for _, ecNode := range ecNodes {
+		allocated = append(allocated, allocatedCount[ecNode])
+	}
+	return allocated, nil
+}
+
+func balanceEcShardsAcrossDataCenters(ctx context.Context, commandEnv *CommandEnv, allEcNodes []*EcNode, collection string, applyBalancing bool) error {
+	// collect vid => []ecNode, since previous steps can change the locations
+	vidLocations := collectVolumeIdToEcNodes(allEcNodes)
+	for vid, locations := range vidLocations {
+		if findEcVolumeCollection(locations, vid) != collection {
+			continue
+		}
+		if err := doBalanceEcShardsAcrossDataCenters(ctx, commandEnv, collection, vid, locations, allEcNodes, applyBalancing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doBalanceEcShardsAcrossDataCenters moves the shards over the limit out of the data center, and also fixes the racks over the limit
+func doBalanceEcShardsAcrossDataCenters(ctx context.Context, commandEnv *CommandEnv, collection string, vid needle.VolumeId, locations []*EcNode, allEcNodes []*EcNode, applyBalancing bool) error {
+
+	p := newEcPlacement(allEcNodes, findEcVolumeScheme(locations, vid)).countEcShards(locations, vid)
+
+	for _, ecNode := range locations {
+		for _, shardId := range findEcVolumeShards(ecNode, vid).ShardIds() {
+			if p.dcShardCount[ecNode.dc] <= p.dcLimit && p.rackShardCount[ecRackKey(ecNode)] <= p.rackLimit {
+				break
+			}
+			overLimitDc := p.dcShardCount[ecNode.dc] > p.dcLimit
+			destination := p.pickEcNode(allEcNodes, func(n *EcNode) bool {
+				if overLimitDc && n.dc == ecNode.dc || ecRackKey(n) == ecRackKey(ecNode) {
+					return true
+				}
+				return findEcVolumeShards(n, vid).HasShardId(shardId)
+			})
+			if destination == nil {
+				fmt.Printf("no destination to move ec shard %d.%d out of %s %s\n", vid, shardId, ecNode.dc, ecNode.rack)
+				break
+			}
+			if err := moveMountedShardToEcNode(ctx, commandEnv, ecNode, collection, vid, shardId, destination, applyBalancing); err != nil {
+				return err
+			}
+			p.addShards(ecNode, -1)
+			p.addShards(destination, 1)
+		}
+	}
+
+	return nil
+}
+
+// reportEcPlacement lists the ec volumes with more shards than the parity shard count in one rack or data center
+func reportEcPlacement(ctx context.Context, commandEnv *CommandEnv, collection string, selectedDataCenter string, writer io.Writer) error {
+
+	topoInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+
+	var allEcNodes []*EcNode
+	eachDataNode(topoInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		if selectedDataCenter != "" && selectedDataCenter != dc {
+			return
+		}
+		allEcNodes = append(allEcNodes, &EcNode{
+			info: dn,
+			dc:   dc,
+			rack: rack,
+		})
+	})
+
+	var violationCount int
+	vidLocations := collectVolumeIdToEcNodes(allEcNodes)
+	var vids []needle.VolumeId
+	for vid := range vidLocations {
+		vids = append(vids, vid)
+	}
+	sort.Slice(vids, func(i, j int) bool { return vids[i] < vids[j] })
+
+	for _, vid := range vids {
+		locations := vidLocations[vid]
+		if collection != "EACH_COLLECTION" && findEcVolumeCollection(locations, vid) != collection {
+			continue
+		}
+		scheme := findEcVolumeScheme(locations, vid)
+		p := newEcPlacement(allEcNodes, scheme).countEcShards(locations, vid)
+
+		violations := findEcPlacementViolations(p.dcShardCount, "data center", p.dcLimit, scheme)
+		violations = append(violations, findEcPlacementViolations(p.rackShardCount, "rack", p.rackLimit, scheme)...)
+		if len(violations) == 0 {
+			continue
+		}
+		violationCount++
+		fmt.Fprintf(writer, "ec volume %d %s: %v\n", vid, scheme, violations)
+	}
+
+	fmt.Fprintf(writer, "%d ec volumes violate the placement policy\n", violationCount)
+	return nil
+}
+
+// findEcPlacementViolations lists the racks or data centers with more shards than the parity shard count.
+// A single rack or data center is not reported, since there is nowhere else to place the shards.
+func findEcPlacementViolations(shardCounts map[string]int, domainType string, limit int, scheme erasure_coding.EcScheme) (violations []string) {
+	if limit == scheme.TotalShards() {
+		return nil
+	}
+	var domains []string
+	for domain, count := range shardCounts {
+		if count > scheme.ParityShards {
+			domains = append(domains, domain)
+		}
+	}
+	sort.Strings(domains)
+	for _, domain := range domains {
+		violation := fmt.Sprintf("%s %s has %d shards, more than %d", domainType, domain, shardCounts[domain], scheme.ParityShards)
+		if limit > scheme.ParityShards {
+			violation += fmt.Sprintf(" (not enough %ss to fix)", domainType)
+		}
+		violations = append(violations, violation)
+	}
+	return
+}
+
+func findEcVolumeCollection(ecNodes []*EcNode, vid needle.VolumeId) string {
+	for _, ecNode := range ecNodes {
+		for _, shardInfo := range ecNode.info.EcShardInfos {
+			if needle.VolumeId(shardInfo.Id) == vid {
+				return shardInfo.Collection
+			}
+		}
+	}
+	return ""
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -111,6 +112,84 @@ func TestCommandEcBalanceVolumeEvenButRackUneven(t *testing.T) {
 	balanceEcRacks(context.Background(), nil, racks, false)
 }
 
+func TestPlaceEcShardsAcrossRacks(t *testing.T) {
+
+	allEcNodes := []*EcNode{
+		newEcNode("dc1", "rack1", "dn1", 100),
+		newEcNode("dc1", "rack1", "dn2", 100),
+		newEcNode("dc1", "rack1", "dn3", 100),
+		newEcNode("dc1", "rack2", "dn4", 100),
+		newEcNode("dc1", "rack2", "dn5", 100),
+		newEcNode("dc1", "rack3", "dn6", 100),
+		newEcNode("dc2", "rack1", "dn7", 100),
+		newEcNode("dc2", "rack4", "dn8", 100),
+	}
+
+	scheme := erasure_coding.DefaultEcScheme
+	allocated, err := placeEcShards(allEcNodes, scheme)
+	if err != nil {
+		t.Fatalf("place ec shards: %v", err)
+	}
+
+	p := newEcPlacement(allEcNodes, scheme)
+	for i, ecNode := range allEcNodes {
+		p.addShards(ecNode, allocated[i])
+	}
+	assertEcPlacement(t, p, scheme, 4, 7)
+}
+
+func TestPlaceEcShardsWithoutEnoughSlots(t *testing.T) {
+
+	allEcNodes := []*EcNode{
+		newEcNode("dc1", "rack1", "dn1", 100),
+		newEcNode("dc1", "rack2", "dn2", 2),
+		newEcNode("dc1", "rack3", "dn3", 2),
+		newEcNode("dc1", "rack4", "dn4", 2),
+	}
+
+	if _, err := placeEcShards(allEcNodes, erasure_coding.DefaultEcScheme); err == nil {
+		t.Errorf("placing 14 shards with at most 4 in one rack should fail")
+	}
+}
+
+func TestCommandEcBalanceAcrossDataCenters(t *testing.T) {
+
+	allEcNodes := []*EcNode{
+		newEcNode("dc1", "rack1", "dn1", 100).addEcVolumeAndShardsForTest(1, "c1", []uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}),
+		newEcNode("dc1", "rack2", "dn2", 100),
+		newEcNode("dc2", "rack1", "dn3", 100),
+		newEcNode("dc2", "rack2", "dn4", 100),
+	}
+
+	racks := collectRacks(allEcNodes)
+	balanceEcVolumes(nil, "c1", allEcNodes, racks, false)
+
+	scheme := erasure_coding.DefaultEcScheme
+	p := newEcPlacement(allEcNodes, scheme).countEcShards(allEcNodes, 1)
+	assertEcPlacement(t, p, scheme, 4, 7)
+}
+
+func assertEcPlacement(t *testing.T, p *ecPlacement, scheme erasure_coding.EcScheme, expectedRackLimit, expectedDcLimit int) {
+	if p.rackLimit != expectedRackLimit || p.dcLimit != expectedDcLimit {
+		t.Errorf("limits: rack %d data center %d, expected %d %d", p.rackLimit, p.dcLimit, expectedRackLimit, expectedDcLimit)
+	}
+	total := 0
+	for rack, count := range p.rackShardCount {
+		total += count
+		if count > p.rackLimit {
+			t.Errorf("rack %s has %d shards", rack, count)
+		}
+	}
+	for dc, count := range p.dcShardCount {
+		if count > p.dcLimit {
+			t.Errorf("data center %s has %d shards", dc, count)
+		}
+	}
+	if total != scheme.TotalShards() {
+		t.Errorf("placed %d shards, expected %d", total, scheme.TotalShards())
+	}
+}
+
 func newEcNode(dc string, rack string, dataNodeId string, freeEcSlot int) *EcNode {
 	return &EcNode{
 		info: &master_pb.DataNodeInfo{
@@ -125,3 +204,70 @@ func newEcNode(dc string, rack string, dataNodeId string, freeEcSlot int) *EcNod
 func (ecNode *EcNode) addEcVolumeAndShardsForTest(vid uint32, collection string, shardIds []uint32) *EcNode {
 	return ecNode.addEcVolumeShards(needle.VolumeId(vid), collection, shardIds)
 }
+
+func TestSurvivingEcShards(t *testing.T) {
+
+	ecNodes := []*EcNode{
+		newEcNode("dc1", "rack1", "dn1", 100).addEcVolumeAndShardsForTest(1, "c1", []uint32{0, 1, 2, 3, 4, 5, 6}),
+		newEcNode("dc1", "rack2", "dn2", 100).addEcVolumeAndShardsForTest(1, "c1", []uint32{7, 8, 9, 10, 11, 12, 13}),
+	}
+	scheme := erasure_coding.DefaultEcScheme
+
+	if collection := findEcVolumeCollection(ecNodes, 1); collection != "c1" {
+		t.Errorf("collection: %s", collection)
+	}
+
+	surviving := survivingEcShards(ecNodes, 1, []erasure_coding.ShardId{1, 8})
+	if surviving.ShardIdCount() != 12 || surviving.HasShardId(1) || surviving.HasShardId(8) {
+		t.Errorf("surviving shards: %v", surviving.ShardIds())
+	}
+
+	var tooMany []erasure_coding.ShardId
+	for shardId := 0; shardId <= scheme.ParityShards; shardId++ {
+		tooMany = append(tooMany, erasure_coding.ShardId(shardId))
+	}
+	if surviving = survivingEcShards(ecNodes, 1, tooMany); surviving.ShardIdCount() >= scheme.DataShards {
+		t.Errorf("%d corrupted shards should leave fewer than %d shards: %v", len(tooMany), scheme.DataShards, surviving.ShardIds())
+	}
+}
+
+func TestChooseCollectionEcScheme(t *testing.T) {
+	small := erasure_coding.EcScheme{DataShards: 4, ParityShards: 2}
+	topoInfo := &master_pb.TopologyInfo{
+		DataCenterInfos: []*master_pb.DataCenterInfo{{
+			Id: "dc1",
+			RackInfos: []*master_pb.RackInfo{{
+				Id: "rack1",
+				DataNodeInfos: []*master_pb.DataNodeInfo{{
+					Id:              "dn1",
+					FreeVolumeCount: 3,
+					EcShardInfos: []*master_pb.VolumeEcShardInformationMessage{
+						{Id: 1, Collection: "small", EcIndexBits: 0x3f, DataShards: 4, ParityShards: 2},
+						{Id: 2, Collection: "", EcIndexBits: 0x3fff},
+					},
+				}},
+			}},
+		}},
+	}
+
+	ecSchemes := collectEcSchemes(topoInfo)
+	if ecSchemes["small"] != small || ecSchemes[""] != erasure_coding.DefaultEcScheme {
+		t.Fatalf("unexpected ec schemes %v", ecSchemes)
+	}
+	if smallest := smallestEcScheme(ecSchemes); smallest != small {
+		t.Errorf("smallest scheme %s, expected %s", smallest, small)
+	}
+	if count := countFreeShardSlots(topoInfo.DataCenterInfos[0].RackInfos[0].DataNodeInfos[0], small); count != 12 {
+		t.Errorf("free shard slots %d, expected 12", count)
+	}
+
+	if scheme, err := chooseCollectionEcScheme(ecSchemes, "small", erasure_coding.DefaultEcScheme, false); err != nil || scheme != small {
+		t.Errorf("expected the scheme %s of the collection, got %s: %v", small, scheme, err)
+	}
+	if _, err := chooseCollectionEcScheme(ecSchemes, "small", erasure_coding.DefaultEcScheme, true); err == nil {
+		t.Errorf("expected a different scheme in the collection refused")
+	}
+	if scheme, err := chooseCollectionEcScheme(ecSchemes, "new", small, true); err != nil || scheme != small {
+		t.Errorf("expected the scheme %s for a new collection, got %s: %v", small, scheme, err)
+	}
+}
@@ -0,0 +1,238 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	Commands = append(Commands, &commandEcVerify{})
+}
+
+type commandEcVerify struct {
+}
+
+func (c *commandEcVerify) Name() string {
+	return "ec.verify"
+}
+
+func (c *commandEcVerify) Help() string {
+	return `verify the parity of erasure coded volumes
+
+	ec.verify [-collection=""] [-volumeId=<volume_id>] [-rebuild]
+
+	This command will:
+	1. pick the volume server with the most shards of the ec volume
+	2. this volume server reads all the shards stripe by stripe, from local disk or other volume servers,
+	   and recomputes the Reed-Solomon parity
+	3. report the mismatched stripes, and the corrupted shards if they can be found
+	4. with -rebuild, delete the corrupted shards and rebuild them from the other shards
+
+	All the shards need to exist. If any shard is lost, run "ec.rebuild -force" first.
+	Without -volumeId, all ec volumes in the collection are verified.
+
+`
+}
+
+func (c *commandEcVerify) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	verifyCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeId := verifyCommand.Int("volumeId", 0, "the volume id")
+	collection := verifyCommand.String("collection", "", "the collection name")
+	applyRebuild := verifyCommand.Bool("rebuild", false, "delete and rebuild the corrupted shards")
+	if err = verifyCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	vid := needle.VolumeId(*volumeId)
+
+	// collect topology information
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+
+	volumeIds := []needle.VolumeId{vid}
+	if vid == 0 {
+		volumeIds = collectEcShardIds(topologyInfo, *collection)
+		fmt.Fprintf(writer, "ec verify volumes: %v\n", volumeIds)
+	}
+
+	var corruptedVolumeIds []needle.VolumeId
+	for _, vid := range volumeIds {
+		badShardIds, err := doEcVerify(ctx, commandEnv, topologyInfo, vid, writer)
+		if err != nil {
+			return err
+		}
+		if len(badShardIds) == 0 {
+			continue
+		}
+		corruptedVolumeIds = append(corruptedVolumeIds, vid)
+		if !*applyRebuild {
+			continue
+		}
+		if err = rebuildCorruptedEcShards(ctx, commandEnv, vid, badShardIds, writer); err != nil {
+			return fmt.Errorf("rebuild ec volume %d: %v", vid, err)
+		}
+	}
+
+	if len(corruptedVolumeIds) > 0 && !*applyRebuild {
+		fmt.Fprintf(writer, "corrupted ec volumes: %v, run \"ec.verify -rebuild\" to rebuild the corrupted shards\n", corruptedVolumeIds)
+	}
+
+	return nil
+}
+
+// doEcVerify returns the corrupted shard ids of the ec volume
+func doEcVerify(ctx context.Context, commandEnv *CommandEnv, topoInfo *master_pb.TopologyInfo, vid needle.VolumeId, writer io.Writer) (badShardIds []erasure_coding.ShardId, err error) {
+
+	ecNodes, _ := collectEcVolumeNodes(topoInfo, vid)
+	if len(ecNodes) == 0 {
+		return nil, fmt.Errorf("ec volume %d not found", vid)
+	}
+
+	// the volume server with the most shards reads the least data remotely
+	verifier := ecNodes[0]
+	for _, ecNode := range ecNodes {
+		if findEcVolumeShards(ecNode, vid).ShardIdCount() > findEcVolumeShards(verifier, vid).ShardIdCount() {
+			verifier = ecNode
+		}
+	}
+
+	var mismatchCount int
+	badShardBits := erasure_coding.ShardBits(0)
+	err = verifyEcShards(ctx, commandEnv.option.GrpcDialOption, vid, verifier.info.Id, func(resp *volume_server_pb.VolumeEcShardsVerifyResponse) {
+		mismatchCount++
+		if len(resp.BadShardIds) == 0 {
+			fmt.Fprintf(writer, "ec volume %d stripe at %d size %d: parity mismatch, corrupted shards unknown\n", vid, resp.Offset, resp.Size)
+			return
+		}
+		fmt.Fprintf(writer, "ec volume %d stripe at %d size %d: corrupted shards %v\n", vid, resp.Offset, resp.Size, resp.BadShardIds)
+		for _, shardId := range resp.BadShardIds {
+			badShardBits = badShardBits.AddShardId(erasure_coding.ShardId(shardId))
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("verify ec volume %d on %s: %v", vid, verifier.info.Id, err)
+	}
+
+	if mismatchCount == 0 {
+		fmt.Fprintf(writer, "ec volume %d verified on %s\n", vid, verifier.info.Id)
+		return nil, nil
+	}
+	fmt.Fprintf(writer, "ec volume %d has %d mismatched stripes, corrupted shards %v\n", vid, mismatchCount, badShardBits.ShardIds())
+
+	return badShardBits.ShardIds(), nil
+}
+
+func verifyEcShards(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, sourceVolumeServer string, fn func(resp *volume_server_pb.VolumeEcShardsVerifyResponse)) error {
+
+	return operation.WithVolumeServerClient(sourceVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		stream, err := volumeServerClient.VolumeEcShardsVerify(ctx, &volume_server_pb.VolumeEcShardsVerifyRequest{
+			VolumeId: uint32(vid),
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				return nil
+			}
+			if recvErr != nil {
+				return recvErr
+			}
+			fn(resp)
+		}
+	})
+}
+
+// rebuildCorruptedEcShards deletes the corrupted shards from their volume servers, and rebuilds them as ec.rebuild.
+// Nothing is deleted unless the remaining shards are enough to rebuild the volume.
+func rebuildCorruptedEcShards(ctx context.Context, commandEnv *CommandEnv, vid needle.VolumeId, badShardIds []erasure_coding.ShardId, writer io.Writer) error {
+
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+	ecNodes, _ := collectEcVolumeNodes(topologyInfo, vid)
+	if len(ecNodes) == 0 {
+		return fmt.Errorf("ec volume %d not found", vid)
+	}
+	scheme := findEcVolumeScheme(ecNodes, vid)
+	collection := findEcVolumeCollection(ecNodes, vid)
+
+	if survivingShardBits := survivingEcShards(ecNodes, vid, badShardIds); survivingShardBits.ShardIdCount() < scheme.DataShards {
+		return fmt.Errorf("ec volume %d is unrepairable, only shards %v are not corrupted", vid, survivingShardBits.ShardIds())
+	}
+
+	allEcNodes, _, err := collectEcNodes(ctx, commandEnv, "", scheme)
+	if err != nil {
+		return err
+	}
+	sortEcNodes(allEcNodes)
+	if len(allEcNodes) == 0 || allEcNodes[0].freeEcSlot < scheme.TotalShards() {
+		return fmt.Errorf("disk space is not enough")
+	}
+
+	if err = commandEnv.confirmIsLocked(); err != nil {
+		return err
+	}
+
+	for _, ecNode := range ecNodes {
+		shardBits := findEcVolumeShards(ecNode, vid)
+		var toBeDeletedShardIds []uint32
+		for _, shardId := range badShardIds {
+			if shardBits.HasShardId(shardId) {
+				toBeDeletedShardIds = append(toBeDeletedShardIds, uint32(shardId))
+			}
+		}
+		if len(toBeDeletedShardIds) == 0 {
+			continue
+		}
+		fmt.Fprintf(writer, "delete corrupted shards %d.%v on %s\n", vid, toBeDeletedShardIds, ecNode.info.Id)
+		if err = unmountEcShards(ctx, commandEnv.option.GrpcDialOption, vid, ecNode.info.Id, toBeDeletedShardIds); err != nil {
+			return err
+		}
+		if err = sourceServerDeleteEcShards(ctx, commandEnv.option.GrpcDialOption, collection, vid, ecNode.info.Id, toBeDeletedShardIds); err != nil {
+			return err
+		}
+		for _, n := range allEcNodes {
+			if n.info.Id == ecNode.info.Id {
+				n.deleteEcVolumeShards(vid, toBeDeletedShardIds)
+			}
+		}
+	}
+
+	ecShardMap := make(EcShardMap)
+	for _, ecNode := range allEcNodes {
+		ecShardMap.registerEcNode(ecNode, collection)
+	}
+	locations, found := ecShardMap[vid]
+	if !found || locations.shardCount() < scheme.DataShards {
+		return fmt.Errorf("ec volume %d is unrepairable", vid)
+	}
+
+	return rebuildOneEcVolume(ctx, commandEnv, allEcNodes[0], collection, vid, scheme, locations, writer, true)
+}
+
+// survivingEcShards returns the shards of the ec volume which are not corrupted
+func survivingEcShards(ecNodes []*EcNode, vid needle.VolumeId, badShardIds []erasure_coding.ShardId) (shardBits erasure_coding.ShardBits) {
+	for _, ecNode := range ecNodes {
+		shardBits = shardBits.Plus(findEcVolumeShards(ecNode, vid))
+	}
+	for _, shardId := range badShardIds {
+		shardBits = shardBits.RemoveShardId(shardId)
+	}
+	return shardBits
+}
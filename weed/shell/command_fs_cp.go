@@ -0,0 +1,187 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsCp{})
+}
+
+type commandFsCp struct {
+}
+
+func (c *commandFsCp) Name() string {
+	return "fs.cp"
+}
+
+func (c *commandFsCp) Help() string {
+	return `copy a file or a folder
+
+	fs.cp [-r] <source entry> <destination entry>
+
+	fs.cp /dir/file_name /dir2/file_name2
+	fs.cp /dir/file_name /dir2/
+	fs.cp -r /dir/dir2 /dir3/new_dir
+
+	The deduplicated chunks are reused by the copy, since they are reference counted by the filer.
+	The other chunks are read and written again as new chunks, so the copies do not share the file content.
+
+`
+}
+
+type fsCopyStats struct {
+	dirCount, fileCount            int
+	reusedChunks, duplicatedChunks int
+}
+
+func (c *commandFsCp) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	cpCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	isRecursive := cpCommand.Bool("r", false, "copy the directories and everything under them")
+	if err = cpCommand.Parse(args); err != nil {
+		return nil
+	}
+	if cpCommand.NArg() != 2 {
+		return fmt.Errorf("need the source and the destination")
+	}
+
+	filerServer, filerPort, sourcePath, err := commandEnv.parseUrl(cpCommand.Arg(0))
+	if err != nil {
+		return err
+	}
+	_, _, destinationPath, err := commandEnv.parseUrl(cpCommand.Arg(1))
+	if err != nil {
+		return err
+	}
+	if sourcePath != "/" {
+		sourcePath = strings.TrimSuffix(sourcePath, "/")
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		sourceEntry, err := lookupEntry(ctx, client, sourcePath)
+		if err != nil {
+			return err
+		}
+		if sourceEntry.IsDirectory && !*isRecursive {
+			return fmt.Errorf("%s is a directory, use -r to copy it", sourcePath)
+		}
+
+		option, err := newFilerUploadOption(ctx, client, "", "", 0)
+		if err != nil {
+			return err
+		}
+
+		_, sourceName := filer2.FullPath(sourcePath).DirAndName()
+		targetDir, targetName := resolveDestination(ctx, client, destinationPath, sourceName)
+		targetPath := filer2.NewFullPath(targetDir, targetName)
+		if sourceEntry.IsDirectory && strings.HasPrefix(string(targetPath)+"/", strings.TrimSuffix(sourcePath, "/")+"/") {
+			return fmt.Errorf("can not copy %s into itself", sourcePath)
+		}
+
+		stats := &fsCopyStats{}
+		if err = copyEntry(ctx, commandEnv, client, option, sourceEntry, targetDir, targetName, stats); err != nil {
+			return err
+		}
+
+		if sourceEntry.IsDirectory {
+			err = doTraverse(ctx, writer, client, filer2.FullPath(sourcePath), func(parentPath filer2.FullPath, entry *filer_pb.Entry) error {
+				dir := string(targetPath) + strings.TrimPrefix(string(parentPath), sourcePath)
+				return copyEntry(ctx, commandEnv, client, option, entry, dir, entry.Name, stats)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintf(writer, "copy: %s => %s, %d directories, %d files, %d chunks reused, %d chunks duplicated\n",
+			sourcePath, targetPath, stats.dirCount, stats.fileCount, stats.reusedChunks, stats.duplicatedChunks)
+
+		return nil
+	})
+
+}
+
+func copyEntry(ctx context.Context, commandEnv *CommandEnv, client filer_pb.SeaweedFilerClient, option *filerUploadOption,
+	entry *filer_pb.Entry, targetDir, targetName string, stats *fsCopyStats) error {
+
+	newEntry := proto.Clone(entry).(*filer_pb.Entry)
+	newEntry.Name = targetName
+	if newEntry.Attributes != nil {
+		newEntry.Attributes.Crtime = time.Now().Unix()
+	}
+
+	if entry.IsDirectory {
+		stats.dirCount++
+	} else {
+		stats.fileCount++
+		entryOption := *option
+		if entry.Attributes != nil && entry.Attributes.Collection != "" {
+			entryOption.collection = entry.Attributes.Collection
+		}
+		if entry.Attributes != nil && entry.Attributes.Replication != "" {
+			entryOption.replication = entry.Attributes.Replication
+		}
+		for i, chunk := range newEntry.Chunks {
+			if chunk.ContentHash != "" {
+				stats.reusedChunks++
+				continue
+			}
+			newChunk, err := duplicateChunk(ctx, commandEnv, client, &entryOption, chunk)
+			if err != nil {
+				return fmt.Errorf("copy chunk %s of %s: %v", chunk.GetFileIdString(), filer2.NewFullPath(targetDir, targetName), err)
+			}
+			newEntry.Chunks[i] = newChunk
+			stats.duplicatedChunks++
+		}
+	}
+
+	if _, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+		Directory: targetDir,
+		Entry:     newEntry,
+	}); err != nil {
+		return fmt.Errorf("create entry %s: %v", filer2.NewFullPath(targetDir, targetName), err)
+	}
+
+	return nil
+}
+
+// duplicateChunk writes the chunk content to a new needle, keeping the offset and mtime of the chunk
+func duplicateChunk(ctx context.Context, commandEnv *CommandEnv, client filer_pb.SeaweedFilerClient, option *filerUploadOption, chunk *filer_pb.FileChunk) (*filer_pb.FileChunk, error) {
+	fileUrl, err := commandEnv.MasterClient.LookupFileId(chunk.GetFileIdString())
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if _, err = util.ReadUrlAsStream(fileUrl, 0, int(chunk.Size), func(data []byte) {
+		buf.Write(data)
+	}); err != nil {
+		return nil, fmt.Errorf("read %s: %v", fileUrl, err)
+	}
+	if uint64(buf.Len()) != chunk.Size {
+		return nil, fmt.Errorf("read %s: %d bytes, expected %d", fileUrl, buf.Len(), chunk.Size)
+	}
+
+	newChunk, err := uploadChunk(ctx, client, option, buf.Bytes(), chunk.GetFileIdString())
+	if err != nil {
+		return nil, err
+	}
+	newChunk.Offset = chunk.Offset
+	newChunk.Mtime = chunk.Mtime
+	return newChunk, nil
+}
@@ -0,0 +1,209 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsFind{})
+}
+
+type commandFsFind struct {
+}
+
+func (c *commandFsFind) Name() string {
+	return "fs.find"
+}
+
+func (c *commandFsFind) Help() string {
+	return `find files and directories matching all the conditions
+
+	fs.find [-name=<pattern>] [-type=f|d] [-size=[+|-]<n>[k|M|G]] [-mtime=[+|-]<days>] [/dir]
+
+	fs.find -name=*.jpg /dir        # the file names matching the pattern
+	fs.find -type=d                 # the directories under the current directory
+	fs.find -size=+100M /dir        # the files larger than 100MB
+	fs.find -size=-1k /dir          # the files smaller than 1KB
+	fs.find -mtime=+30 /dir         # the entries modified more than 30 days ago
+	fs.find -mtime=-1 /dir          # the entries modified in the last day
+
+	Like the unix find, the days are rounded down, so "-mtime=1" matches the entries modified between 1 and 2 days ago.
+
+`
+}
+
+func (c *commandFsFind) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	return c.find(args, commandEnv, writer, func(path filer2.FullPath, entry *filer_pb.Entry) {
+		fmt.Fprintf(writer, "%s\n", path)
+	})
+
+}
+
+// DoStructured lists the matched entries with their path, name, type, size and modified time
+func (c *commandFsFind) DoStructured(args []string, commandEnv *CommandEnv) (records []Record, err error) {
+
+	err = c.find(args, commandEnv, ioutil.Discard, func(path filer2.FullPath, entry *filer_pb.Entry) {
+		var mtime int64
+		if entry.Attributes != nil {
+			mtime = entry.Attributes.Mtime
+		}
+		records = append(records, Record{
+			"path":        string(path),
+			"name":        entry.Name,
+			"isDirectory": entry.IsDirectory,
+			"size":        filer2.TotalSize(entry.Chunks),
+			"mtime":       mtime,
+		})
+	})
+
+	return records, err
+}
+
+func (c *commandFsFind) find(args []string, commandEnv *CommandEnv, writer io.Writer, fn func(path filer2.FullPath, entry *filer_pb.Entry)) (err error) {
+
+	findCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := findCommand.String("name", "", "the file name pattern, with the syntax of path/filepath.Match")
+	entryType := findCommand.String("type", "", "f for files, d for directories")
+	size := findCommand.String("size", "", "the file size, +n for larger, -n for smaller, with an optional k, M or G unit")
+	mtime := findCommand.String("mtime", "", "the modified days ago, +n for older, -n for newer")
+	if err = findCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	filter, err := newFindFilter(*name, *entryType, *size, *mtime)
+	if err != nil {
+		return err
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(findCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		now := time.Now()
+		return doTraverse(ctx, writer, client, filer2.FullPath(path), func(parentPath filer2.FullPath, entry *filer_pb.Entry) error {
+			if filter.match(entry, now) {
+				fn(parentPath.Child(entry.Name), entry)
+			}
+			return nil
+		})
+
+	})
+
+}
+
+// findComparison compares a value with a number, +n for greater than n, -n for less than n, n for equal to n
+type findComparison struct {
+	sign  byte
+	value int64
+}
+
+func parseFindComparison(s string, units map[string]int64) (*findComparison, error) {
+	if s == "" {
+		return nil, nil
+	}
+	c := &findComparison{}
+	if s[0] == '+' || s[0] == '-' {
+		c.sign, s = s[0], s[1:]
+	}
+	multiplier := int64(1)
+	for unit, m := range units {
+		if strings.HasSuffix(s, unit) {
+			s, multiplier = strings.TrimSuffix(s, unit), m
+			break
+		}
+	}
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || value < 0 {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	c.value = value * multiplier
+	return c, nil
+}
+
+func (c *findComparison) match(value int64) bool {
+	switch c.sign {
+	case '+':
+		return value > c.value
+	case '-':
+		return value < c.value
+	}
+	return value == c.value
+}
+
+type findFilter struct {
+	namePattern string
+	entryType   string
+	size        *findComparison
+	mtimeDays   *findComparison
+}
+
+func newFindFilter(namePattern, entryType, size, mtimeDays string) (filter *findFilter, err error) {
+	filter = &findFilter{
+		namePattern: namePattern,
+		entryType:   entryType,
+	}
+	if _, err = filepath.Match(namePattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid -name %q: %v", namePattern, err)
+	}
+	if entryType != "" && entryType != "f" && entryType != "d" {
+		return nil, fmt.Errorf("invalid -type %q, should be f or d", entryType)
+	}
+	if filter.size, err = parseFindComparison(size, map[string]int64{"k": 1024, "M": 1024 * 1024, "G": 1024 * 1024 * 1024}); err != nil {
+		return nil, fmt.Errorf("invalid -size: %v", err)
+	}
+	if filter.mtimeDays, err = parseFindComparison(mtimeDays, nil); err != nil {
+		return nil, fmt.Errorf("invalid -mtime: %v", err)
+	}
+	return filter, nil
+}
+
+func (filter *findFilter) match(entry *filer_pb.Entry, now time.Time) bool {
+	if filter.namePattern != "" {
+		if matched, _ := filepath.Match(filter.namePattern, entry.Name); !matched {
+			return false
+		}
+	}
+	switch filter.entryType {
+	case "f":
+		if entry.IsDirectory {
+			return false
+		}
+	case "d":
+		if !entry.IsDirectory {
+			return false
+		}
+	}
+	if filter.size != nil {
+		if entry.IsDirectory || !filter.size.match(int64(filer2.TotalSize(entry.Chunks))) {
+			return false
+		}
+	}
+	if filter.mtimeDays != nil {
+		var mtime int64
+		if entry.Attributes != nil {
+			mtime = entry.Attributes.Mtime
+		}
+		days := int64(now.Sub(time.Unix(mtime, 0)) / (24 * time.Hour))
+		if !filter.mtimeDays.match(days) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package shell
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestFindFilter(t *testing.T) {
+	now := time.Now()
+	file := &filer_pb.Entry{
+		Name:       "photo.jpg",
+		Chunks:     []*filer_pb.FileChunk{{Offset: 0, Size: 2048}},
+		Attributes: &filer_pb.FuseAttributes{Mtime: now.Add(-36 * time.Hour).Unix()},
+	}
+	dir := &filer_pb.Entry{
+		Name:        "photos",
+		IsDirectory: true,
+		Attributes:  &filer_pb.FuseAttributes{Mtime: now.Unix()},
+	}
+
+	tests := []struct {
+		name, entryType, size, mtime string
+		fileMatched, dirMatched      bool
+	}{
+		{fileMatched: true, dirMatched: true},
+		{name: "*.jpg", fileMatched: true},
+		{name: "photo*", fileMatched: true, dirMatched: true},
+		{entryType: "d", dirMatched: true},
+		{size: "2k", fileMatched: true},
+		{size: "+1k", fileMatched: true},
+		{size: "-2k"},
+		{mtime: "1", fileMatched: true},
+		{mtime: "-1", dirMatched: true},
+		{mtime: "+0", fileMatched: true},
+		{name: "*.jpg", mtime: "+1"},
+	}
+
+	for _, tt := range tests {
+		filter, err := newFindFilter(tt.name, tt.entryType, tt.size, tt.mtime)
+		if err != nil {
+			t.Fatalf("new filter %+v: %v", tt, err)
+		}
+		if matched := filter.match(file, now); matched != tt.fileMatched {
+			t.Errorf("filter %+v matches the file: %v", tt, matched)
+		}
+		if matched := filter.match(dir, now); matched != tt.dirMatched {
+			t.Errorf("filter %+v matches the directory: %v", tt, matched)
+		}
+	}
+
+	for _, size := range []string{"abc", "+", "10x", "--1"} {
+		if _, err := newFindFilter("", "", size, ""); err == nil {
+			t.Errorf("size %q should be invalid", size)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsGet{})
+}
+
+type commandFsGet struct {
+}
+
+func (c *commandFsGet) Name() string {
+	return "fs.get"
+}
+
+func (c *commandFsGet) Help() string {
+	return `download a file from the filer to a local file
+
+	fs.get <file> [<local destination>]
+
+	fs.get /dir/file_name                    # download to ./file_name
+	fs.get /dir/file_name /tmp/              # download to /tmp/file_name
+	fs.get /dir/file_name /tmp/file_name2    # download to /tmp/file_name2
+	fs.get http://<filer_server>:<port>/dir/file_name
+
+`
+}
+
+func (c *commandFsGet) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	if len(args) == 0 {
+		return fmt.Errorf("missing the file to download")
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, name := filer2.FullPath(path).DirAndName()
+	localPath := name
+	if len(args) > 1 {
+		localPath = args[1]
+		if fi, statErr := os.Stat(localPath); statErr == nil && fi.IsDir() {
+			localPath = filepath.Join(localPath, name)
+		}
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		entry, err := lookupEntry(ctx, client, path)
+		if err != nil {
+			return err
+		}
+		if entry.IsDirectory {
+			return fmt.Errorf("%s is a directory", path)
+		}
+
+		dst, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		defer dst.Close()
+
+		size := filer2.TotalSize(entry.Chunks)
+		if err = filer2.StreamContent(commandEnv.MasterClient, dst, entry.Chunks, 0, int(size)); err != nil {
+			return fmt.Errorf("download %s: %v", path, err)
+		}
+
+		fmt.Fprintf(writer, "downloaded %s => %s, %d bytes\n", path, localPath, size)
+
+		return nil
+	})
+
+}
@@ -0,0 +1,62 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsMetaCat{})
+}
+
+type commandFsMetaCat struct {
+}
+
+func (c *commandFsMetaCat) Name() string {
+	return "fs.meta.cat"
+}
+
+func (c *commandFsMetaCat) Help() string {
+	return `print the meta data of a file or directory
+
+	fs.meta.cat /dir/
+	fs.meta.cat /dir/file_name
+	fs.meta.cat http://<filer_server>:<port>/dir/file_name
+
+	The meta data is printed in the protobuf text format.
+
+`
+}
+
+func (c *commandFsMetaCat) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(args))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	dir, _ := filer2.FullPath(path).DirAndName()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		entry, err := lookupEntry(ctx, client, path)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprint(writer, proto.MarshalTextString(&filer_pb.FullEntry{
+			Dir:   dir,
+			Entry: entry,
+		}))
+
+		return nil
+	})
+
+}
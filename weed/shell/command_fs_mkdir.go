@@ -0,0 +1,84 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsMkdir{})
+}
+
+type commandFsMkdir struct {
+}
+
+func (c *commandFsMkdir) Name() string {
+	return "fs.mkdir"
+}
+
+func (c *commandFsMkdir) Help() string {
+	return `create a directory, and its parent directories if missing
+
+	fs.mkdir /dir/new_dir
+	fs.mkdir new_dir
+	fs.mkdir http://<filer_server>:<port>/dir/new_dir
+
+`
+}
+
+func (c *commandFsMkdir) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	if len(args) == 0 {
+		return fmt.Errorf("missing the directory to create")
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(args[len(args)-1])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	dir, name := filer2.FullPath(path).DirAndName()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		if entry, err := lookupEntry(ctx, client, path); err == nil {
+			if entry.IsDirectory {
+				return fmt.Errorf("%s already exists", path)
+			}
+			return fmt.Errorf("%s is a file", path)
+		}
+
+		now := time.Now().Unix()
+		_, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+			Directory: dir,
+			Entry: &filer_pb.Entry{
+				Name:        name,
+				IsDirectory: true,
+				Attributes: &filer_pb.FuseAttributes{
+					Mtime:    now,
+					Crtime:   now,
+					FileMode: uint32(os.ModeDir | 0770),
+					Uid:      filer2.OS_UID,
+					Gid:      filer2.OS_GID,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(writer, "created %s\n", path)
+
+		return nil
+
+	})
+
+}
@@ -0,0 +1,219 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsPut{})
+}
+
+type commandFsPut struct {
+}
+
+func (c *commandFsPut) Name() string {
+	return "fs.put"
+}
+
+func (c *commandFsPut) Help() string {
+	return `upload a local file to the filer
+
+	fs.put [-collection=""] [-replication=""] [-maxMB=0] <local file> [<destination>]
+
+	fs.put /tmp/file_name                  # upload to the current directory
+	fs.put /tmp/file_name /dir/            # upload to /dir/file_name
+	fs.put /tmp/file_name /dir/file_name2  # upload to /dir/file_name2
+
+	The collection, replication and chunk size default to the filer configuration.
+
+`
+}
+
+func (c *commandFsPut) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	putCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := putCommand.String("collection", "", "the collection, default to the filer collection")
+	replication := putCommand.String("replication", "", "the replication, default to the filer replication")
+	maxMB := putCommand.Int("maxMB", 0, "split the file into chunks of this size in MB, default to the filer setting")
+	if err = putCommand.Parse(args); err != nil {
+		return nil
+	}
+	if putCommand.NArg() == 0 {
+		return fmt.Errorf("missing the local file to upload")
+	}
+	localPath := putCommand.Arg(0)
+	destination := "."
+	if putCommand.NArg() > 1 {
+		destination = putCommand.Arg(1)
+	}
+
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		return fmt.Errorf("%s is a directory", localPath)
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(destination)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		option, err := newFilerUploadOption(ctx, client, *collection, *replication, *maxMB)
+		if err != nil {
+			return err
+		}
+
+		dir, name := resolveDestination(ctx, client, path, filepath.Base(localPath))
+
+		chunks, err := uploadContentInChunks(ctx, client, option, f, name)
+		if err != nil {
+			return fmt.Errorf("upload %s: %v", localPath, err)
+		}
+
+		_, err = client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+			Directory: dir,
+			Entry: &filer_pb.Entry{
+				Name: name,
+				Attributes: &filer_pb.FuseAttributes{
+					Crtime:      time.Now().Unix(),
+					Mtime:       fi.ModTime().Unix(),
+					FileSize:    uint64(fi.Size()),
+					FileMode:    uint32(fi.Mode()),
+					Uid:         filer2.OS_UID,
+					Gid:         filer2.OS_GID,
+					Mime:        mime.TypeByExtension(strings.ToLower(filepath.Ext(name))),
+					Replication: option.replication,
+					Collection:  option.collection,
+				},
+				Chunks: chunks,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("create entry %s: %v", filer2.NewFullPath(dir, name), err)
+		}
+
+		fmt.Fprintf(writer, "uploaded %s => %s, %d bytes in %d chunks\n", localPath, filer2.NewFullPath(dir, name), fi.Size(), len(chunks))
+
+		return nil
+	})
+
+}
+
+type filerUploadOption struct {
+	collection  string
+	replication string
+	chunkSize   int64
+}
+
+// newFilerUploadOption fills in the missing collection, replication and chunk size with the filer configuration
+func newFilerUploadOption(ctx context.Context, client filer_pb.SeaweedFilerClient, collection, replication string, maxMB int) (*filerUploadOption, error) {
+	resp, err := client.GetFilerConfiguration(ctx, &filer_pb.GetFilerConfigurationRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get filer configuration: %v", err)
+	}
+	option := &filerUploadOption{
+		collection:  collection,
+		replication: replication,
+		chunkSize:   int64(maxMB) * 1024 * 1024,
+	}
+	if option.collection == "" {
+		option.collection = resp.Collection
+	}
+	if option.replication == "" {
+		option.replication = resp.Replication
+	}
+	if option.chunkSize <= 0 {
+		option.chunkSize = int64(resp.MaxMb) * 1024 * 1024
+	}
+	if option.chunkSize <= 0 {
+		option.chunkSize = 32 * 1024 * 1024
+	}
+	return option, nil
+}
+
+// resolveDestination returns the directory and name to create, appending the source name if the destination is a directory
+func resolveDestination(ctx context.Context, client filer_pb.SeaweedFilerClient, path string, sourceName string) (dir, name string) {
+	if strings.HasSuffix(path, "/") && path != "/" {
+		return strings.TrimSuffix(path, "/"), sourceName
+	}
+	if entry, err := lookupEntry(ctx, client, path); err == nil && entry.IsDirectory {
+		return path, sourceName
+	}
+	return filer2.FullPath(path).DirAndName()
+}
+
+// uploadContentInChunks reads the content to the end, and uploads each chunk to a volume assigned by the filer
+func uploadContentInChunks(ctx context.Context, client filer_pb.SeaweedFilerClient, option *filerUploadOption, reader io.Reader, name string) (chunks []*filer_pb.FileChunk, err error) {
+	buf := make([]byte, option.chunkSize)
+	var offset int64
+	for {
+		n, readErr := io.ReadFull(reader, buf)
+		if n > 0 {
+			chunk, err := uploadChunk(ctx, client, option, buf[:n], fmt.Sprintf("%s-%d", name, len(chunks)+1))
+			if err != nil {
+				return nil, err
+			}
+			chunk.Offset = offset
+			chunks = append(chunks, chunk)
+			offset += int64(n)
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			return chunks, nil
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+}
+
+func uploadChunk(ctx context.Context, client filer_pb.SeaweedFilerClient, option *filerUploadOption, data []byte, name string) (*filer_pb.FileChunk, error) {
+	assignResult, err := client.AssignVolume(ctx, &filer_pb.AssignVolumeRequest{
+		Count:       1,
+		Collection:  option.collection,
+		Replication: option.replication,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assign volume: %v", err)
+	}
+
+	targetUrl := "http://" + assignResult.Url + "/" + assignResult.FileId
+	uploadResult, err := operation.Upload(targetUrl, name, bytes.NewReader(data), false, "application/octet-stream", nil, security.EncodedJwt(assignResult.Auth))
+	if err != nil {
+		return nil, fmt.Errorf("upload data to %s: %v", targetUrl, err)
+	}
+	if uploadResult.Error != "" {
+		return nil, fmt.Errorf("upload data to %s: %v", targetUrl, uploadResult.Error)
+	}
+
+	return &filer_pb.FileChunk{
+		FileId: assignResult.FileId,
+		Size:   uint64(len(data)),
+		Mtime:  time.Now().UnixNano(),
+		ETag:   uploadResult.ETag,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsRm{})
+}
+
+type commandFsRm struct {
+}
+
+func (c *commandFsRm) Name() string {
+	return "fs.rm"
+}
+
+func (c *commandFsRm) Help() string {
+	return `remove files and directories, and delete their file content
+
+	fs.rm /dir/file_name
+	fs.rm /dir/file_name1 /dir/file_name2
+	fs.rm -r /dir/dir2      # remove the directory and everything under it
+	fs.rm http://<filer_server>:<port>/dir/file_name
+
+	Without -r, only files and empty directories are removed.
+
+`
+}
+
+func (c *commandFsRm) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	rmCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	isRecursive := rmCommand.Bool("r", false, "remove the directories and everything under them")
+	if err = rmCommand.Parse(args); err != nil {
+		return nil
+	}
+	if rmCommand.NArg() == 0 {
+		return fmt.Errorf("missing the entries to remove")
+	}
+
+	ctx := context.Background()
+
+	for _, input := range rmCommand.Args() {
+
+		filerServer, filerPort, path, err := commandEnv.parseUrl(input)
+		if err != nil {
+			return err
+		}
+		if path == "/" {
+			return fmt.Errorf("can not remove the root directory")
+		}
+
+		dir, name := filer2.FullPath(path).DirAndName()
+
+		err = commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+			entry, err := lookupEntry(ctx, client, path)
+			if err != nil {
+				return err
+			}
+			if entry.IsDirectory && !*isRecursive {
+				if err = checkEmptyDirectory(ctx, client, path); err != nil {
+					return err
+				}
+			}
+
+			_, err = client.DeleteEntry(ctx, &filer_pb.DeleteEntryRequest{
+				Directory:    dir,
+				Name:         name,
+				IsDeleteData: true,
+				IsRecursive:  *isRecursive,
+			})
+			return err
+
+		})
+		if err != nil {
+			return fmt.Errorf("remove %s: %v", path, err)
+		}
+
+		fmt.Fprintf(writer, "removed %s\n", path)
+	}
+
+	return nil
+
+}
+
+func checkEmptyDirectory(ctx context.Context, client filer_pb.SeaweedFilerClient, path string) error {
+	resp, err := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+		Directory: path,
+		Limit:     1,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Entries) > 0 {
+		return fmt.Errorf("%s is a directory not empty, use -r to remove it", path)
+	}
+	return nil
+}
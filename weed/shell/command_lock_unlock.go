@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"io"
+)
+
+func init() {
+	Commands = append(Commands, &commandLock{})
+	Commands = append(Commands, &commandUnlock{})
+}
+
+type commandLock struct {
+}
+
+func (c *commandLock) Name() string {
+	return "lock"
+}
+
+func (c *commandLock) Help() string {
+	return `hold the admin lock to run several commands changing the cluster
+
+	lock
+
+	The commands changing the volumes or ec shards, e.g., volume.balance and ec.encode,
+	take the admin lock on the master while running, and fail if another shell holds it.
+	The maintenance scripts on the master wait for the lock.
+	"lock" keeps the lock until "unlock" or the shell exits, so others can not run in between.
+
+`
+}
+
+func (c *commandLock) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	if commandEnv.AdminLock.IsLocked() {
+		return nil
+	}
+
+	return commandEnv.AdminLock.Acquire(commandEnv)
+}
+
+type commandUnlock struct {
+}
+
+func (c *commandUnlock) Name() string {
+	return "unlock"
+}
+
+func (c *commandUnlock) Help() string {
+	return `release the admin lock
+
+	unlock
+
+`
+}
+
+func (c *commandUnlock) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	commandEnv.AdminLock.Release(commandEnv)
+
+	return nil
+}
@@ -0,0 +1,343 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeCheckDisk{})
+}
+
+type commandVolumeCheckDisk struct {
+}
+
+func (c *commandVolumeCheckDisk) Name() string {
+	return "volume.check.disk"
+}
+
+func (c *commandVolumeCheckDisk) Help() string {
+	return `compare the replicas of each volume and fix the differences
+
+	volume.check.disk [-collection=""] [-volumeId=<volume_id>] [-v] [-n]
+
+	This command will:
+	1. read the .idx file of each replica of the replicated volumes
+	2. for a needle deleted on some replicas, delete it on the replicas still having it
+	3. for a needle missing on some replicas, copy it over from a replica having it
+	4. for a needle with different sizes on the replicas, copy the latest written one to the other replicas
+	5. report the fixed needles per volume
+
+	With -n, only report the differences without fixing them.
+
+	Note:
+		* a needle deleted and then compacted away on one replica looks missing there. The needles are not
+		  copied to a replica compacted more times than the source replica, so the deleted files do not come back.
+		* the files being written while the command runs can show up as differences, which are harmless to fix
+
+`
+}
+
+// replicaNeedleCopy copies a needle from the source replica to the target replicas, by the index of the replica.
+// When the live needle has different sizes on the replicas, the source is the candidate with the latest written needle.
+type replicaNeedleCopy struct {
+	key        types.NeedleId
+	size       uint32
+	source     int
+	targets    []int
+	candidates []int
+}
+
+func (c *commandVolumeCheckDisk) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	checkCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := checkCommand.String("collection", "", "only check the volumes of this collection")
+	volumeId := checkCommand.Int("volumeId", 0, "only check this volume")
+	verbose := checkCommand.Bool("v", false, "list each needle to fix")
+	dryRun := checkCommand.Bool("n", false, "only report the differences, without fixing them")
+	if err = checkCommand.Parse(args); err != nil {
+		return nil
+	}
+	isCollectionSet := false
+	checkCommand.Visit(func(f *flag.Flag) {
+		if f.Name == "collection" {
+			isCollectionSet = true
+		}
+	})
+
+	ctx := context.Background()
+
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+
+	volumeLocations := make(map[uint32][]string)
+	volumeRevisions := make(map[uint32][]uint32)
+	volumeInfos := make(map[uint32]*master_pb.VolumeInformationMessage)
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, v := range dn.VolumeInfos {
+			if isCollectionSet && v.Collection != *collection {
+				continue
+			}
+			if *volumeId != 0 && v.Id != uint32(*volumeId) {
+				continue
+			}
+			volumeLocations[v.Id] = append(volumeLocations[v.Id], dn.Id)
+			volumeRevisions[v.Id] = append(volumeRevisions[v.Id], v.CompactRevision)
+			volumeInfos[v.Id] = v
+		}
+	})
+
+	var vids []uint32
+	for vid, locations := range volumeLocations {
+		if len(locations) > 1 {
+			vids = append(vids, vid)
+		}
+	}
+	sort.Slice(vids, func(i, j int) bool {
+		return vids[i] < vids[j]
+	})
+
+	var totalCopyCount, totalDeleteCount, totalFixedCount uint64
+	for _, vid := range vids {
+		locations := volumeLocations[vid]
+		copies, deletes, err := checkVolumeReplicas(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(vid), volumeInfos[vid].Collection, locations, volumeRevisions[vid])
+		if err != nil {
+			return err
+		}
+		deleteCount := 0
+		for _, keys := range deletes {
+			deleteCount += len(keys)
+		}
+		if len(copies) == 0 && deleteCount == 0 {
+			continue
+		}
+		totalCopyCount += uint64(len(copies))
+		totalDeleteCount += uint64(deleteCount)
+		fmt.Fprintf(writer, "volume %d on %v: %d needles to copy, %d needles to delete\n", vid, locations, len(copies), deleteCount)
+
+		if *verbose {
+			for _, cp := range copies {
+				if len(cp.candidates) > 1 {
+					fmt.Fprintf(writer, "  copy %s of different sizes on %v, the latest one to the others and %v\n",
+						needle.NewFileId(needle.VolumeId(vid), uint64(cp.key), 0), replicaLocations(locations, cp.candidates), replicaLocations(locations, cp.targets))
+					continue
+				}
+				fmt.Fprintf(writer, "  copy %s size %d from %s to %v\n",
+					needle.NewFileId(needle.VolumeId(vid), uint64(cp.key), 0), cp.size, locations[cp.source], replicaLocations(locations, cp.targets))
+			}
+			for i, keys := range deletes {
+				for _, key := range keys {
+					fmt.Fprintf(writer, "  delete %s on %s\n", needle.NewFileId(needle.VolumeId(vid), uint64(key), 0), locations[i])
+				}
+			}
+		}
+		if *dryRun {
+			continue
+		}
+		if err = commandEnv.confirmIsLocked(); err != nil {
+			return err
+		}
+
+		for _, cp := range copies {
+			if err = copyReplicaNeedle(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(vid), locations, cp); err != nil {
+				fmt.Fprintf(writer, "copy needle %s: %v\n", needle.NewFileId(needle.VolumeId(vid), uint64(cp.key), 0), err)
+				continue
+			}
+			totalFixedCount++
+		}
+		for i, keys := range deletes {
+			deleted, err := deleteVolumeNeedles(ctx, commandEnv.option.GrpcDialOption, locations[i], needle.VolumeId(vid), keys, writer)
+			if err != nil {
+				return fmt.Errorf("volume %d: %v", vid, err)
+			}
+			totalFixedCount += deleted
+		}
+	}
+
+	fmt.Fprintf(writer, "checked %d replicated volumes: %d needles to copy, %d needles to delete\n", len(vids), totalCopyCount, totalDeleteCount)
+	if *dryRun {
+		if totalCopyCount+totalDeleteCount > 0 {
+			fmt.Fprintf(writer, "run \"volume.check.disk\" without -n to fix them\n")
+		}
+	} else {
+		fmt.Fprintf(writer, "fixed %d needles\n", totalFixedCount)
+	}
+
+	return nil
+}
+
+func checkVolumeReplicas(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, locations []string, revisions []uint32) (copies []replicaNeedleCopy, deletes [][]types.NeedleId, err error) {
+	var indexes []map[types.NeedleId]uint32
+	for _, location := range locations {
+		index, err := readVolumeIndex(ctx, grpcDialOption, location, vid, collection, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		indexes = append(indexes, index)
+	}
+	copies, deletes = compareReplicaIndexes(indexes, revisions)
+	return
+}
+
+// compareReplicaIndexes finds the needles to delete on each replica, and the needles to copy between the replicas.
+// A needle deleted on any replica is deleted on all replicas. A needle missing on some replicas is copied
+// from the replica having it with the latest compaction revision, but not to the replicas with a later compaction revision,
+// which may have compacted the deleted needle away. A live needle with different sizes is copied from the latest written one.
+func compareReplicaIndexes(indexes []map[types.NeedleId]uint32, revisions []uint32) (copies []replicaNeedleCopy, deletes [][]types.NeedleId) {
+	deletes = make([][]types.NeedleId, len(indexes))
+
+	keys := make(map[types.NeedleId]bool)
+	for _, index := range indexes {
+		for key := range index {
+			keys[key] = true
+		}
+	}
+	sortedKeys := make([]types.NeedleId, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return sortedKeys[i] < sortedKeys[j]
+	})
+
+	for _, key := range sortedKeys {
+		var live, missing []int
+		isDeleted := false
+		for i, index := range indexes {
+			size, found := index[key]
+			switch {
+			case !found:
+				missing = append(missing, i)
+			case size == types.TombstoneFileSize:
+				isDeleted = true
+			default:
+				live = append(live, i)
+			}
+		}
+		if isDeleted {
+			for _, i := range live {
+				deletes[i] = append(deletes[i], key)
+			}
+			continue
+		}
+
+		source := live[0]
+		isConflicting := false
+		for _, i := range live[1:] {
+			if indexes[i][key] != indexes[source][key] {
+				isConflicting = true
+			}
+			if revisions[i] > revisions[source] {
+				source = i
+			}
+		}
+		var targets []int
+		for _, i := range missing {
+			if revisions[i] <= revisions[source] {
+				targets = append(targets, i)
+			}
+		}
+		if !isConflicting && len(targets) == 0 {
+			continue
+		}
+		cp := replicaNeedleCopy{
+			key:     key,
+			size:    indexes[source][key],
+			source:  source,
+			targets: targets,
+		}
+		if isConflicting {
+			cp.candidates = live
+		}
+		copies = append(copies, cp)
+	}
+	return
+}
+
+func copyReplicaNeedle(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, locations []string, cp replicaNeedleCopy) error {
+	var blob *volume_server_pb.ReadNeedleBlobResponse
+	var err error
+	targets := cp.targets
+	if len(cp.candidates) > 1 {
+		var source int
+		if source, blob, err = readLatestNeedleBlob(ctx, grpcDialOption, vid, locations, cp); err != nil {
+			return err
+		}
+		for _, i := range cp.candidates {
+			if i != source {
+				targets = append(targets, i)
+			}
+		}
+	} else if blob, err = readNeedleBlob(ctx, grpcDialOption, vid, locations[cp.source], cp.key); err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		err = operation.WithVolumeServerClient(locations[target], grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			_, writeErr := client.WriteNeedleBlob(ctx, &volume_server_pb.WriteNeedleBlobRequest{
+				VolumeId:   uint32(vid),
+				NeedleId:   uint64(cp.key),
+				NeedleBlob: blob.NeedleBlob,
+				Version:    blob.Version,
+			})
+			return writeErr
+		})
+		if err != nil {
+			return fmt.Errorf("write to %s: %v", locations[target], err)
+		}
+	}
+	return nil
+}
+
+// readLatestNeedleBlob reads the needle from each candidate replica, and returns the one with the latest AppendAtNs
+func readLatestNeedleBlob(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, locations []string, cp replicaNeedleCopy) (source int, latest *volume_server_pb.ReadNeedleBlobResponse, err error) {
+	var latestAppendAtNs uint64
+	for _, i := range cp.candidates {
+		blob, err := readNeedleBlob(ctx, grpcDialOption, vid, locations[i], cp.key)
+		if err != nil {
+			return 0, nil, err
+		}
+		n := new(needle.Needle)
+		n.ParseNeedleHeader(blob.NeedleBlob)
+		if err = n.ReadBytes(blob.NeedleBlob, 0, n.Size, needle.Version(blob.Version)); err != nil {
+			return 0, nil, fmt.Errorf("parse needle from %s: %v", locations[i], err)
+		}
+		if latest == nil || n.AppendAtNs > latestAppendAtNs {
+			source, latest, latestAppendAtNs = i, blob, n.AppendAtNs
+		}
+	}
+	return source, latest, nil
+}
+
+func readNeedleBlob(ctx context.Context, grpcDialOption grpc.DialOption, vid needle.VolumeId, location string, key types.NeedleId) (blob *volume_server_pb.ReadNeedleBlobResponse, err error) {
+	err = operation.WithVolumeServerClient(location, grpcDialOption, func(client volume_server_pb.VolumeServerClient) (readErr error) {
+		blob, readErr = client.ReadNeedleBlob(ctx, &volume_server_pb.ReadNeedleBlobRequest{
+			VolumeId: uint32(vid),
+			NeedleId: uint64(key),
+		})
+		return readErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read from %s: %v", location, err)
+	}
+	return blob, nil
+}
+
+func replicaLocations(locations []string, indexes []int) (selected []string) {
+	for _, i := range indexes {
+		selected = append(selected, locations[i])
+	}
+	return
+}
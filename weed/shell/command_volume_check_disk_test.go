@@ -0,0 +1,59 @@
+package shell
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestCompareReplicaIndexes(t *testing.T) {
+	indexes := []map[types.NeedleId]uint32{
+		{1: 100, 2: 200, 3: 300, 4: types.TombstoneFileSize},
+		{1: 100, 2: types.TombstoneFileSize, 4: types.TombstoneFileSize},
+		{1: 100, 2: 200, 5: 500},
+	}
+
+	copies, deletes := compareReplicaIndexes(indexes, []uint32{0, 0, 0})
+
+	expectedCopies := []replicaNeedleCopy{
+		{key: 3, size: 300, source: 0, targets: []int{1, 2}},
+		{key: 5, size: 500, source: 2, targets: []int{0, 1}},
+	}
+	if !reflect.DeepEqual(copies, expectedCopies) {
+		t.Errorf("copies %+v, expected %+v", copies, expectedCopies)
+	}
+
+	// the needle 2 deleted on the second replica is deleted on the others, the needle 4 is deleted or missing everywhere
+	expectedDeletes := [][]types.NeedleId{{2}, nil, {2}}
+	if !reflect.DeepEqual(deletes, expectedDeletes) {
+		t.Errorf("deletes %v, expected %v", deletes, expectedDeletes)
+	}
+}
+
+func TestCompareReplicaIndexesWithDifferentNeedles(t *testing.T) {
+	indexes := []map[types.NeedleId]uint32{
+		{1: 100, 2: 200},
+		{1: 150},
+		{1: 100, 2: 200},
+	}
+
+	// the second replica is compacted more times, the needle 2 may be deleted and compacted away there
+	copies, deletes := compareReplicaIndexes(indexes, []uint32{1, 2, 1})
+
+	expectedCopies := []replicaNeedleCopy{
+		{key: 1, size: 150, source: 1, candidates: []int{0, 1, 2}},
+	}
+	if !reflect.DeepEqual(copies, expectedCopies) {
+		t.Errorf("copies %+v, expected %+v", copies, expectedCopies)
+	}
+	if !reflect.DeepEqual(deletes, [][]types.NeedleId{nil, nil, nil}) {
+		t.Errorf("deletes %v, expected none", deletes)
+	}
+
+	// with the same compaction revisions, the missing needle is copied
+	copies, _ = compareReplicaIndexes(indexes, []uint32{1, 1, 1})
+	if len(copies) != 2 || copies[1].key != 2 || !reflect.DeepEqual(copies[1].targets, []int{1}) {
+		t.Errorf("copies %+v, expected needle 2 copied to the second replica", copies)
+	}
+}
@@ -111,6 +111,9 @@ func (c *commandVolumeFixReplication) Do(args []string, commandEnv *CommandEnv,
 				if !takeAction {
 					break
 				}
+				if err := commandEnv.confirmIsLocked(); err != nil {
+					return err
+				}
 
 				err := operation.WithVolumeServerClient(dst.dataNode.Id, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
 					_, replicateErr := volumeServerClient.VolumeCopy(ctx, &volume_server_pb.VolumeCopyRequest{
@@ -0,0 +1,389 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/idx"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeFsck{})
+}
+
+type commandVolumeFsck struct {
+}
+
+func (c *commandVolumeFsck) Name() string {
+	return "volume.fsck"
+}
+
+func (c *commandVolumeFsck) Help() string {
+	return `check the volumes against the file chunks referenced by the filer
+
+	volume.fsck [-collection=""] [-v] [-purge] [-cutoffTimeAgo=5m]
+
+	This command will:
+	1. read the needle ids in the .idx file of each volume, or the .ecx file of each ec volume, from one volume server
+	2. collect the file ids of all file chunks in the filer
+	3. report the orphan needles, which are in the volumes but not referenced by the filer, with counts and sizes per volume
+	4. report the dangling filer references, whose needles or volumes are missing
+	5. with -purge, delete the orphan needles from all the replicas. The ec volumes are only reported.
+
+	The needles written within -cutoffTimeAgo are not counted as orphans, since their filer entries may not be created yet.
+	Without -collection, all volumes are checked, so the files not written through the filer also show up as orphans.
+	For this reason, -purge requires -collection.
+
+`
+}
+
+const needleDeleteBatchSize = 1000
+
+type fsckVolume struct {
+	vid        needle.VolumeId
+	collection string
+	isEcVolume bool
+	locations  []string
+	needles    map[types.NeedleId]uint32
+}
+
+type fsckReference struct {
+	path   string
+	fileId string
+}
+
+func (c *commandVolumeFsck) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	fsckCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := fsckCommand.String("collection", "", "only check the volumes of this collection")
+	verbose := fsckCommand.Bool("v", false, "list each orphan needle and dangling reference")
+	applyPurge := fsckCommand.Bool("purge", false, "delete the orphan needles from the volumes of the collection")
+	cutoffTimeAgo := fsckCommand.Duration("cutoffTimeAgo", 5*time.Minute, "the needles written after this long ago are not orphans")
+	if err = fsckCommand.Parse(args); err != nil {
+		return nil
+	}
+	isCollectionSet := false
+	fsckCommand.Visit(func(f *flag.Flag) {
+		if f.Name == "collection" {
+			isCollectionSet = true
+		}
+	})
+	if *applyPurge && !isCollectionSet {
+		return fmt.Errorf("-purge requires -collection, the volumes may have files not written through the filer")
+	}
+	cutoffNs := uint64(time.Now().Add(-*cutoffTimeAgo).UnixNano())
+
+	ctx := context.Background()
+
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+	allVolumes := collectFsckVolumes(topologyInfo)
+
+	var volumes []*fsckVolume
+	for _, vol := range allVolumes {
+		if isCollectionSet && vol.collection != *collection {
+			continue
+		}
+		volumes = append(volumes, vol)
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].vid < volumes[j].vid
+	})
+
+	// read the volume indexes before the filer, so the files created during the check are not seen as orphans
+	for _, vol := range volumes {
+		if err = readFsckVolumeNeedles(ctx, commandEnv.option.GrpcDialOption, vol); err != nil {
+			return err
+		}
+	}
+
+	filerServer, filerPort, _, err := commandEnv.parseUrl("/")
+	if err != nil {
+		return err
+	}
+	referenced := make(map[needle.VolumeId]map[types.NeedleId]bool)
+	var dangling []fsckReference
+	var referenceCount uint64
+	err = commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+		return doTraverse(ctx, writer, client, filer2.FullPath("/"), func(parentPath filer2.FullPath, entry *filer_pb.Entry) error {
+			if entry.IsDirectory {
+				return nil
+			}
+			for _, chunk := range entry.Chunks {
+				fileId := chunk.GetFileIdString()
+				fid, err := needle.ParseFileIdFromString(fileId)
+				if err != nil {
+					return fmt.Errorf("parse file id of %s: %v", parentPath.Child(entry.Name), err)
+				}
+				referenceCount++
+				ref := fsckReference{path: string(parentPath.Child(entry.Name)), fileId: fileId}
+				vol, found := allVolumes[fid.VolumeId]
+				if !found {
+					dangling = append(dangling, ref)
+					continue
+				}
+				if vol.needles == nil {
+					// not checked with -collection
+					continue
+				}
+				if _, found := vol.needles[fid.Key]; !found {
+					dangling = append(dangling, ref)
+					continue
+				}
+				if referenced[fid.VolumeId] == nil {
+					referenced[fid.VolumeId] = make(map[types.NeedleId]bool)
+				}
+				referenced[fid.VolumeId][fid.Key] = true
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("traverse filer: %v", err)
+	}
+
+	var totalOrphanCount, totalOrphanSize, totalPurgedCount uint64
+	for _, vol := range volumes {
+		orphans, _ := findOrphanNeedles(vol.needles, referenced[vol.vid], nil)
+		if len(orphans) == 0 {
+			continue
+		}
+		var recent map[types.NeedleId]bool
+		if !vol.isEcVolume {
+			if recent, err = readRecentNeedles(ctx, commandEnv.option.GrpcDialOption, vol, cutoffNs); err != nil {
+				return err
+			}
+		}
+		orphans, orphanSize := findOrphanNeedles(vol.needles, referenced[vol.vid], recent)
+		if len(orphans) == 0 {
+			continue
+		}
+		totalOrphanCount += uint64(len(orphans))
+		totalOrphanSize += orphanSize
+		volumeType := "volume"
+		if vol.isEcVolume {
+			volumeType = "ec volume"
+		}
+		fmt.Fprintf(writer, "%s %d collection:%q: %d of %d needles are orphans, %d bytes\n",
+			volumeType, vol.vid, vol.collection, len(orphans), len(vol.needles), orphanSize)
+		if *verbose {
+			for _, key := range orphans {
+				fmt.Fprintf(writer, "  orphan %s size %d\n", needle.NewFileId(vol.vid, uint64(key), 0), vol.needles[key])
+			}
+		}
+		if !*applyPurge || vol.isEcVolume {
+			continue
+		}
+		if err = commandEnv.confirmIsLocked(); err != nil {
+			return err
+		}
+		purged, err := purgeOrphanNeedles(ctx, commandEnv.option.GrpcDialOption, vol, orphans, writer)
+		if err != nil {
+			return fmt.Errorf("purge volume %d: %v", vol.vid, err)
+		}
+		totalPurgedCount += purged
+	}
+
+	if *verbose {
+		for _, ref := range dangling {
+			fmt.Fprintf(writer, "dangling %s in %s\n", ref.fileId, ref.path)
+		}
+	}
+
+	fmt.Fprintf(writer, "checked %d volumes and %d filer references\n", len(volumes), referenceCount)
+	fmt.Fprintf(writer, "total %d orphan needles, %d bytes\n", totalOrphanCount, totalOrphanSize)
+	fmt.Fprintf(writer, "total %d dangling filer references\n", len(dangling))
+	if *applyPurge {
+		fmt.Fprintf(writer, "purged %d orphan needles\n", totalPurgedCount)
+	} else if totalOrphanCount > 0 {
+		fmt.Fprintf(writer, "run \"volume.fsck -purge\" to delete the orphan needles\n")
+	}
+
+	return nil
+}
+
+func collectFsckVolumes(topoInfo *master_pb.TopologyInfo) map[needle.VolumeId]*fsckVolume {
+	volumes := make(map[needle.VolumeId]*fsckVolume)
+	addLocation := func(vid needle.VolumeId, collection string, isEcVolume bool, location string) {
+		vol, found := volumes[vid]
+		if !found {
+			vol = &fsckVolume{vid: vid, collection: collection, isEcVolume: isEcVolume}
+			volumes[vid] = vol
+		}
+		vol.locations = append(vol.locations, location)
+	}
+	eachDataNode(topoInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, v := range dn.VolumeInfos {
+			addLocation(needle.VolumeId(v.Id), v.Collection, false, dn.Id)
+		}
+		for _, v := range dn.EcShardInfos {
+			addLocation(needle.VolumeId(v.Id), v.Collection, true, dn.Id)
+		}
+	})
+	return volumes
+}
+
+// readFsckVolumeNeedles reads the live needle ids and sizes from the index file, trying each replica until one succeeds
+func readFsckVolumeNeedles(ctx context.Context, grpcDialOption grpc.DialOption, vol *fsckVolume) (err error) {
+	for _, location := range vol.locations {
+		if vol.needles, err = readVolumeIndex(ctx, grpcDialOption, location, vol.vid, vol.collection, vol.isEcVolume); err == nil {
+			for key, size := range vol.needles {
+				if size == types.TombstoneFileSize {
+					delete(vol.needles, key)
+				}
+			}
+			return nil
+		}
+	}
+	vol.needles = nil
+	return fmt.Errorf("read index of volume %d from %v: %v", vol.vid, vol.locations, err)
+}
+
+// readVolumeIndex streams the .idx file of the volume, or the .ecx file of the ec volume, from the volume server.
+// The deleted needles have the size of TombstoneFileSize.
+func readVolumeIndex(ctx context.Context, grpcDialOption grpc.DialOption, location string, vid needle.VolumeId, collection string, isEcVolume bool) (needles map[types.NeedleId]uint32, err error) {
+	ext := ".idx"
+	if isEcVolume {
+		ext = ".ecx"
+	}
+	needles = make(map[types.NeedleId]uint32)
+	err = operation.WithVolumeServerClient(location, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		stream, err := client.CopyFile(ctx, &volume_server_pb.CopyFileRequest{
+			VolumeId:           uint32(vid),
+			Ext:                ext,
+			CompactionRevision: math.MaxUint32,
+			StopOffset:         math.MaxInt64,
+			Collection:         collection,
+			IsEcVolume:         isEcVolume,
+		})
+		if err != nil {
+			return err
+		}
+		var remaining []byte
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				break
+			}
+			if recvErr != nil {
+				return recvErr
+			}
+			remaining = readIndexEntries(append(remaining, resp.FileContent...), needles)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read %s of volume %d on %s: %v", ext, vid, location, err)
+	}
+	return needles, nil
+}
+
+// readIndexEntries applies the complete index entries to the needles, and returns the incomplete bytes at the end.
+// The later entries override the earlier ones, and the deleted needles are set to TombstoneFileSize.
+func readIndexEntries(data []byte, needles map[types.NeedleId]uint32) (remaining []byte) {
+	for len(data) >= types.NeedleMapEntrySize {
+		key, offset, size := idx.IdxFileEntry(data[:types.NeedleMapEntrySize])
+		if !offset.IsZero() && size != types.TombstoneFileSize {
+			needles[key] = size
+		} else {
+			needles[key] = types.TombstoneFileSize
+		}
+		data = data[types.NeedleMapEntrySize:]
+	}
+	return append([]byte(nil), data...)
+}
+
+// readRecentNeedles returns the needles appended to the volume since the cutoff time
+func readRecentNeedles(ctx context.Context, grpcDialOption grpc.DialOption, vol *fsckVolume, cutoffNs uint64) (recent map[types.NeedleId]bool, err error) {
+	for _, location := range vol.locations {
+		recent = make(map[types.NeedleId]bool)
+		err = operation.TailVolumeFromSource(location, grpcDialOption, vol.vid, cutoffNs, 1, func(n *needle.Needle) error {
+			recent[n.Id] = true
+			return nil
+		})
+		if err == nil {
+			return recent, nil
+		}
+	}
+	return nil, fmt.Errorf("read recent needles of volume %d from %v: %v", vol.vid, vol.locations, err)
+}
+
+// findOrphanNeedles returns the sorted needle ids neither referenced nor recent, and their total size
+func findOrphanNeedles(needles map[types.NeedleId]uint32, referenced, recent map[types.NeedleId]bool) (orphans []types.NeedleId, orphanSize uint64) {
+	for key, size := range needles {
+		if referenced[key] || recent[key] {
+			continue
+		}
+		orphans = append(orphans, key)
+		orphanSize += uint64(size)
+	}
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphans[i] < orphans[j]
+	})
+	return
+}
+
+// purgeOrphanNeedles deletes the orphan needles from all the replicas, and returns the number of needles deleted on the first replica
+func purgeOrphanNeedles(ctx context.Context, grpcDialOption grpc.DialOption, vol *fsckVolume, orphans []types.NeedleId, writer io.Writer) (purged uint64, err error) {
+	for i, location := range vol.locations {
+		deleted, err := deleteVolumeNeedles(ctx, grpcDialOption, location, vol.vid, orphans, writer)
+		if err != nil {
+			return purged, err
+		}
+		if i == 0 {
+			purged = deleted
+		}
+	}
+	return purged, nil
+}
+
+// deleteVolumeNeedles deletes the needles from the volume on one volume server, without checking the cookies.
+// The deletion is not replicated to the other replicas.
+func deleteVolumeNeedles(ctx context.Context, grpcDialOption grpc.DialOption, location string, vid needle.VolumeId, keys []types.NeedleId, writer io.Writer) (deleted uint64, err error) {
+	for start := 0; start < len(keys); start += needleDeleteBatchSize {
+		end := start + needleDeleteBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var fileIds []string
+		for _, key := range keys[start:end] {
+			fileIds = append(fileIds, needle.NewFileId(vid, uint64(key), 0).String())
+		}
+		err = operation.WithVolumeServerClient(location, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			resp, err := client.BatchDelete(ctx, &volume_server_pb.BatchDeleteRequest{
+				FileIds:         fileIds,
+				SkipCookieCheck: true,
+			})
+			if err != nil {
+				return err
+			}
+			for _, result := range resp.Results {
+				if result.Error != "" {
+					fmt.Fprintf(writer, "delete %s on %s: %s\n", result.FileId, location, result.Error)
+					continue
+				}
+				deleted++
+			}
+			return nil
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("batch delete on %s: %v", location, err)
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func indexEntry(key types.NeedleId, offset uint32, size uint32) []byte {
+	bytes := make([]byte, types.NeedleMapEntrySize)
+	types.NeedleIdToBytes(bytes[0:types.NeedleIdSize], key)
+	types.OffsetToBytes(bytes[types.NeedleIdSize:types.NeedleIdSize+types.OffsetSize], types.Uint32ToOffset(offset))
+	util.Uint32toBytes(bytes[types.NeedleIdSize+types.OffsetSize:], size)
+	return bytes
+}
+
+func TestReadIndexEntries(t *testing.T) {
+	var data []byte
+	data = append(data, indexEntry(1, 1, 100)...)
+	data = append(data, indexEntry(2, 2, 200)...)
+	data = append(data, indexEntry(3, 3, 300)...)
+	data = append(data, indexEntry(1, 0, types.TombstoneFileSize)...)
+	data = append(data, indexEntry(2, 4, 250)...)
+
+	needles := make(map[types.NeedleId]uint32)
+	// split in the middle of an entry, as the streamed chunks can be
+	split := types.NeedleMapEntrySize*2 + 5
+	remaining := readIndexEntries(data[:split], needles)
+	if len(remaining) != 5 {
+		t.Fatalf("remaining %d bytes, expected 5", len(remaining))
+	}
+	remaining = readIndexEntries(append(remaining, data[split:]...), needles)
+	if len(remaining) != 0 {
+		t.Fatalf("remaining %d bytes, expected 0", len(remaining))
+	}
+
+	if len(needles) != 3 || needles[1] != types.TombstoneFileSize || needles[2] != 250 || needles[3] != 300 {
+		t.Errorf("unexpected needles: %v", needles)
+	}
+	delete(needles, 1)
+
+	orphans, orphanSize := findOrphanNeedles(needles, map[types.NeedleId]bool{3: true}, nil)
+	if len(orphans) != 1 || orphans[0] != 2 || orphanSize != 250 {
+		t.Errorf("unexpected orphans %v size %d", orphans, orphanSize)
+	}
+
+	// the needles written after the cutoff time are not orphans
+	orphans, orphanSize = findOrphanNeedles(needles, map[types.NeedleId]bool{3: true}, map[types.NeedleId]bool{2: true})
+	if len(orphans) != 0 || orphanSize != 0 {
+		t.Errorf("unexpected orphans %v size %d with recent needles", orphans, orphanSize)
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
 
 	"io"
 	"sort"
@@ -25,6 +28,7 @@ func (c *commandVolumeList) Help() string {
 	return `list all volumes
 
 	This command list all volumes as a tree of dataCenter > rack > dataNode > volume.
+	With --json, or in a for loop, each volume replica and the ec shards on each volume server are one record.
 
 `
 }
@@ -45,6 +49,52 @@ func (c *commandVolumeList) Do(args []string, commandEnv *CommandEnv, writer io.
 	return nil
 }
 
+// DoStructured lists each volume replica and the ec shards on each volume server
+func (c *commandVolumeList) DoStructured(args []string, commandEnv *CommandEnv) (records []Record, err error) {
+
+	topologyInfo, err := collectTopologyInfo(context.Background(), commandEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		for _, v := range dn.VolumeInfos {
+			replication := ""
+			if rp, err := storage.NewReplicaPlacementFromByte(byte(v.ReplicaPlacement)); err == nil {
+				replication = rp.String()
+			}
+			records = append(records, Record{
+				"id":           v.Id,
+				"collection":   v.Collection,
+				"server":       dn.Id,
+				"dataCenter":   dc,
+				"rack":         string(rack),
+				"size":         v.Size,
+				"fileCount":    v.FileCount,
+				"deleteCount":  v.DeleteCount,
+				"deletedBytes": v.DeletedByteCount,
+				"readOnly":     v.ReadOnly,
+				"replication":  replication,
+				"ttl":          needle.LoadTTLFromUint32(v.Ttl).String(),
+				"isEc":         false,
+			})
+		}
+		for _, ecShardInfo := range dn.EcShardInfos {
+			records = append(records, Record{
+				"id":         ecShardInfo.Id,
+				"collection": ecShardInfo.Collection,
+				"server":     dn.Id,
+				"dataCenter": dc,
+				"rack":       string(rack),
+				"isEc":       true,
+				"shards":     erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIds(),
+			})
+		}
+	})
+
+	return records, nil
+}
+
 func writeTopologyInfo(writer io.Writer, t *master_pb.TopologyInfo, volumeSizeLimitMb uint64) statistics {
 	fmt.Fprintf(writer, "Topology volume:%d/%d active:%d free:%d volumeSizeLimit:%d MB\n", t.VolumeCount, t.MaxVolumeCount, t.ActiveVolumeCount, t.FreeVolumeCount, volumeSizeLimitMb)
 	sort.Slice(t.DataCenterInfos, func(i, j int) bool {
@@ -83,6 +133,12 @@ func writeRackInfo(writer io.Writer, t *master_pb.RackInfo) statistics {
 }
 func writeDataNodeInfo(writer io.Writer, t *master_pb.DataNodeInfo) statistics {
 	fmt.Fprintf(writer, "      DataNode %s volume:%d/%d active:%d free:%d\n", t.Id, t.VolumeCount, t.MaxVolumeCount, t.ActiveVolumeCount, t.FreeVolumeCount)
+	if len(t.LowDiskSpaceDirs) > 0 {
+		fmt.Fprintf(writer, "      DataNode %s low disk space, read only:%v\n", t.Id, t.LowDiskSpaceDirs)
+	}
+	if len(t.Labels) > 0 {
+		fmt.Fprintf(writer, "      DataNode %s labels:%s\n", t.Id, util.FormatLabels(t.Labels))
+	}
 	var s statistics
 	sort.Slice(t.VolumeInfos, func(i, j int) bool {
 		return t.VolumeInfos[i].Id < t.VolumeInfos[j].Id
@@ -91,7 +147,7 @@ func writeDataNodeInfo(writer io.Writer, t *master_pb.DataNodeInfo) statistics {
 		s = s.plus(writeVolumeInformationMessage(writer, vi))
 	}
 	for _, ecShardInfo := range t.EcShardInfos {
-		fmt.Fprintf(writer, "        ec volume id:%v collection:%v shards:%v\n", ecShardInfo.Id, ecShardInfo.Collection, erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIds())
+		fmt.Fprintf(writer, "        ec volume id:%v collection:%v scheme:%v shards:%v\n", ecShardInfo.Id, ecShardInfo.Collection, erasure_coding.ToEcScheme(ecShardInfo.DataShards, ecShardInfo.ParityShards), erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIds())
 	}
 	fmt.Fprintf(writer, "      DataNode %s %+v \n", t.Id, s)
 	return s
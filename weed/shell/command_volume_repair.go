@@ -0,0 +1,87 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeRepair{})
+}
+
+type commandVolumeRepair struct {
+}
+
+func (c *commandVolumeRepair) Name() string {
+	return "volume.repair"
+}
+
+func (c *commandVolumeRepair) Help() string {
+	return `show or pause the automatic volume repair on the master
+
+	volume.repair          # show the progress
+	volume.repair -pause   # stop starting new repair tasks, the running tasks are finished
+	volume.repair -resume  # continue the repair
+
+	When a volume server is disconnected longer than the grace period, the master re-replicates
+	its replicated volumes, and rebuilds the missing shards of its ec volumes.
+	The repair is configured in the [master.repair] section of master.toml.
+
+	The pause only applies to the current master leader.
+
+`
+}
+
+func (c *commandVolumeRepair) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	repairCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	pause := repairCommand.Bool("pause", false, "pause the volume repair")
+	resume := repairCommand.Bool("resume", false, "resume the volume repair")
+	if err = repairCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	var resp *master_pb.VolumeRepairResponse
+	ctx := context.Background()
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeRepair(ctx, &master_pb.VolumeRepairRequest{
+			Pause:  *pause,
+			Resume: *resume,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsEnabled {
+		fmt.Fprintf(writer, "volume repair is disabled\n")
+		return nil
+	}
+	state := "running"
+	if resp.IsPaused {
+		state = "paused"
+	}
+	fmt.Fprintf(writer, "volume repair is %s, grace period:%v\n", state, time.Duration(resp.GracePeriodSeconds)*time.Second)
+	for _, lost := range resp.LostDataNodes {
+		lostAt := time.Unix(0, lost.LostAtNs)
+		fmt.Fprintf(writer, "  lost volume server %s since %v volumes:%d ec volumes:%d repair in:%v\n",
+			lost.Url, lostAt.Format(time.RFC3339), lost.VolumeCount, lost.EcVolumeCount,
+			(time.Until(lostAt.Add(time.Duration(resp.GracePeriodSeconds) * time.Second))).Round(time.Second))
+	}
+	for _, task := range resp.RunningTasks {
+		fmt.Fprintf(writer, "  running: %s\n", task)
+	}
+	fmt.Fprintf(writer, "tasks pending:%d running:%d succeeded:%d skipped:%d failed:%d\n",
+		resp.PendingTaskCount, len(resp.RunningTasks), resp.SucceededTaskCount, resp.SkippedTaskCount, resp.FailedTaskCount)
+	if resp.LastError != "" {
+		fmt.Fprintf(writer, "last error: %s\n", resp.LastError)
+	}
+
+	return nil
+}
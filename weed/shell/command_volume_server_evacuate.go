@@ -0,0 +1,378 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeServerEvacuate{})
+}
+
+type commandVolumeServerEvacuate struct {
+}
+
+func (c *commandVolumeServerEvacuate) Name() string {
+	return "volume.server.evacuate"
+}
+
+func (c *commandVolumeServerEvacuate) Help() string {
+	return `move out all data on a volume server
+
+	volume.server.evacuate -node=<host:port> [-force]
+	volume.server.evacuate -node=<host:port> -cancel
+
+	This command moves all volumes and ec shards off the volume server, usually before decommissioning it.
+
+	1. The volume server is marked as draining, and reports no free volume slots to the master.
+		The master stops placing new volumes on it. The mark is kept across volume server restarts.
+	2. Each volume is moved to a volume server satisfying its replica placement, with the same steps as volume.move.
+	3. Each ec shard is moved to a volume server with free slots, preferring the same data center,
+		and the racks and volume servers with the fewest shards of the same ec volume.
+
+	Without -force, only the plan is printed.
+	If interrupted, just run it again. Volumes and ec shards already copied elsewhere are removed
+	from the volume server without copying them again.
+
+	Use -cancel to stop draining, so the volume server accepts new volumes again.
+
+`
+}
+
+func (c *commandVolumeServerEvacuate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	evacuateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	volumeServer := evacuateCommand.String("node", "", "<host>:<port> of the volume server to evacuate")
+	applyChange := evacuateCommand.Bool("force", false, "actually move the volumes and ec shards")
+	cancel := evacuateCommand.Bool("cancel", false, "stop draining the volume server")
+	if err = evacuateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	if *volumeServer == "" {
+		return fmt.Errorf("need to specify the volume server by -node=<host>:<port>")
+	}
+
+	ctx := context.Background()
+
+	if *cancel {
+		if err = drainVolumeServer(ctx, commandEnv.option.GrpcDialOption, *volumeServer, false); err != nil {
+			return fmt.Errorf("cancel draining %s: %v", *volumeServer, err)
+		}
+		fmt.Fprintf(writer, "volume server %s accepts new volumes again\n", *volumeServer)
+		return nil
+	}
+
+	return volumeServerEvacuate(ctx, commandEnv, *volumeServer, *applyChange, writer)
+}
+
+func volumeServerEvacuate(ctx context.Context, commandEnv *CommandEnv, volumeServer string, applyChange bool, writer io.Writer) (err error) {
+
+	var resp *master_pb.VolumeListResponse
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var thisLocation *location
+	var otherLocations []location
+	eachDataNode(resp.TopologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		loc := newLocation(dc, string(rack), dn)
+		if dn.Id == volumeServer {
+			thisLocation = &loc
+		} else {
+			otherLocations = append(otherLocations, loc)
+		}
+	})
+	if thisLocation == nil {
+		return fmt.Errorf("volume server %s is not found", volumeServer)
+	}
+
+	fmt.Fprintf(writer, "marking volume server %s as draining\n", volumeServer)
+	if applyChange {
+		if err = drainVolumeServer(ctx, commandEnv.option.GrpcDialOption, volumeServer, true); err != nil {
+			return fmt.Errorf("drain %s: %v", volumeServer, err)
+		}
+	}
+
+	unmovedVolumes, err := evacuateVolumes(ctx, commandEnv, *thisLocation, otherLocations, applyChange, writer)
+	if err != nil {
+		return err
+	}
+
+	unmovedEcShards, err := evacuateEcShards(ctx, commandEnv, *thisLocation, otherLocations, applyChange, writer)
+	if err != nil {
+		return err
+	}
+
+	if unmovedVolumes > 0 || unmovedEcShards > 0 {
+		return fmt.Errorf("%d volumes and %d ec shards can not be moved off %s", unmovedVolumes, unmovedEcShards, volumeServer)
+	}
+
+	if !applyChange {
+		fmt.Fprintf(writer, "this is a dry run, use -force to evacuate %s\n", volumeServer)
+		return nil
+	}
+
+	fmt.Fprintf(writer, "volume server %s is evacuated\n", volumeServer)
+	return nil
+}
+
+func evacuateVolumes(ctx context.Context, commandEnv *CommandEnv, thisLocation location, otherLocations []location, applyChange bool, writer io.Writer) (unmovedCount int, err error) {
+
+	// the locations of each volume, other than the evacuated volume server
+	volumeLocations := make(map[uint32][]location)
+	for _, loc := range otherLocations {
+		for _, v := range loc.dataNode.VolumeInfos {
+			volumeLocations[v.Id] = append(volumeLocations[v.Id], loc)
+		}
+	}
+
+	volumes := make([]*master_pb.VolumeInformationMessage, len(thisLocation.dataNode.VolumeInfos))
+	copy(volumes, thisLocation.dataNode.VolumeInfos)
+	sortReadOnlyVolumes(volumes)
+
+	for _, v := range volumes {
+
+		replicaPlacement, _ := storage.NewReplicaPlacementFromByte(byte(v.ReplicaPlacement))
+		existingLocations := volumeLocations[v.Id]
+
+		if len(existingLocations) >= replicaPlacement.GetCopyCount() {
+			// an interrupted move already copied the volume
+			fmt.Fprintf(writer, "volume %d already has %d replicas elsewhere, removing it from %s\n", v.Id, len(existingLocations), thisLocation.dataNode.Id)
+			if applyChange {
+				if err = finishMovingVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), thisLocation, existingLocations); err != nil {
+					return
+				}
+			}
+			continue
+		}
+
+		keepDataNodesSorted(otherLocations)
+		dst, found := pickVolumeDestination(v, replicaPlacement, existingLocations, otherLocations)
+		if !found {
+			fmt.Fprintf(writer, "failed to find a destination for volume %d as %s, existing:%+v\n", v.Id, replicaPlacement, existingLocations)
+			unmovedCount++
+			continue
+		}
+
+		fmt.Fprintf(writer, "moving volume %d %s => %s\n", v.Id, thisLocation.dataNode.Id, dst.dataNode.Id)
+		if applyChange {
+			if err = commandEnv.confirmIsLocked(); err != nil {
+				return
+			}
+			if err = LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(v.Id), thisLocation.dataNode.Id, dst.dataNode.Id, 5*time.Second); err != nil {
+				return
+			}
+		}
+
+		// adjust the destination for the following volumes
+		dst.dataNode.FreeVolumeCount--
+		dst.dataNode.VolumeInfos = append(dst.dataNode.VolumeInfos, v)
+		volumeLocations[v.Id] = append(volumeLocations[v.Id], dst)
+	}
+
+	return
+}
+
+func pickVolumeDestination(v *master_pb.VolumeInformationMessage, replicaPlacement *storage.ReplicaPlacement, existingLocations []location, candidates []location) (location, bool) {
+	for _, dst := range candidates {
+		if dst.dataNode.FreeVolumeCount == 0 {
+			continue
+		}
+		if hasVolume(dst.dataNode, v.Id) {
+			continue
+		}
+		if satisfyReplicaPlacement(replicaPlacement, existingLocations, dst) {
+			return dst, true
+		}
+	}
+	return location{}, false
+}
+
+func hasVolume(dn *master_pb.DataNodeInfo, vid uint32) bool {
+	for _, v := range dn.VolumeInfos {
+		if v.Id == vid {
+			return true
+		}
+	}
+	return false
+}
+
+// finishMovingVolume catches up the copied volumes with the source volume, and deletes the source volume.
+func finishMovingVolume(ctx context.Context, grpcDialOption grpc.DialOption, volumeId needle.VolumeId, source location, copies []location) error {
+
+	for _, dst := range copies {
+		var sinceNs uint64
+		err := operation.WithVolumeServerClient(dst.dataNode.Id, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			resp, statusErr := volumeServerClient.ReadVolumeFileStatus(ctx, &volume_server_pb.ReadVolumeFileStatusRequest{
+				VolumeId: uint32(volumeId),
+			})
+			if statusErr == nil {
+				sinceNs = resp.DatFileTimestampSeconds * uint64(time.Second)
+			}
+			return statusErr
+		})
+		if err != nil {
+			return fmt.Errorf("read volume %d status on %s: %v", volumeId, dst.dataNode.Id, err)
+		}
+		if err = tailVolume(ctx, grpcDialOption, volumeId, source.dataNode.Id, dst.dataNode.Id, sinceNs, 5*time.Second); err != nil {
+			return fmt.Errorf("tail volume %d from %s to %s: %v", volumeId, source.dataNode.Id, dst.dataNode.Id, err)
+		}
+	}
+
+	if err := deleteVolume(ctx, grpcDialOption, volumeId, source.dataNode.Id); err != nil {
+		return fmt.Errorf("delete volume %d from %s: %v", volumeId, source.dataNode.Id, err)
+	}
+
+	return nil
+}
+
+func evacuateEcShards(ctx context.Context, commandEnv *CommandEnv, thisLocation location, otherLocations []location, applyChange bool, writer io.Writer) (unmovedCount int, err error) {
+
+	// the shards to move can be of several schemes, count the free slots with the smallest one
+	ecSchemes := make(map[string]erasure_coding.EcScheme)
+	for _, ecShardInfo := range thisLocation.dataNode.EcShardInfos {
+		scheme := erasure_coding.ToEcScheme(ecShardInfo.DataShards, ecShardInfo.ParityShards)
+		ecSchemes[scheme.String()] = scheme
+	}
+	scheme := smallestEcScheme(ecSchemes)
+
+	thisEcNode := &EcNode{
+		info:       thisLocation.dataNode,
+		dc:         thisLocation.dc,
+		rack:       RackId(thisLocation.rack),
+		freeEcSlot: countFreeShardSlots(thisLocation.dataNode, scheme),
+	}
+	var otherEcNodes []*EcNode
+	for _, loc := range otherLocations {
+		otherEcNodes = append(otherEcNodes, &EcNode{
+			info:       loc.dataNode,
+			dc:         loc.dc,
+			rack:       RackId(loc.rack),
+			freeEcSlot: countFreeShardSlots(loc.dataNode, scheme),
+		})
+	}
+
+	// the shards are removed from thisEcNode while moving
+	type ecShards struct {
+		vid        needle.VolumeId
+		collection string
+		shardIds   []erasure_coding.ShardId
+	}
+	var toMove []ecShards
+	for _, ecShardInfo := range thisEcNode.info.EcShardInfos {
+		toMove = append(toMove, ecShards{
+			vid:        needle.VolumeId(ecShardInfo.Id),
+			collection: ecShardInfo.Collection,
+			shardIds:   erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIds(),
+		})
+	}
+	sort.Slice(toMove, func(i, j int) bool {
+		return toMove[i].vid < toMove[j].vid
+	})
+
+	for _, shards := range toMove {
+		for _, shardId := range shards.shardIds {
+
+			if existing := findEcShardLocation(otherEcNodes, shards.vid, shardId); existing != nil {
+				// an interrupted move already copied the shard
+				fmt.Fprintf(writer, "ec shard %d.%d is already on %s, removing it from %s\n", shards.vid, shardId, existing.info.Id, thisEcNode.info.Id)
+				if applyChange {
+					shardIds := []uint32{uint32(shardId)}
+					if err = unmountEcShards(ctx, commandEnv.option.GrpcDialOption, shards.vid, thisEcNode.info.Id, shardIds); err != nil {
+						return
+					}
+					if err = sourceServerDeleteEcShards(ctx, commandEnv.option.GrpcDialOption, shards.collection, shards.vid, thisEcNode.info.Id, shardIds); err != nil {
+						return
+					}
+				}
+				thisEcNode.deleteEcVolumeShards(shards.vid, []uint32{uint32(shardId)})
+				continue
+			}
+
+			dst := pickEcShardDestination(thisEcNode, otherEcNodes, shards.vid)
+			if dst == nil {
+				fmt.Fprintf(writer, "failed to find a destination for ec shard %d.%d\n", shards.vid, shardId)
+				unmovedCount++
+				continue
+			}
+
+			fmt.Fprintf(writer, "moving ec shard %d.%d %s => %s\n", shards.vid, shardId, thisEcNode.info.Id, dst.info.Id)
+			if err = moveMountedShardToEcNode(ctx, commandEnv, thisEcNode, shards.collection, shards.vid, shardId, dst, applyChange); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func findEcShardLocation(ecNodes []*EcNode, vid needle.VolumeId, shardId erasure_coding.ShardId) *EcNode {
+	for _, ecNode := range ecNodes {
+		if findEcVolumeShards(ecNode, vid).HasShardId(shardId) {
+			return ecNode
+		}
+	}
+	return nil
+}
+
+// pickEcShardDestination prefers the same data center, then the racks and the volume servers
+// with the fewest shards of the ec volume, then the most free slots.
+func pickEcShardDestination(source *EcNode, candidates []*EcNode, vid needle.VolumeId) *EcNode {
+
+	rackShardCount := groupByCount(candidates, func(ecNode *EcNode) (id string, count int) {
+		return string(ecNode.rack), findEcVolumeShards(ecNode, vid).ShardIdCount()
+	})
+
+	var possibleDestinations []*EcNode
+	for _, ecNode := range candidates {
+		if ecNode.freeEcSlot > 0 {
+			possibleDestinations = append(possibleDestinations, ecNode)
+		}
+	}
+	if len(possibleDestinations) == 0 {
+		return nil
+	}
+
+	sort.Slice(possibleDestinations, func(i, j int) bool {
+		a, b := possibleDestinations[i], possibleDestinations[j]
+		if (a.dc == source.dc) != (b.dc == source.dc) {
+			return a.dc == source.dc
+		}
+		if rackShardCount[string(a.rack)] != rackShardCount[string(b.rack)] {
+			return rackShardCount[string(a.rack)] < rackShardCount[string(b.rack)]
+		}
+		aCount, bCount := findEcVolumeShards(a, vid).ShardIdCount(), findEcVolumeShards(b, vid).ShardIdCount()
+		if aCount != bCount {
+			return aCount < bCount
+		}
+		return a.freeEcSlot > b.freeEcSlot
+	})
+
+	return possibleDestinations[0]
+}
+
+func drainVolumeServer(ctx context.Context, grpcDialOption grpc.DialOption, volumeServer string, isDraining bool) error {
+	return operation.WithVolumeServerClient(volumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		_, drainErr := volumeServerClient.VolumeServerDrain(ctx, &volume_server_pb.VolumeServerDrainRequest{
+			Cancel: !isDraining,
+		})
+		return drainErr
+	})
+}
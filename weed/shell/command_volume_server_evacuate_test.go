@@ -0,0 +1,50 @@
+package shell
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestPickVolumeDestination(t *testing.T) {
+
+	v := &master_pb.VolumeInformationMessage{Id: 1}
+	replicaPlacement, _ := storage.NewReplicaPlacementFromString("010")
+
+	existing := newLocation("dc1", "rack1", &master_pb.DataNodeInfo{Id: "dn1", FreeVolumeCount: 5, VolumeInfos: []*master_pb.VolumeInformationMessage{v}})
+	candidates := []location{
+		existing,
+		newLocation("dc1", "rack1", &master_pb.DataNodeInfo{Id: "dn2", FreeVolumeCount: 10}),
+		newLocation("dc1", "rack2", &master_pb.DataNodeInfo{Id: "dn3", FreeVolumeCount: 0}),
+		newLocation("dc1", "rack2", &master_pb.DataNodeInfo{Id: "dn4", FreeVolumeCount: 1}),
+	}
+
+	dst, found := pickVolumeDestination(v, replicaPlacement, []location{existing}, candidates)
+	if !found || dst.dataNode.Id != "dn4" {
+		t.Fatalf("expected dn4 on another rack with free slots, found:%v %+v", found, dst.dataNode)
+	}
+
+	candidates[3].dataNode.FreeVolumeCount = 0
+	if dst, found = pickVolumeDestination(v, replicaPlacement, []location{existing}, candidates); found {
+		t.Fatalf("unexpected destination %s", dst.dataNode.Id)
+	}
+}
+
+func TestPickEcShardDestination(t *testing.T) {
+
+	source := newEcNode("dc1", "rack1", "dn0", 0).addEcVolumeAndShardsForTest(1, "c1", []uint32{0, 1})
+	candidates := []*EcNode{
+		newEcNode("dc1", "rack1", "dn1", 100).addEcVolumeAndShardsForTest(1, "c1", []uint32{2, 3}),
+		newEcNode("dc1", "rack2", "dn2", 100).addEcVolumeAndShardsForTest(1, "c1", []uint32{4}),
+		newEcNode("dc1", "rack2", "dn3", 10),
+		newEcNode("dc2", "rack3", "dn4", 100),
+		newEcNode("dc1", "rack4", "dn5", 0),
+	}
+
+	dst := pickEcShardDestination(source, candidates, needle.VolumeId(1))
+	if dst == nil || dst.info.Id != "dn3" {
+		t.Fatalf("expected dn3 in the same data center and the least used rack, got %+v", dst)
+	}
+}
@@ -22,12 +22,16 @@ type ShellOptions struct {
 	FilerHost string
 	FilerPort int64
 	Directory string
+	// print the output of all commands as json
+	JsonOutput bool
 }
 
 type CommandEnv struct {
 	env          map[string]string
 	MasterClient *wdclient.MasterClient
+	AdminLock    *AdminLock
 	option       ShellOptions
+	jsonOutput   bool
 }
 
 type command interface {
@@ -45,10 +49,16 @@ func NewCommandEnv(options ShellOptions) *CommandEnv {
 		env: make(map[string]string),
 		MasterClient: wdclient.NewMasterClient(context.Background(),
 			options.GrpcDialOption, "shell", strings.Split(*options.Masters, ",")),
-		option: options,
+		AdminLock: NewAdminLock("shell", false),
+		option:    options,
 	}
 }
 
+// IsJsonOutput tells whether the running command should print json
+func (ce *CommandEnv) IsJsonOutput() bool {
+	return ce.jsonOutput
+}
+
 func (ce *CommandEnv) parseUrl(input string) (filerServer string, filerPort int64, path string, err error) {
 	if strings.HasPrefix(input, "http") {
 		return parseFilerUrl(input)
@@ -128,3 +138,15 @@ func findInputDirectory(args []string) (input string) {
 	}
 	return input
 }
+
+func lookupEntry(ctx context.Context, client filer_pb.SeaweedFilerClient, path string) (*filer_pb.Entry, error) {
+	dir, name := filer2.FullPath(path).DirAndName()
+	resp, err := client.LookupDirectoryEntry(ctx, &filer_pb.LookupDirectoryEntryRequest{
+		Directory: dir,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Entry, nil
+}
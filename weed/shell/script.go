@@ -0,0 +1,363 @@
+package shell
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Record is one item of a structured command output
+type Record map[string]interface{}
+
+// structuredCommand is implemented by the commands with a list of records as the output.
+// The records are printed with --json, and can be iterated by the for loops in the scripts.
+type structuredCommand interface {
+	DoStructured(args []string, commandEnv *CommandEnv) ([]Record, error)
+}
+
+// the commands printing their own json output when commandEnv.IsJsonOutput()
+var jsonNativeCommands = map[string]bool{
+	"cluster.watch": true,
+}
+
+// ErrIncompleteScript is returned when a for loop is not closed by end yet
+var ErrIncompleteScript = errors.New("incomplete script, missing end")
+
+// ScriptExitError stops the script with the exit code
+type ScriptExitError struct {
+	Code int
+}
+
+func (e *ScriptExitError) Error() string {
+	return fmt.Sprintf("exit %d", e.Code)
+}
+
+type ScriptOptions struct {
+	// called with each error, and the script continues with the next statement.
+	// Without OnError, the script stops at the first error.
+	OnError func(err error)
+	// called before running each command
+	OnCommand func(name string, args []string)
+}
+
+type scriptWord struct {
+	text  string
+	quote byte
+}
+
+type scriptStatement struct {
+	line  int
+	words []scriptWord
+	// the statements in the for loop
+	body []*scriptStatement
+}
+
+var (
+	scriptWordRegexp     = regexp.MustCompile(`'.*?'|".*?"|;|[^\s;]+`)
+	scriptVariableRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_.]*)\}|\$([A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?)`)
+)
+
+// parseScript splits the script into statements. The statements are separated by new lines or ";",
+// and "#" starts a comment till the end of the line.
+func parseScript(script string) (statements []*scriptStatement, err error) {
+	var flat []*scriptStatement
+	for i, line := range strings.Split(script, "\n") {
+		current := &scriptStatement{line: i + 1}
+		for _, w := range scriptWordRegexp.FindAllString(line, -1) {
+			if w == ";" {
+				if len(current.words) > 0 {
+					flat = append(flat, current)
+				}
+				current = &scriptStatement{line: i + 1}
+				continue
+			}
+			if strings.HasPrefix(w, "#") {
+				break
+			}
+			word := scriptWord{text: w}
+			if len(w) >= 2 && (w[0] == '"' || w[0] == '\'') && w[len(w)-1] == w[0] {
+				word = scriptWord{text: w[1 : len(w)-1], quote: w[0]}
+			}
+			current.words = append(current.words, word)
+		}
+		if len(current.words) > 0 {
+			flat = append(flat, current)
+		}
+	}
+
+	statements, rest, err := nestStatements(flat)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("line %d: end without for", rest[0].line)
+	}
+	return statements, nil
+}
+
+// nestStatements moves the statements inside the for loops into their bodies,
+// and returns the statements after the first unmatched end
+func nestStatements(flat []*scriptStatement) (statements, rest []*scriptStatement, err error) {
+	for len(flat) > 0 {
+		s := flat[0]
+		flat = flat[1:]
+		switch s.words[0].text {
+		case "end":
+			return statements, append([]*scriptStatement{s}, flat...), nil
+		case "for":
+			if len(s.words) < 3 || s.words[2].text != "in" {
+				return nil, nil, fmt.Errorf("line %d: should be: for <variable> in <command or words>", s.line)
+			}
+			var remaining []*scriptStatement
+			s.body, remaining, err = nestStatements(flat)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(remaining) == 0 {
+				return nil, nil, ErrIncompleteScript
+			}
+			flat = remaining[1:]
+		}
+		statements = append(statements, s)
+	}
+	return statements, nil, nil
+}
+
+// RunScript runs the script with the variables in the commandEnv
+func RunScript(commandEnv *CommandEnv, script string, writer io.Writer, options ScriptOptions) error {
+	statements, err := parseScript(script)
+	if err != nil {
+		return err
+	}
+	return runStatements(commandEnv, statements, writer, options)
+}
+
+// RunBatch connects to the masters, and runs the script without the interactive shell.
+// The script stops at the first error.
+func RunBatch(options ShellOptions, script string, writer io.Writer) error {
+
+	commandEnv := NewCommandEnv(options)
+
+	go commandEnv.MasterClient.KeepConnectedToMaster()
+	commandEnv.MasterClient.WaitUntilConnected()
+
+	defer commandEnv.AdminLock.Release(commandEnv)
+
+	return RunScript(commandEnv, script, writer, ScriptOptions{})
+}
+
+func runStatements(commandEnv *CommandEnv, statements []*scriptStatement, writer io.Writer, options ScriptOptions) error {
+	for _, s := range statements {
+		err := runStatement(commandEnv, s, writer, options)
+		if err == nil {
+			continue
+		}
+		if _, isExit := err.(*ScriptExitError); isExit {
+			return err
+		}
+		if _, hasLine := err.(*scriptLineError); !hasLine {
+			err = &scriptLineError{line: s.line, err: err}
+		}
+		if options.OnError == nil {
+			return err
+		}
+		options.OnError(err)
+	}
+	return nil
+}
+
+type scriptLineError struct {
+	line int
+	err  error
+}
+
+func (e *scriptLineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.line, e.err)
+}
+
+func runStatement(commandEnv *CommandEnv, s *scriptStatement, writer io.Writer, options ScriptOptions) error {
+
+	if s.words[0].text == "for" {
+		return runForLoop(commandEnv, s, writer, options)
+	}
+
+	words, err := expandWords(commandEnv, s.words)
+	if err != nil {
+		return err
+	}
+
+	name, args := strings.ToLower(words[0]), words[1:]
+	switch name {
+	case "set":
+		if len(args) == 0 {
+			return fmt.Errorf("should be: set <variable> [<value>...]")
+		}
+		commandEnv.env[args[0]] = strings.Join(args[1:], " ")
+		return nil
+	case "echo":
+		_, err = fmt.Fprintln(writer, strings.Join(args, " "))
+		return err
+	case "exit":
+		code := 0
+		if len(args) > 0 {
+			if code, err = strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("invalid exit code %s", args[0])
+			}
+		}
+		return &ScriptExitError{Code: code}
+	}
+
+	if options.OnCommand != nil {
+		options.OnCommand(name, args)
+	}
+
+	return RunCommandLine(commandEnv, name, args, writer)
+}
+
+func runForLoop(commandEnv *CommandEnv, s *scriptStatement, writer io.Writer, options ScriptOptions) error {
+
+	variable := s.words[1].text
+	words, err := expandWords(commandEnv, s.words[3:])
+	if err != nil {
+		return err
+	}
+
+	var records []Record
+	if len(words) > 0 {
+		if c := findCommand(strings.ToLower(words[0])); c != nil {
+			sc, ok := c.(structuredCommand)
+			if !ok {
+				return fmt.Errorf("%s has no structured output to iterate", c.Name())
+			}
+			if records, err = sc.DoStructured(words[1:], commandEnv); err != nil {
+				return err
+			}
+		} else {
+			for _, w := range words {
+				records = append(records, Record{"value": w})
+			}
+		}
+	}
+
+	for _, record := range records {
+		setRecordVariables(commandEnv, variable, record)
+		if err = runStatements(commandEnv, s.body, writer, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setRecordVariables sets $name to the id, name, path or value of the record, and $name.field to each field
+func setRecordVariables(commandEnv *CommandEnv, variable string, record Record) {
+	for key, value := range record {
+		commandEnv.env[variable+"."+key] = fmt.Sprint(value)
+	}
+	for _, key := range []string{"id", "name", "path", "value"} {
+		if value, found := record[key]; found {
+			commandEnv.env[variable] = fmt.Sprint(value)
+			return
+		}
+	}
+}
+
+// expandWords replaces $name and ${name} with the variable values, except in the single quoted words
+func expandWords(commandEnv *CommandEnv, words []scriptWord) (expanded []string, err error) {
+	for _, w := range words {
+		if w.quote == '\'' {
+			expanded = append(expanded, w.text)
+			continue
+		}
+		text := scriptVariableRegexp.ReplaceAllStringFunc(w.text, func(s string) string {
+			name := strings.TrimPrefix(strings.TrimSuffix(strings.TrimPrefix(s, "${"), "}"), "$")
+			value, found := commandEnv.env[name]
+			if !found && err == nil {
+				err = fmt.Errorf("undefined variable %s", name)
+			}
+			return value
+		})
+		expanded = append(expanded, text)
+	}
+	return
+}
+
+func findCommand(name string) command {
+	for _, c := range Commands {
+		if c.Name() == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// RunCommandLine runs the command by name. With -json or --json in the args, or the json output option,
+// the output is printed as json: the records of the structured commands, or the text output and the error otherwise.
+func RunCommandLine(commandEnv *CommandEnv, name string, args []string, writer io.Writer) error {
+
+	c := findCommand(name)
+	if c == nil {
+		return fmt.Errorf("unknown command: %v", name)
+	}
+
+	args, jsonOutput := extractJsonFlag(args)
+	if !jsonOutput && !commandEnv.option.JsonOutput {
+		return RunCommand(commandEnv, c, args, writer)
+	}
+
+	commandEnv.jsonOutput = true
+	defer func() {
+		commandEnv.jsonOutput = false
+	}()
+
+	if sc, ok := c.(structuredCommand); ok {
+		records, err := sc.DoStructured(args, commandEnv)
+		if err != nil {
+			writeJson(writer, map[string]interface{}{"command": name, "error": err.Error()})
+			return err
+		}
+		if records == nil {
+			records = []Record{}
+		}
+		return writeJson(writer, records)
+	}
+
+	if jsonNativeCommands[name] {
+		return RunCommand(commandEnv, c, args, writer)
+	}
+
+	var output bytes.Buffer
+	err := RunCommand(commandEnv, c, args, &output)
+	result := map[string]interface{}{"command": name, "output": output.String()}
+	if err != nil {
+		result["error"] = err.Error()
+	}
+	if writeErr := writeJson(writer, result); writeErr != nil {
+		return writeErr
+	}
+	return err
+}
+
+func extractJsonFlag(args []string) (rest []string, jsonOutput bool) {
+	for _, arg := range args {
+		if arg == "-json" || arg == "--json" {
+			jsonOutput = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return
+}
+
+func writeJson(writer io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, "%s\n", b)
+	return err
+}
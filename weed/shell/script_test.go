@@ -0,0 +1,97 @@
+package shell
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseScript(t *testing.T) {
+	statements, err := parseScript(`
+# a comment
+set dir "/my dir" ; echo $dir  # another comment
+for v in a b
+  for w in c d
+    echo $v $w
+  end
+end
+`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(statements) != 3 {
+		t.Fatalf("statements: %d", len(statements))
+	}
+	if words := statements[0].words; len(words) != 3 || words[2].text != "/my dir" || words[2].quote != '"' {
+		t.Errorf("set statement: %+v", words)
+	}
+	if statements[1].line != 3 {
+		t.Errorf("echo statement line: %d", statements[1].line)
+	}
+	if loop := statements[2]; len(loop.body) != 1 || len(loop.body[0].body) != 1 {
+		t.Errorf("nested for loops: %+v", loop)
+	}
+
+	if _, err = parseScript("for v in a b\necho $v"); err != ErrIncompleteScript {
+		t.Errorf("missing end: %v", err)
+	}
+	if _, err = parseScript("echo a\nend"); err == nil {
+		t.Errorf("end without for should fail")
+	}
+	if _, err = parseScript("for v a b\nend"); err == nil {
+		t.Errorf("for without in should fail")
+	}
+}
+
+func TestRunScript(t *testing.T) {
+	tests := []struct {
+		script, output string
+		exitCode       int
+		failed         bool
+	}{
+		{script: "echo hello world", output: "hello world\n"},
+		{script: "set name seaweed\necho $name ${name}fs '$name'", output: "seaweed seaweedfs $name\n"},
+		{script: "for v in 1 2 3; echo v$v; end", output: "v1\nv2\nv3\n"},
+		{script: "for v in a b\nfor w in c d\necho $v$w\nend\nend", output: "ac\nad\nbc\nbd\n"},
+		{script: "set list x y\nfor v in $list\necho $v.value\nend", output: "x y\n"},
+		{script: "echo before\nexit 3\necho after", output: "before\n", exitCode: 3},
+		{script: "echo $missing\necho after", failed: true},
+		{script: "no.such.command\necho after", failed: true},
+		{script: "for v in fs.mkdir /dir\necho $v\nend", failed: true},
+	}
+
+	for _, tt := range tests {
+		commandEnv := &CommandEnv{env: make(map[string]string)}
+		var output bytes.Buffer
+		err := RunScript(commandEnv, tt.script, &output, ScriptOptions{})
+		if exitErr, ok := err.(*ScriptExitError); ok {
+			if exitErr.Code != tt.exitCode {
+				t.Errorf("script %q exit code %d", tt.script, exitErr.Code)
+			}
+		} else if (err != nil) != tt.failed {
+			t.Errorf("script %q: %v", tt.script, err)
+		}
+		if !tt.failed && output.String() != tt.output {
+			t.Errorf("script %q output %q, expected %q", tt.script, output.String(), tt.output)
+		}
+	}
+}
+
+func TestRunScriptOnError(t *testing.T) {
+	commandEnv := &CommandEnv{env: make(map[string]string)}
+	var output bytes.Buffer
+	var errs []error
+	err := RunScript(commandEnv, "echo $missing\nno.such.command\necho done", &output, ScriptOptions{
+		OnError: func(err error) {
+			errs = append(errs, err)
+		},
+	})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if len(errs) != 2 || errs[1].Error() != "line 2: unknown command: no.such.command" {
+		t.Errorf("errors: %v", errs)
+	}
+	if output.String() != "done\n" {
+		t.Errorf("output: %q", output.String())
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"io"
 	"os"
 	"path"
-	"regexp"
 	"strings"
 
 	"sort"
@@ -30,15 +29,26 @@ func RunShell(options ShellOptions) {
 
 	defer saveHistory()
 
-	reg, _ := regexp.Compile(`'.*?'|".*?"|\S+`)
-
 	commandEnv := NewCommandEnv(options)
 
 	go commandEnv.MasterClient.KeepConnectedToMaster()
 	commandEnv.MasterClient.WaitUntilConnected()
 
+	defer commandEnv.AdminLock.Release(commandEnv)
+
+	scriptOptions := ScriptOptions{
+		OnError: func(err error) {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		},
+	}
+
+	var script string
 	for {
-		cmd, err := line.Prompt("> ")
+		prompt := "> "
+		if script != "" {
+			prompt = "... "
+		}
+		cmd, err := line.Prompt(prompt)
 		if err != nil {
 			if err != io.EOF {
 				fmt.Printf("%v\n", err)
@@ -46,38 +56,34 @@ func RunShell(options ShellOptions) {
 			return
 		}
 
-		cmds := reg.FindAllString(cmd, -1)
-		if len(cmds) == 0 {
+		cmds := strings.Fields(cmd)
+		if len(cmds) == 0 && script == "" {
 			continue
-		} else {
-			line.AppendHistory(cmd)
-
-			args := make([]string, len(cmds[1:]))
-
-			for i := range args {
-				args[i] = strings.Trim(string(cmds[1+i]), "\"'")
-			}
+		}
+		line.AppendHistory(cmd)
 
-			cmd := strings.ToLower(cmds[0])
-			if cmd == "help" || cmd == "?" {
+		if script == "" {
+			name := strings.ToLower(cmds[0])
+			if name == "help" || name == "?" {
 				printHelp(cmds)
-			} else if cmd == "exit" || cmd == "quit" {
+				continue
+			} else if name == "quit" || (name == "exit" && len(cmds) == 1) {
 				return
-			} else {
-				foundCommand := false
-				for _, c := range Commands {
-					if c.Name() == cmd {
-						if err := c.Do(args, commandEnv, os.Stdout); err != nil {
-							fmt.Fprintf(os.Stderr, "error: %v\n", err)
-						}
-						foundCommand = true
-					}
-				}
-				if !foundCommand {
-					fmt.Fprintf(os.Stderr, "unknown command: %v\n", cmd)
-				}
 			}
+		}
+
+		script += cmd + "\n"
+		if _, err = parseScript(script); err == ErrIncompleteScript {
+			continue
+		}
 
+		err = RunScript(commandEnv, script, os.Stdout, scriptOptions)
+		script = ""
+		if _, isExit := err.(*ScriptExitError); isExit {
+			return
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		}
 	}
 }
@@ -85,6 +91,10 @@ func RunShell(options ShellOptions) {
 func printGenericHelp() {
 	msg :=
 		`Type:	"help <command>" for help on <command>
+	"set <variable> <value>" to set a variable, used as $variable or ${variable}
+	"for <variable> in <command or words> ... end" to loop over the records of volume.list, collection.list or fs.find, or the words
+	"echo <words>" to print the words
+	"<command> --json" to print the command output as json
 `
 	fmt.Print(msg)
 
@@ -13,6 +13,7 @@ import (
 var (
 	FilerGather        = prometheus.NewRegistry()
 	VolumeServerGather = prometheus.NewRegistry()
+	MasterGather       = prometheus.NewRegistry()
 
 	FilerRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -65,6 +66,23 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 24),
 		}, []string{"type"})
 
+	VolumeServerEcRecoveryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "volumeServer",
+			Name:      "ec_recovery_total",
+			Help:      "Counter of reconstructing missing ec shard intervals, by cached, recovered, or failed.",
+		}, []string{"type"})
+
+	VolumeServerEcRecoveryHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "volumeServer",
+			Name:      "ec_recovery_seconds",
+			Help:      "Bucketed histogram of reconstructing missing ec shard intervals from other shards.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 24),
+		})
+
 	VolumeServerVolumeCounter = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "SeaweedFS",
@@ -88,6 +106,79 @@ var (
 			Name:      "total_disk_size",
 			Help:      "Actual disk size used by volumes.",
 		}, []string{"collection", "type"})
+
+	VolumeServerLowDiskSpaceGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "volumeServer",
+			Name:      "low_disk_space",
+			Help:      "1 if the free space of the directory is below the minimum, and its volumes are read only.",
+		}, []string{"dir"})
+
+	MasterRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "request_total",
+			Help:      "Counter of master requests.",
+		}, []string{"type"})
+
+	MasterRequestHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "request_seconds",
+			Help:      "Bucketed histogram of master request processing time.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 24),
+		}, []string{"type"})
+
+	MasterVolumeCountGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "volumes",
+			Help:      "Number of volumes, writable volumes, or ec volumes.",
+		}, []string{"collection", "type"})
+
+	MasterFreeSlotsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "free_volume_slots",
+			Help:      "Number of free volume slots.",
+		}, []string{"dc", "rack"})
+
+	MasterLeaderGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "is_leader",
+			Help:      "1 if the master is the raft leader.",
+		})
+
+	MasterRaftTermGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "raft_term",
+			Help:      "The current raft term.",
+		})
+
+	MasterVacuumCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "vacuum_total",
+			Help:      "Counter of vacuumed volumes or ec volumes, by committed or failed.",
+		}, []string{"type", "result"})
+
+	MasterVolumeGrowFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "master",
+			Name:      "volume_grow_failures_total",
+			Help:      "Counter of failures to grow volumes.",
+		}, []string{"collection"})
 )
 
 func init() {
@@ -100,9 +191,22 @@ func init() {
 
 	VolumeServerGather.MustRegister(VolumeServerRequestCounter)
 	VolumeServerGather.MustRegister(VolumeServerRequestHistogram)
+	VolumeServerGather.MustRegister(VolumeServerEcRecoveryCounter)
+	VolumeServerGather.MustRegister(VolumeServerEcRecoveryHistogram)
 	VolumeServerGather.MustRegister(VolumeServerVolumeCounter)
 	VolumeServerGather.MustRegister(VolumeServerMaxVolumeCounter)
 	VolumeServerGather.MustRegister(VolumeServerDiskSizeGauge)
+	VolumeServerGather.MustRegister(VolumeServerLowDiskSpaceGauge)
+
+	MasterGather.MustRegister(MasterRequestCounter)
+	MasterGather.MustRegister(MasterRequestHistogram)
+	MasterGather.MustRegister(MasterVolumeCountGauge)
+	MasterGather.MustRegister(MasterFreeSlotsGauge)
+	MasterGather.MustRegister(MasterLeaderGauge)
+	MasterGather.MustRegister(MasterRaftTermGauge)
+	MasterGather.MustRegister(MasterVacuumCounter)
+	MasterGather.MustRegister(MasterVolumeGrowFailureCounter)
+	MasterGather.MustRegister(prometheus.NewGoCollector())
 
 }
 
@@ -14,18 +14,23 @@ import (
 )
 
 type DiskLocation struct {
-	Directory      string
-	MaxVolumeCount int
-	volumes        map[needle.VolumeId]*Volume
+	Directory           string
+	MaxVolumeCount      int
+	MinFreeSpacePercent float32
+	volumes             map[needle.VolumeId]*Volume
 	sync.RWMutex
 
+	diskSpaceLow   int32
+	freeDiskBytes  uint64
+	totalDiskBytes uint64
+
 	// erasure coding
 	ecVolumes     map[needle.VolumeId]*erasure_coding.EcVolume
 	ecVolumesLock sync.RWMutex
 }
 
-func NewDiskLocation(dir string, maxVolumeCount int) *DiskLocation {
-	location := &DiskLocation{Directory: dir, MaxVolumeCount: maxVolumeCount}
+func NewDiskLocation(dir string, maxVolumeCount int, minFreeSpacePercent float32) *DiskLocation {
+	location := &DiskLocation{Directory: dir, MaxVolumeCount: maxVolumeCount, MinFreeSpacePercent: minFreeSpacePercent}
 	location.volumes = make(map[needle.VolumeId]*Volume)
 	location.ecVolumes = make(map[needle.VolumeId]*erasure_coding.EcVolume)
 	return location
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+)
+
+const diskSpaceCheckInterval = 10 * time.Second
+
+// IsDiskSpaceLow tells whether the free space of the directory is below MinFreeSpacePercent.
+// Volumes in a low location are read only, and no new volumes are created there.
+func (l *DiskLocation) IsDiskSpaceLow() bool {
+	return atomic.LoadInt32(&l.diskSpaceLow) == 1
+}
+
+// DiskSpace returns the free and total bytes of the disk from the last check, both 0 if not known.
+func (l *DiskLocation) DiskSpace() (free, total uint64) {
+	return atomic.LoadUint64(&l.freeDiskBytes), atomic.LoadUint64(&l.totalDiskBytes)
+}
+
+func (l *DiskLocation) checkDiskSpace() {
+	dir, err := filepath.Abs(l.Directory)
+	if err != nil {
+		return
+	}
+	s := stats.NewDiskStatus(dir)
+	if s.All == 0 {
+		// disk status is not supported on this platform
+		return
+	}
+	atomic.StoreUint64(&l.freeDiskBytes, s.Free)
+	atomic.StoreUint64(&l.totalDiskBytes, s.All)
+
+	percentFree := float32(s.Free) * 100 / float32(s.All)
+	isLow := percentFree < l.MinFreeSpacePercent
+
+	if isLow != l.IsDiskSpaceLow() {
+		if isLow {
+			glog.Warningf("disk space of %s is low: %.2f%% free, less than %.2f%%, volumes are read only", dir, percentFree, l.MinFreeSpacePercent)
+			atomic.StoreInt32(&l.diskSpaceLow, 1)
+		} else {
+			glog.V(0).Infof("disk space of %s is recovered: %.2f%% free", dir, percentFree)
+			atomic.StoreInt32(&l.diskSpaceLow, 0)
+		}
+	}
+
+	if isLow {
+		stats.VolumeServerLowDiskSpaceGauge.WithLabelValues(l.Directory).Set(1)
+	} else {
+		stats.VolumeServerLowDiskSpaceGauge.WithLabelValues(l.Directory).Set(0)
+	}
+}
+
+// CheckDiskSpace keeps checking the free disk space of the location.
+func (l *DiskLocation) CheckDiskSpace() {
+	for {
+		l.checkDiskSpace()
+		time.Sleep(diskSpaceCheckInterval)
+	}
+}
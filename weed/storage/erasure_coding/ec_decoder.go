@@ -0,0 +1,205 @@
+package erasure_coding
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/idx"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// the super block is not exposed from the storage package, to avoid an import cycle
+const superBlockHeaderSize = 8
+
+// WriteIdxFileFromEcIndex generates the .idx file from the .ecx file, and appends the deletions in the .ecj file
+func WriteIdxFileFromEcIndex(baseFileName string) (err error) {
+
+	ecxFile, openErr := os.OpenFile(baseFileName+".ecx", os.O_RDONLY, 0644)
+	if openErr != nil {
+		return fmt.Errorf("cannot open ec index %s.ecx: %v", baseFileName, openErr)
+	}
+	defer ecxFile.Close()
+
+	idxFile, openErr := os.OpenFile(baseFileName+".idx", os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if openErr != nil {
+		return fmt.Errorf("cannot open %s.idx: %v", baseFileName, openErr)
+	}
+	defer idxFile.Close()
+
+	if _, err = io.Copy(idxFile, ecxFile); err != nil {
+		return fmt.Errorf("copy %s.ecx to %s.idx: %v", baseFileName, baseFileName, err)
+	}
+
+	return iterateEcjFile(baseFileName, func(key types.NeedleId) error {
+		bytes := needle_map.ToBytes(key, types.Offset{}, types.TombstoneFileSize)
+		_, writeErr := idxFile.Write(bytes)
+		return writeErr
+	})
+}
+
+// FindDatFileSize calculates the .dat file size from the max offset and size in the .ecx file,
+// since the data shards are padded to full blocks.
+func FindDatFileSize(baseFileName string) (datSize int64, err error) {
+
+	version, superBlockSize, err := readEcVolumeVersion(baseFileName)
+	if err != nil {
+		return 0, fmt.Errorf("read ec volume %s version: %v", baseFileName, err)
+	}
+	datSize = superBlockSize
+
+	err = iterateEcxFile(baseFileName, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if size == types.TombstoneFileSize {
+			return nil
+		}
+		entryStopOffset := offset.ToAcutalOffset() + needle.GetActualSize(size, version)
+		if datSize < entryStopOffset {
+			datSize = entryStopOffset
+		}
+		return nil
+	})
+
+	return
+}
+
+// WriteDatFile reassembles the .dat file from the data shards .ec00 ~ .ec09, or as many as the ec scheme has
+func WriteDatFile(baseFileName string, datFileSize int64) error {
+	scheme, err := LoadEcScheme(baseFileName)
+	if err != nil {
+		return err
+	}
+	return writeDatFile(baseFileName, scheme, datFileSize, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize)
+}
+
+func writeDatFile(baseFileName string, scheme EcScheme, datFileSize int64, largeBlockSize, smallBlockSize int64) error {
+
+	datFile, err := os.OpenFile(baseFileName+".dat", os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open dat file: %v", err)
+	}
+	defer datFile.Close()
+
+	inputFiles := make([]*os.File, scheme.DataShards)
+	for shardId := 0; shardId < scheme.DataShards; shardId++ {
+		shardFileName := baseFileName + ToExt(shardId)
+		inputFiles[shardId], err = os.OpenFile(shardFileName, os.O_RDONLY, 0)
+		if err != nil {
+			return err
+		}
+		defer inputFiles[shardId].Close()
+	}
+
+	fi, err := inputFiles[0].Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %v", inputFiles[0].Name(), err)
+	}
+
+	// the encoder always ends with at least one row of small blocks, adding up to at most one large block
+	var largeRowCount int64
+	if fi.Size() > 0 {
+		largeRowCount = (fi.Size() - 1) / largeBlockSize
+	}
+
+	remainingSize := datFileSize
+	for row := int64(0); row < largeRowCount && remainingSize > 0; row++ {
+		for shardId := 0; shardId < scheme.DataShards && remainingSize > 0; shardId++ {
+			n := minInt64(largeBlockSize, remainingSize)
+			if err = copyShardBlock(datFile, inputFiles[shardId], row*largeBlockSize, n); err != nil {
+				return err
+			}
+			remainingSize -= n
+		}
+	}
+
+	shardOffset := largeRowCount * largeBlockSize
+	for remainingSize > 0 {
+		for shardId := 0; shardId < scheme.DataShards && remainingSize > 0; shardId++ {
+			n := minInt64(smallBlockSize, remainingSize)
+			if err = copyShardBlock(datFile, inputFiles[shardId], shardOffset, n); err != nil {
+				return err
+			}
+			remainingSize -= n
+		}
+		shardOffset += smallBlockSize
+	}
+
+	return nil
+}
+
+func copyShardBlock(datFile *os.File, shardFile *os.File, shardOffset int64, size int64) error {
+	n, err := io.Copy(datFile, io.NewSectionReader(shardFile, shardOffset, size))
+	if err != nil {
+		return fmt.Errorf("copy %s at %d: %v", shardFile.Name(), shardOffset, err)
+	}
+	if n != size {
+		return fmt.Errorf("copy %s at %d: read %d bytes, expecting %d", shardFile.Name(), shardOffset, n, size)
+	}
+	return nil
+}
+
+func readEcVolumeVersion(baseFileName string) (version needle.Version, superBlockSize int64, err error) {
+
+	// the super block is at the beginning of the first data shard
+	datFile, err := os.OpenFile(baseFileName+ToExt(0), os.O_RDONLY, 0644)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to open ec volume %s: %v", baseFileName, err)
+	}
+	defer datFile.Close()
+
+	header := make([]byte, superBlockHeaderSize)
+	if _, err = datFile.ReadAt(header, 0); err != nil {
+		return 0, 0, fmt.Errorf("failed to read super block of %s: %v", datFile.Name(), err)
+	}
+
+	version = needle.Version(header[0])
+	superBlockSize = superBlockHeaderSize
+	if version == needle.Version2 || version == needle.Version3 {
+		superBlockSize += int64(util.BytesToUint16(header[6:8]))
+	}
+	return
+}
+
+func iterateEcxFile(baseFileName string, processNeedleFn func(key types.NeedleId, offset types.Offset, size uint32) error) error {
+	ecxFile, openErr := os.OpenFile(baseFileName+".ecx", os.O_RDONLY, 0644)
+	if openErr != nil {
+		return fmt.Errorf("cannot open ec index %s.ecx: %v", baseFileName, openErr)
+	}
+	defer ecxFile.Close()
+
+	return idx.WalkIndexFile(ecxFile, processNeedleFn)
+}
+
+func iterateEcjFile(baseFileName string, processNeedleFn func(key types.NeedleId) error) error {
+	if !util.FileExists(baseFileName + ".ecj") {
+		return nil
+	}
+	ecjFile, openErr := os.OpenFile(baseFileName+".ecj", os.O_RDONLY, 0644)
+	if openErr != nil {
+		return fmt.Errorf("cannot open ec deletion journal %s.ecj: %v", baseFileName, openErr)
+	}
+	defer ecjFile.Close()
+
+	buf := make([]byte, types.NeedleIdSize)
+	for {
+		n, err := ecjFile.Read(buf)
+		if n != types.NeedleIdSize {
+			if err == io.EOF || err == nil {
+				return nil
+			}
+			return err
+		}
+		if err = processNeedleFn(types.BytesToNeedleId(buf)); err != nil {
+			return err
+		}
+	}
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
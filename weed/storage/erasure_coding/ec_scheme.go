@@ -0,0 +1,121 @@
+package erasure_coding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/reedsolomon"
+)
+
+// MaxShardCount is limited by the 32 bits of ShardBits
+const MaxShardCount = 32
+
+// EcScheme is the number of data shards and parity shards of an ec volume.
+// It is chosen when the volume is encoded, and kept in the .ecm file next to the .ecx file.
+type EcScheme struct {
+	DataShards   int `json:"dataShards"`
+	ParityShards int `json:"parityShards"`
+}
+
+var DefaultEcScheme = EcScheme{DataShards: DataShardsCount, ParityShards: ParityShardsCount}
+
+func NewEcScheme(dataShards, parityShards int) (EcScheme, error) {
+	scheme := EcScheme{DataShards: dataShards, ParityShards: parityShards}
+	if dataShards < 1 || parityShards < 1 {
+		return scheme, fmt.Errorf("ec scheme %s needs at least 1 data shard and 1 parity shard", scheme)
+	}
+	if scheme.TotalShards() > MaxShardCount {
+		return scheme, fmt.Errorf("ec scheme %s has more than %d shards", scheme, MaxShardCount)
+	}
+	return scheme, nil
+}
+
+// ParseEcScheme parses schemes like "10+4" or "6+3"
+func ParseEcScheme(s string) (EcScheme, error) {
+	parts := strings.Split(s, "+")
+	if len(parts) != 2 {
+		return EcScheme{}, fmt.Errorf("unknown ec scheme %s, expecting data shards+parity shards, e.g. 6+3", s)
+	}
+	dataShards, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return EcScheme{}, fmt.Errorf("ec scheme %s: %v", s, err)
+	}
+	parityShards, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return EcScheme{}, fmt.Errorf("ec scheme %s: %v", s, err)
+	}
+	return NewEcScheme(dataShards, parityShards)
+}
+
+// ToEcScheme converts the shard counts in the protobuf messages, where 0 means the default 10+4
+func ToEcScheme(dataShards, parityShards uint32) EcScheme {
+	if dataShards == 0 || parityShards == 0 {
+		return DefaultEcScheme
+	}
+	return EcScheme{DataShards: int(dataShards), ParityShards: int(parityShards)}
+}
+
+func (scheme EcScheme) TotalShards() int {
+	return scheme.DataShards + scheme.ParityShards
+}
+
+func (scheme EcScheme) String() string {
+	return fmt.Sprintf("%d+%d", scheme.DataShards, scheme.ParityShards)
+}
+
+func (scheme EcScheme) NewEncoder() (reedsolomon.Encoder, error) {
+	return reedsolomon.New(scheme.DataShards, scheme.ParityShards)
+}
+
+// ecMeta is the content of the .ecm file.
+// The generation is increased every time the ec volume is vacuumed.
+type ecMeta struct {
+	EcScheme
+	Generation uint32 `json:"generation,omitempty"`
+}
+
+// SaveEcScheme writes the .ecm file
+func SaveEcScheme(baseFileName string, scheme EcScheme) error {
+	return saveEcMeta(baseFileName, ecMeta{EcScheme: scheme})
+}
+
+// LoadEcScheme reads the .ecm file. Volumes encoded before the schemes were configurable have no .ecm file,
+// and use the default 10+4 scheme.
+func LoadEcScheme(baseFileName string) (EcScheme, error) {
+	meta, err := loadEcMeta(baseFileName)
+	return meta.EcScheme, err
+}
+
+// LoadEcGeneration reads the generation of the ec files from the .ecm file, 0 if never vacuumed
+func LoadEcGeneration(baseFileName string) (uint32, error) {
+	meta, err := loadEcMeta(baseFileName)
+	return meta.Generation, err
+}
+
+func saveEcMeta(baseFileName string, meta ecMeta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(baseFileName+".ecm", data, 0644)
+}
+
+func loadEcMeta(baseFileName string) (ecMeta, error) {
+	data, err := ioutil.ReadFile(baseFileName + ".ecm")
+	if os.IsNotExist(err) {
+		return ecMeta{EcScheme: DefaultEcScheme}, nil
+	}
+	if err != nil {
+		return ecMeta{EcScheme: DefaultEcScheme}, fmt.Errorf("read %s.ecm: %v", baseFileName, err)
+	}
+	var meta ecMeta
+	if err = json.Unmarshal(data, &meta); err != nil {
+		return ecMeta{EcScheme: DefaultEcScheme}, fmt.Errorf("parse %s.ecm: %v", baseFileName, err)
+	}
+	meta.EcScheme, err = NewEcScheme(meta.DataShards, meta.ParityShards)
+	return meta, err
+}
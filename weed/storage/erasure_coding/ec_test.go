@@ -3,12 +3,16 @@ package erasure_coding
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"os"
+	"path"
 	"testing"
 
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/klauspost/reedsolomon"
 )
 
@@ -21,7 +25,7 @@ func TestEncodingDecoding(t *testing.T) {
 	bufferSize := 50
 	baseFileName := "1"
 
-	err := generateEcFiles(baseFileName, bufferSize, largeBlockSize, smallBlockSize)
+	err := generateEcFiles(baseFileName, DefaultEcScheme, bufferSize, largeBlockSize, smallBlockSize)
 	if err != nil {
 		t.Logf("generateEcFiles: %v", err)
 	}
@@ -57,7 +61,7 @@ func validateFiles(baseFileName string) error {
 		return fmt.Errorf("failed to stat dat file: %v", err)
 	}
 
-	ecFiles, err := openEcFiles(baseFileName, true)
+	ecFiles, err := openEcFiles(baseFileName, DefaultEcScheme, true)
 	defer closeEcFiles(ecFiles)
 
 	err = cm.AscendingVisit(func(value needle_map.NeedleValue) error {
@@ -103,7 +107,7 @@ func readDatFile(datFile *os.File, offset types.Offset, size uint32) ([]byte, er
 
 func readEcFile(datSize int64, ecFiles []*os.File, offset types.Offset, size uint32) (data []byte, err error) {
 
-	intervals := LocateData(largeBlockSize, smallBlockSize, datSize, offset.ToAcutalOffset(), size)
+	intervals := LocateData(largeBlockSize, smallBlockSize, DataShardsCount, datSize, offset.ToAcutalOffset(), size)
 
 	for i, interval := range intervals {
 		if d, e := readOneInterval(interval, ecFiles); e != nil {
@@ -122,7 +126,7 @@ func readEcFile(datSize int64, ecFiles []*os.File, offset types.Offset, size uin
 
 func readOneInterval(interval Interval, ecFiles []*os.File) (data []byte, err error) {
 
-	ecFileIndex, ecFileOffset := interval.ToShardIdAndOffset(largeBlockSize, smallBlockSize)
+	ecFileIndex, ecFileOffset := interval.ToShardIdAndOffset(largeBlockSize, smallBlockSize, DataShardsCount)
 
 	data = make([]byte, interval.Size)
 	err = readFromFile(ecFiles[ecFileIndex], data, ecFileOffset)
@@ -185,7 +189,7 @@ func removeGeneratedFiles(baseFileName string) {
 }
 
 func TestLocateData(t *testing.T) {
-	intervals := LocateData(largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize, 1)
+	intervals := LocateData(largeBlockSize, smallBlockSize, DataShardsCount, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize, 1)
 	if len(intervals) != 1 {
 		t.Errorf("unexpected interval size %d", len(intervals))
 	}
@@ -193,7 +197,7 @@ func TestLocateData(t *testing.T) {
 		t.Errorf("unexpected interval %+v", intervals[0])
 	}
 
-	intervals = LocateData(largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize/2+100, DataShardsCount*largeBlockSize+1-DataShardsCount*largeBlockSize/2-100)
+	intervals = LocateData(largeBlockSize, smallBlockSize, DataShardsCount, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize/2+100, DataShardsCount*largeBlockSize+1-DataShardsCount*largeBlockSize/2-100)
 	fmt.Printf("%+v\n", intervals)
 }
 
@@ -203,3 +207,332 @@ func (this Interval) sameAs(that Interval) bool {
 		this.BlockIndex == that.BlockIndex &&
 		this.Size == that.Size
 }
+
+func TestLocateDataWithEcScheme(t *testing.T) {
+	scheme, _ := ParseEcScheme("6+3")
+	dataShards := scheme.DataShards
+
+	// the first byte after 2 rows of large blocks
+	datSize := int64(dataShards)*largeBlockSize*2 + 1
+	intervals := LocateData(largeBlockSize, smallBlockSize, dataShards, datSize, int64(dataShards)*largeBlockSize*2, 1)
+	if len(intervals) != 1 || !intervals[0].sameAs(Interval{0, 0, 1, false, 2}) {
+		t.Fatalf("unexpected intervals %+v", intervals)
+	}
+	shardId, offset := intervals[0].ToShardIdAndOffset(largeBlockSize, smallBlockSize, dataShards)
+	if shardId != 0 || offset != 2*largeBlockSize {
+		t.Errorf("unexpected shard %d offset %d", shardId, offset)
+	}
+
+	// the last byte of the first row of large blocks
+	intervals = LocateData(largeBlockSize, smallBlockSize, dataShards, datSize, int64(dataShards)*largeBlockSize-1, 1)
+	shardId, offset = intervals[0].ToShardIdAndOffset(largeBlockSize, smallBlockSize, dataShards)
+	if shardId != ShardId(dataShards-1) || offset != largeBlockSize-1 {
+		t.Errorf("unexpected shard %d offset %d", shardId, offset)
+	}
+}
+
+func TestEcScheme(t *testing.T) {
+	for _, s := range []string{"4+2", "6+3", "12+4", "10+4"} {
+		scheme, err := ParseEcScheme(s)
+		if err != nil || scheme.String() != s {
+			t.Errorf("parse %s: %v %v", s, scheme, err)
+		}
+	}
+	for _, s := range []string{"6", "0+2", "6+0", "30+4", "a+b"} {
+		if _, err := ParseEcScheme(s); err == nil {
+			t.Errorf("expected error parsing %s", s)
+		}
+	}
+	if ToEcScheme(0, 0) != DefaultEcScheme {
+		t.Errorf("expected the default scheme for old messages")
+	}
+
+	dir, err := ioutil.TempDir("", "ec_scheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	baseFileName := path.Join(dir, "1")
+
+	if scheme, err := LoadEcScheme(baseFileName); err != nil || scheme != DefaultEcScheme {
+		t.Errorf("expected the default scheme without .ecm file: %v %v", scheme, err)
+	}
+	if err := SaveEcScheme(baseFileName, EcScheme{DataShards: 6, ParityShards: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if scheme, err := LoadEcScheme(baseFileName); err != nil || scheme.String() != "6+3" {
+		t.Errorf("load saved scheme: %v %v", scheme, err)
+	}
+}
+
+func TestDecodingEcFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ec_decode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	baseFileName := path.Join(dir, "1")
+
+	datData, _ := ioutil.ReadFile("1.dat")
+	idxData, _ := ioutil.ReadFile("1.idx")
+	if err = ioutil.WriteFile(baseFileName+".idx", idxData, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(baseFileName+".dat", datData, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	scheme := EcScheme{DataShards: 6, ParityShards: 3}
+	if err = generateEcFiles(baseFileName, scheme, 50, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("generateEcFiles: %v", err)
+	}
+	if err = WriteSortedEcxFile(baseFileName); err != nil {
+		t.Fatalf("WriteSortedEcxFile: %v", err)
+	}
+	cm, _ := readCompactMap(baseFileName)
+	os.Remove(baseFileName + ".dat")
+	os.Remove(baseFileName + ".idx")
+
+	// delete one needle
+	var deletedKey types.NeedleId
+	cm.AscendingVisit(func(value needle_map.NeedleValue) error {
+		deletedKey = value.Key
+		return fmt.Errorf("stop")
+	})
+	ecjData := make([]byte, types.NeedleIdSize)
+	types.NeedleIdToBytes(ecjData, deletedKey)
+	if err = ioutil.WriteFile(baseFileName+".ecj", ecjData, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	datFileSize, err := FindDatFileSize(baseFileName)
+	if err != nil {
+		t.Fatalf("FindDatFileSize: %v", err)
+	}
+	if datFileSize > int64(len(datData)) {
+		t.Fatalf("dat file size %d is larger than the original %d", datFileSize, len(datData))
+	}
+	if err = writeDatFile(baseFileName, scheme, datFileSize, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("writeDatFile: %v", err)
+	}
+	decodedData, _ := ioutil.ReadFile(baseFileName + ".dat")
+	if !bytes.Equal(decodedData, datData[:datFileSize]) {
+		t.Errorf("decoded dat file is different from the original")
+	}
+
+	if err = WriteIdxFileFromEcIndex(baseFileName); err != nil {
+		t.Fatalf("WriteIdxFileFromEcIndex: %v", err)
+	}
+	decodedCm, err := readCompactMap(baseFileName)
+	if err != nil {
+		t.Fatalf("readCompactMap: %v", err)
+	}
+	if v, found := decodedCm.Get(deletedKey); found && v.Size != types.TombstoneFileSize {
+		t.Errorf("needle %d should be deleted", deletedKey)
+	}
+	cm.AscendingVisit(func(value needle_map.NeedleValue) error {
+		if value.Key == deletedKey {
+			return nil
+		}
+		if v, found := decodedCm.Get(value.Key); !found || v.Offset != value.Offset || v.Size != value.Size {
+			t.Errorf("needle %d: expected %+v, got %+v", value.Key, value, v)
+		}
+		return nil
+	})
+}
+
+func TestCompactEcFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ec_vacuum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	baseFileName := path.Join(dir, "1")
+
+	datData, _ := ioutil.ReadFile("1.dat")
+	idxData, _ := ioutil.ReadFile("1.idx")
+	if err = ioutil.WriteFile(baseFileName+".idx", idxData, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(baseFileName+".dat", datData, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	scheme := EcScheme{DataShards: 6, ParityShards: 3}
+	if err = SaveEcScheme(baseFileName, scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err = generateEcFiles(baseFileName, scheme, 50, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("generateEcFiles: %v", err)
+	}
+	if err = WriteSortedEcxFile(baseFileName); err != nil {
+		t.Fatalf("WriteSortedEcxFile: %v", err)
+	}
+	cm, _ := readCompactMap(baseFileName)
+
+	version, _, _ := readEcVolumeVersion(baseFileName)
+	originalGarbageRatio, err := FindEcGarbageRatio(baseFileName)
+	if err != nil {
+		t.Fatalf("FindEcGarbageRatio: %v", err)
+	}
+
+	// delete every other needle in the .ecx file
+	ecxFile, err := os.OpenFile(baseFileName+".ecx", os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi, _ := ecxFile.Stat()
+	deleted := make(map[types.NeedleId]bool)
+	var i int
+	var deletedSize int64
+	cm.AscendingVisit(func(value needle_map.NeedleValue) error {
+		if i%2 == 0 {
+			deleted[value.Key] = true
+			deletedSize += needle.GetActualSize(value.Size, version)
+			searchNeedleFromEcx(ecxFile, fi.Size(), value.Key, markNeedleDeleted)
+		}
+		i++
+		return nil
+	})
+	ecxFile.Close()
+
+	garbageRatio, err := FindEcGarbageRatio(baseFileName)
+	if err != nil || garbageRatio <= originalGarbageRatio {
+		t.Errorf("unexpected garbage ratio %f, before deletions %f: %v", garbageRatio, originalGarbageRatio, err)
+	}
+
+	if err = compactEcFiles(baseFileName, 50, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("compactEcFiles: %v", err)
+	}
+	compactedBaseFileName := CompactedBaseFileName(baseFileName)
+	if generation, _ := LoadEcGeneration(compactedBaseFileName); generation != 1 {
+		t.Errorf("unexpected compacted generation %d", generation)
+	}
+	if garbageRatio, _ := FindEcGarbageRatio(compactedBaseFileName); garbageRatio > 0.01 {
+		t.Errorf("unexpected compacted garbage ratio %f", garbageRatio)
+	}
+
+	// the live needles should be the same after decoding the compacted ec files
+	datFileSize, err := FindDatFileSize(compactedBaseFileName)
+	if err != nil {
+		t.Fatalf("FindDatFileSize: %v", err)
+	}
+	if err = writeDatFile(compactedBaseFileName, scheme, datFileSize, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("writeDatFile: %v", err)
+	}
+	compactedDatData, _ := ioutil.ReadFile(compactedBaseFileName + ".dat")
+	if int64(len(compactedDatData)) > int64(len(datData))-deletedSize {
+		t.Errorf("compacted dat file size %d, original %d, deleted %d", len(compactedDatData), len(datData), deletedSize)
+	}
+	err = iterateEcxFile(compactedBaseFileName, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if deleted[key] {
+			return fmt.Errorf("needle %d should be deleted", key)
+		}
+		original, _ := cm.Get(key)
+		actualSize := needle.GetActualSize(size, version)
+		originalOffset := original.Offset.ToAcutalOffset()
+		if !bytes.Equal(compactedDatData[offset.ToAcutalOffset():offset.ToAcutalOffset()+actualSize], datData[originalOffset:originalOffset+actualSize]) {
+			return fmt.Errorf("needle %d is different after compaction", key)
+		}
+		delete(deleted, key)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if err = PrepareCompactedEcFiles(baseFileName, []ShardId{0, 1, 2}); err != nil {
+		t.Fatalf("PrepareCompactedEcFiles: %v", err)
+	}
+	if err = PrepareCompactedEcFiles(baseFileName, []ShardId{0, 1, 2, MaxShardCount - 1}); err == nil {
+		t.Errorf("expected missing compacted shard found when preparing")
+	}
+	if _, found := FindGenerationBaseFileName(baseFileName, 1); !found {
+		t.Errorf("expected the compacted generation readable before committing")
+	}
+
+	if err = CommitCompactedEcFiles(baseFileName, []ShardId{0, 1, 2}); err != nil {
+		t.Fatalf("CommitCompactedEcFiles: %v", err)
+	}
+	if generation, _ := LoadEcGeneration(baseFileName); generation != 1 {
+		t.Errorf("unexpected committed generation %d", generation)
+	}
+	if util.FileExists(compactedBaseFileName + ToExt(3)) {
+		t.Errorf("compacted files should be cleaned up")
+	}
+	// the previous generation is still readable until the backup files are removed
+	if backupBaseFileName, found := FindGenerationBaseFileName(baseFileName, 0); !found || backupBaseFileName != BackupBaseFileName(baseFileName) {
+		t.Errorf("expected the previous generation in the backup files, got %s", backupBaseFileName)
+	}
+
+	// delete a needle on the committed generation, and roll back
+	var liveKey types.NeedleId
+	iterateEcxFile(baseFileName, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if liveKey == 0 && size != types.TombstoneFileSize {
+			liveKey = key
+		}
+		return nil
+	})
+	ecjFile, err := os.OpenFile(baseFileName+".ecj", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, types.NeedleIdSize)
+	types.NeedleIdToBytes(b, liveKey)
+	ecjFile.Write(b)
+	ecjFile.Close()
+
+	if err = RollbackCompactedEcFiles(baseFileName, []ShardId{0, 1, 2}); err != nil {
+		t.Fatalf("RollbackCompactedEcFiles: %v", err)
+	}
+	if generation, _ := LoadEcGeneration(baseFileName); generation != 0 {
+		t.Errorf("unexpected generation %d after rollback", generation)
+	}
+	if _, size, err := FindNeedleFromEcxFile(baseFileName, liveKey); err != nil || size != types.TombstoneFileSize {
+		t.Errorf("expected needle %d deleted after rollback, size %d: %v", liveKey, size, err)
+	}
+
+	RemoveBackupEcFiles(baseFileName)
+	if util.FileExists(BackupBaseFileName(baseFileName) + ".ecx") {
+		t.Errorf("backup files should be removed")
+	}
+}
+
+func TestVerifyEcStripe(t *testing.T) {
+	scheme := EcScheme{DataShards: 6, ParityShards: 3}
+	enc, err := scheme.NewEncoder()
+	if err != nil {
+		t.Fatalf("new encoder: %v", err)
+	}
+
+	shards := make([][]byte, scheme.TotalShards())
+	for i := range shards {
+		shards[i] = make([]byte, smallBlockSize)
+		if i < scheme.DataShards {
+			rand.Read(shards[i])
+		}
+	}
+	if err = enc.Encode(shards); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	if ok, badShardIds, err := VerifyEcStripe(enc, shards); !ok || len(badShardIds) != 0 || err != nil {
+		t.Fatalf("verify good stripe: %v %v %v", ok, badShardIds, err)
+	}
+
+	for _, corrupted := range []int{0, 5, 7} {
+		original := shards[corrupted][3]
+		shards[corrupted][3] = original + 1
+		ok, badShardIds, err := VerifyEcStripe(enc, shards)
+		if ok || err != nil || len(badShardIds) != 1 || badShardIds[0] != ShardId(corrupted) {
+			t.Errorf("verify stripe with shard %d corrupted: %v %v %v", corrupted, ok, badShardIds, err)
+		}
+		shards[corrupted][3] = original
+	}
+
+	shards[1][0]++
+	shards[2][0]++
+	if ok, badShardIds, err := VerifyEcStripe(enc, shards); ok || len(badShardIds) != 0 || err != nil {
+		t.Errorf("verify stripe with 2 shards corrupted: %v %v %v", ok, badShardIds, err)
+	}
+}
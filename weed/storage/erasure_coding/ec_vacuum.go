@@ -0,0 +1,388 @@
+package erasure_coding
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+/*
+
+Steps to vacuum an ec volume
+1. one volume server with all the data shards writes the live needles into the next generation of ec files,
+   named as <base>.cpd.ecx, <base>.cpd.ecm and <base>.cpd.ec00 ~ <base>.cpd.ec13
+2. the other volume servers with the ec shards copy the compacted .ecx, .ecm and their own shards
+3. every volume server checks it has all the compacted files, before any of them commits
+4. each volume server commits by replacing the ec files with the compacted ones, with the deletions
+   during the compaction applied, and keeps the replaced files as <base>.bak.* files.
+   The generation in the .ecm file prevents reading shards across generations. Until all the volume servers
+   have committed, the shards of either generation are read from the backup or the compacted files.
+5. if any volume server fails to commit, the committed ones roll back to the backup files
+6. the compacted and the backup files are cleaned up at the end
+
+*/
+
+// CompactedExt is added to the base file name for the next generation of the ec files
+const CompactedExt = ".cpd"
+
+// BackupExt is added to the base file name for the previous generation of the ec files
+const BackupExt = ".bak"
+
+func CompactedBaseFileName(baseFileName string) string {
+	return baseFileName + CompactedExt
+}
+
+func BackupBaseFileName(baseFileName string) string {
+	return baseFileName + BackupExt
+}
+
+// FindEcGarbageRatio estimates the ratio of the deleted needles from the .ecx file.
+// The deleted entries keep their offsets in the .ecx file, but not their sizes.
+func FindEcGarbageRatio(baseFileName string) (float64, error) {
+
+	version, superBlockSize, err := readEcVolumeVersion(baseFileName)
+	if err != nil {
+		// the first data shard may not be local
+		version, superBlockSize = needle.CurrentVersion, superBlockHeaderSize
+	}
+
+	var liveSize int64
+	datSize := superBlockSize
+	err = iterateEcxFile(baseFileName, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if offset.IsZero() {
+			return nil
+		}
+		stopOffset := offset.ToAcutalOffset()
+		if size != types.TombstoneFileSize {
+			actualSize := needle.GetActualSize(size, version)
+			liveSize += actualSize
+			stopOffset += actualSize
+		}
+		if datSize < stopOffset {
+			datSize = stopOffset
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if datSize <= superBlockSize {
+		return 0, nil
+	}
+	return 1 - float64(liveSize)/float64(datSize-superBlockSize), nil
+}
+
+// CompactEcFiles writes the live needles into the next generation of the ec files.
+// All the data shards need to be local.
+func CompactEcFiles(baseFileName string) error {
+	return compactEcFiles(baseFileName, 256*1024, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize)
+}
+
+func compactEcFiles(baseFileName string, bufferSize int, largeBlockSize int64, smallBlockSize int64) error {
+
+	meta, err := loadEcMeta(baseFileName)
+	if err != nil {
+		return err
+	}
+
+	compactedBaseFileName := CompactedBaseFileName(baseFileName)
+	defer os.Remove(compactedBaseFileName + ".dat")
+	defer os.Remove(compactedBaseFileName + ".idx")
+
+	if err = writeCompactedDatFile(baseFileName, compactedBaseFileName, meta.EcScheme, largeBlockSize, smallBlockSize); err != nil {
+		return fmt.Errorf("write compacted dat file: %v", err)
+	}
+
+	if err = WriteSortedEcxFile(compactedBaseFileName); err != nil {
+		return fmt.Errorf("write compacted ecx file: %v", err)
+	}
+
+	meta.Generation++
+	if err = saveEcMeta(compactedBaseFileName, meta); err != nil {
+		return fmt.Errorf("write compacted ecm file: %v", err)
+	}
+
+	return generateEcFiles(compactedBaseFileName, meta.EcScheme, bufferSize, largeBlockSize, smallBlockSize)
+}
+
+func writeCompactedDatFile(baseFileName, compactedBaseFileName string, scheme EcScheme, largeBlockSize int64, smallBlockSize int64) error {
+
+	dataShards := make([]*os.File, scheme.DataShards)
+	for shardId := 0; shardId < scheme.DataShards; shardId++ {
+		file, err := os.OpenFile(baseFileName+ToExt(shardId), os.O_RDONLY, 0)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		dataShards[shardId] = file
+	}
+	fi, err := dataShards[0].Stat()
+	if err != nil {
+		return err
+	}
+	datSize := fi.Size() * int64(scheme.DataShards)
+
+	version, superBlockSize, err := readEcVolumeVersion(baseFileName)
+	if err != nil {
+		return err
+	}
+	superBlock, err := readEcData(dataShards, largeBlockSize, smallBlockSize, datSize, 0, superBlockSize)
+	if err != nil {
+		return fmt.Errorf("read super block: %v", err)
+	}
+	// increase the compaction revision in byte 4 and byte 5
+	util.Uint16toBytes(superBlock[4:6], util.BytesToUint16(superBlock[4:6])+1)
+
+	datFile, err := os.OpenFile(compactedBaseFileName+".dat", os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer datFile.Close()
+	idxFile, err := os.OpenFile(compactedBaseFileName+".idx", os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer idxFile.Close()
+
+	if _, err = datFile.Write(superBlock); err != nil {
+		return err
+	}
+	newOffset := superBlockSize
+
+	return iterateEcxFile(baseFileName, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if offset.IsZero() || size == types.TombstoneFileSize {
+			return nil
+		}
+
+		if newOffset%types.NeedlePaddingSize != 0 {
+			padding := types.NeedlePaddingSize - newOffset%types.NeedlePaddingSize
+			if _, err := datFile.Write(make([]byte, padding)); err != nil {
+				return err
+			}
+			newOffset += padding
+		}
+
+		data, err := readEcData(dataShards, largeBlockSize, smallBlockSize, datSize, offset.ToAcutalOffset(), needle.GetActualSize(size, version))
+		if err != nil {
+			return fmt.Errorf("read needle %d: %v", key, err)
+		}
+		if _, err = datFile.Write(data); err != nil {
+			return err
+		}
+		if _, err = idxFile.Write(needle_map.ToBytes(key, types.ToOffset(newOffset), size)); err != nil {
+			return err
+		}
+		newOffset += int64(len(data))
+
+		return nil
+	})
+}
+
+func readEcData(dataShards []*os.File, largeBlockSize, smallBlockSize int64, datSize int64, offset int64, size int64) ([]byte, error) {
+	data := make([]byte, 0, size)
+	for _, interval := range LocateData(largeBlockSize, smallBlockSize, len(dataShards), datSize, offset, uint32(size)) {
+		shardId, shardOffset := interval.ToShardIdAndOffset(largeBlockSize, smallBlockSize, len(dataShards))
+		buf := make([]byte, interval.Size)
+		n, err := dataShards[shardId].ReadAt(buf, shardOffset)
+		if err != nil && !(err == io.EOF && n == len(buf)) {
+			return nil, fmt.Errorf("read %s at %d: %v", dataShards[shardId].Name(), shardOffset, err)
+		}
+		data = append(data, buf...)
+	}
+	return data, nil
+}
+
+// PrepareCompactedEcFiles checks the compacted .ecx, .ecm and ec shard files are all local before committing.
+func PrepareCompactedEcFiles(baseFileName string, shardIds []ShardId) error {
+
+	compactedBaseFileName := CompactedBaseFileName(baseFileName)
+
+	for _, ext := range []string{".ecx", ".ecm"} {
+		if !util.FileExists(compactedBaseFileName + ext) {
+			return fmt.Errorf("compacted file %s%s not found", compactedBaseFileName, ext)
+		}
+	}
+	for _, shardId := range shardIds {
+		if !util.FileExists(compactedBaseFileName + ToExt(int(shardId))) {
+			return fmt.Errorf("compacted ec shard %s%s not found", compactedBaseFileName, ToExt(int(shardId)))
+		}
+	}
+	return nil
+}
+
+// CommitCompactedEcFiles replaces the local ec shards, .ecx and .ecm files with the compacted ones.
+// The deletions in the .ecj file, including those during the compaction, are applied to the compacted .ecx file.
+// The replaced files are kept as the backup files, to serve the previous generation and to roll back,
+// until RemoveBackupEcFiles is called.
+// The ec shards should not be mounted when calling this.
+func CommitCompactedEcFiles(baseFileName string, shardIds []ShardId) error {
+
+	if err := PrepareCompactedEcFiles(baseFileName, shardIds); err != nil {
+		return err
+	}
+
+	compactedBaseFileName := CompactedBaseFileName(baseFileName)
+	backupBaseFileName := BackupBaseFileName(baseFileName)
+
+	if err := applyEcjToEcx(baseFileName, compactedBaseFileName); err != nil {
+		return fmt.Errorf("apply deletions to compacted ecx file: %v", err)
+	}
+
+	exts := []string{".ecx", ".ecm", ".ecj"}
+	for _, shardId := range shardIds {
+		exts = append(exts, ToExt(int(shardId)))
+	}
+
+	RemoveBackupEcFiles(baseFileName)
+	for _, ext := range exts {
+		if err := renameIfExists(baseFileName+ext, backupBaseFileName+ext); err != nil {
+			return err
+		}
+	}
+	for _, ext := range exts {
+		if err := renameIfExists(compactedBaseFileName+ext, baseFileName+ext); err != nil {
+			return err
+		}
+	}
+
+	CleanupCompactedEcFiles(baseFileName)
+
+	return nil
+}
+
+// RollbackCompactedEcFiles restores the backup ec files replaced by CommitCompactedEcFiles.
+// The deletions on the compacted generation are applied to the restored .ecx file.
+// The ec shards should not be mounted when calling this.
+func RollbackCompactedEcFiles(baseFileName string, shardIds []ShardId) error {
+
+	backupBaseFileName := BackupBaseFileName(baseFileName)
+	if !util.FileExists(backupBaseFileName + ".ecx") {
+		return fmt.Errorf("backup file %s.ecx not found", backupBaseFileName)
+	}
+
+	if err := applyEcjToEcx(baseFileName, backupBaseFileName); err != nil {
+		return fmt.Errorf("apply deletions to backup ecx file: %v", err)
+	}
+	if err := appendEcjFile(baseFileName, backupBaseFileName); err != nil {
+		return fmt.Errorf("append deletions to backup ecj file: %v", err)
+	}
+
+	exts := []string{".ecx", ".ecm", ".ecj"}
+	for _, shardId := range shardIds {
+		exts = append(exts, ToExt(int(shardId)))
+	}
+	for _, ext := range exts {
+		if util.FileExists(backupBaseFileName + ext) {
+			if err := os.Rename(backupBaseFileName+ext, baseFileName+ext); err != nil {
+				return err
+			}
+		} else if ext == ".ecm" || ext == ".ecj" {
+			// the ec volume had no .ecm file before the ec schemes were configurable, or no deletions
+			os.Remove(baseFileName + ext)
+		}
+	}
+
+	return nil
+}
+
+// FindGenerationBaseFileName finds the ec files of another generation.
+// While the ec volume is being swapped to the vacuumed generation, the volume servers can have
+// the previous generation as the backup files, or the next generation as the compacted files.
+func FindGenerationBaseFileName(baseFileName string, generation uint32) (string, bool) {
+	for _, candidate := range []string{BackupBaseFileName(baseFileName), CompactedBaseFileName(baseFileName)} {
+		if !util.FileExists(candidate + ".ecx") {
+			continue
+		}
+		if candidateGeneration, err := LoadEcGeneration(candidate); err == nil && candidateGeneration == generation {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// FindNeedleFromEcxFile looks up the needle in the .ecx file of the base file name
+func FindNeedleFromEcxFile(baseFileName string, needleId types.NeedleId) (offset types.Offset, size uint32, err error) {
+	ecxFile, err := os.OpenFile(baseFileName+".ecx", os.O_RDONLY, 0644)
+	if err != nil {
+		return types.Offset{}, 0, err
+	}
+	defer ecxFile.Close()
+
+	fi, err := ecxFile.Stat()
+	if err != nil {
+		return types.Offset{}, 0, err
+	}
+	return searchNeedleFromEcx(ecxFile, fi.Size(), needleId, nil)
+}
+
+func applyEcjToEcx(ecjBaseFileName, ecxBaseFileName string) error {
+
+	ecxFile, err := os.OpenFile(ecxBaseFileName+".ecx", os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	defer ecxFile.Close()
+
+	fi, err := ecxFile.Stat()
+	if err != nil {
+		return err
+	}
+
+	return iterateEcjFile(ecjBaseFileName, func(key types.NeedleId) error {
+		_, _, err := searchNeedleFromEcx(ecxFile, fi.Size(), key, markNeedleDeleted)
+		if err != nil && err != NotFoundError {
+			return err
+		}
+		return nil
+	})
+}
+
+func appendEcjFile(fromBaseFileName, toBaseFileName string) error {
+	if !util.FileExists(fromBaseFileName + ".ecj") {
+		return nil
+	}
+	data, err := ioutil.ReadFile(fromBaseFileName + ".ecj")
+	if err != nil || len(data) == 0 {
+		return err
+	}
+	ecjFile, err := os.OpenFile(toBaseFileName+".ecj", os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer ecjFile.Close()
+	_, err = ecjFile.Write(data)
+	return err
+}
+
+func renameIfExists(from, to string) error {
+	if !util.FileExists(from) {
+		return nil
+	}
+	return os.Rename(from, to)
+}
+
+// CleanupCompactedEcFiles removes any compacted ec files
+func CleanupCompactedEcFiles(baseFileName string) {
+	removeEcFiles(CompactedBaseFileName(baseFileName), ".dat", ".idx")
+}
+
+// RemoveBackupEcFiles removes the previous generation kept by CommitCompactedEcFiles
+func RemoveBackupEcFiles(baseFileName string) {
+	removeEcFiles(BackupBaseFileName(baseFileName), ".ecj")
+}
+
+func removeEcFiles(baseFileName string, otherExts ...string) {
+	for shardId := 0; shardId < MaxShardCount; shardId++ {
+		os.Remove(baseFileName + ToExt(shardId))
+	}
+	for _, ext := range append([]string{".ecx", ".ecm"}, otherExts...) {
+		os.Remove(baseFileName + ext)
+	}
+}
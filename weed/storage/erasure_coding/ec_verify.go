@@ -0,0 +1,35 @@
+package erasure_coding
+
+import (
+	"github.com/klauspost/reedsolomon"
+)
+
+// VerifyEcStripe checks the parity of the shards read at the same shard offset.
+// If the parity does not match, it finds the corrupted shard by reconstructing each shard from the other ones,
+// which requires at least 2 parity shards. No bad shard ids are returned if the corrupted shard can not be found,
+// e.g., more than one shard are corrupted in the stripe.
+func VerifyEcStripe(enc reedsolomon.Encoder, shards [][]byte) (ok bool, badShardIds []ShardId, err error) {
+
+	if ok, err = enc.Verify(shards); ok || err != nil {
+		return
+	}
+
+	for i := range shards {
+		candidate := make([][]byte, len(shards))
+		copy(candidate, shards)
+		candidate[i] = nil
+		if err = enc.Reconstruct(candidate); err != nil {
+			return false, nil, err
+		}
+		if verified, _ := enc.Verify(candidate); verified {
+			badShardIds = append(badShardIds, ShardId(i))
+		}
+	}
+
+	// if the stripe can be fixed by replacing more than one shard, the corrupted shard is not certain
+	if len(badShardIds) > 1 {
+		badShardIds = nil
+	}
+
+	return false, badShardIds, nil
+}
@@ -31,6 +31,8 @@ type EcVolume struct {
 	ShardLocationsRefreshTime time.Time
 	ShardLocationsLock        sync.RWMutex
 	Version                   needle.Version
+	Scheme                    EcScheme
+	Generation                uint32
 	ecjFile                   *os.File
 	ecjFileAccessLock         sync.Mutex
 }
@@ -51,6 +53,13 @@ func NewEcVolume(dir string, collection string, vid needle.VolumeId) (ev *EcVolu
 	ev.ecxFileSize = ecxFi.Size()
 	ev.ecxCreatedAt = ecxFi.ModTime()
 
+	if ev.Scheme, err = LoadEcScheme(baseFileName); err != nil {
+		return nil, err
+	}
+	if ev.Generation, err = LoadEcGeneration(baseFileName); err != nil {
+		return nil, err
+	}
+
 	// open ecj file
 	if ev.ecjFile, err = os.OpenFile(baseFileName+".ecj", os.O_RDWR|os.O_CREATE, 0644); err != nil {
 		return nil, fmt.Errorf("cannot open ec volume journal %s.ecj: %v", baseFileName, err)
@@ -126,6 +135,7 @@ func (ev *EcVolume) Destroy() {
 	}
 	os.Remove(ev.FileName() + ".ecx")
 	os.Remove(ev.FileName() + ".ecj")
+	os.Remove(ev.FileName() + ".ecm")
 }
 
 func (ev *EcVolume) FileName() string {
@@ -158,8 +168,10 @@ func (ev *EcVolume) ToVolumeEcShardInformationMessage() (messages []*master_pb.V
 	for _, s := range ev.Shards {
 		if s.VolumeId != prevVolumeId {
 			m = &master_pb.VolumeEcShardInformationMessage{
-				Id:         uint32(s.VolumeId),
-				Collection: s.Collection,
+				Id:           uint32(s.VolumeId),
+				Collection:   s.Collection,
+				DataShards:   uint32(ev.Scheme.DataShards),
+				ParityShards: uint32(ev.Scheme.ParityShards),
 			}
 			messages = append(messages, m)
 		}
@@ -180,7 +192,7 @@ func (ev *EcVolume) LocateEcShardNeedle(needleId types.NeedleId, version needle.
 	shard := ev.Shards[0]
 
 	// calculate the locations in the ec shards
-	intervals = LocateData(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, DataShardsCount*shard.ecdFileSize, offset.ToAcutalOffset(), uint32(needle.GetActualSize(size, version)))
+	intervals = LocateData(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, ev.Scheme.DataShards, int64(ev.Scheme.DataShards)*shard.ecdFileSize, offset.ToAcutalOffset(), uint32(needle.GetActualSize(size, version)))
 
 	return
 }
@@ -201,7 +213,7 @@ func searchNeedleFromEcx(ecxFile *os.File, ecxFileSize int64, needleId types.Nee
 		key, offset, size = idx.IdxFileEntry(buf)
 		if key == needleId {
 			if processNeedleFn != nil {
-				err = processNeedleFn(ecxFile, m*types.NeedleHeaderSize)
+				err = processNeedleFn(ecxFile, m*types.NeedleMapEntrySize)
 			}
 			return
 		}
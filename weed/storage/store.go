@@ -3,12 +3,14 @@ package storage
 import (
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/karlseguin/ccache"
 	"google.golang.org/grpc"
 )
 
@@ -35,6 +37,10 @@ type Store struct {
 	DeletedVolumesChan  chan master_pb.VolumeShortInformationMessage
 	NewEcShardsChan     chan master_pb.VolumeEcShardInformationMessage
 	DeletedEcShardsChan chan master_pb.VolumeEcShardInformationMessage
+	draining            int32 // no new volumes when evacuating the volume server
+	ecRecoveryCache     *ccache.Cache
+	ecRecoveryTimeout   time.Duration
+	labels              map[string]string // reported to the master for the volume placement
 }
 
 func (s *Store) String() (str string) {
@@ -42,15 +48,18 @@ func (s *Store) String() (str string) {
 	return
 }
 
-func NewStore(grpcDialOption grpc.DialOption, port int, ip, publicUrl string, dirnames []string, maxVolumeCounts []int, needleMapKind NeedleMapType) (s *Store) {
+func NewStore(grpcDialOption grpc.DialOption, port int, ip, publicUrl string, dirnames []string, maxVolumeCounts []int, minFreeSpacePercents []float32, needleMapKind NeedleMapType) (s *Store) {
 	s = &Store{grpcDialOption: grpcDialOption, Port: port, Ip: ip, PublicUrl: publicUrl, NeedleMapType: needleMapKind}
 	s.Locations = make([]*DiskLocation, 0)
 	for i := 0; i < len(dirnames); i++ {
-		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i])
+		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i], minFreeSpacePercents[i])
 		location.loadExistingVolumes(needleMapKind)
+		location.checkDiskSpace()
+		go location.CheckDiskSpace()
 		s.Locations = append(s.Locations, location)
 		stats.VolumeServerMaxVolumeCounter.Add(float64(maxVolumeCounts[i]))
 	}
+	s.loadDraining()
 	s.NewVolumesChan = make(chan master_pb.VolumeShortInformationMessage, 3)
 	s.DeletedVolumesChan = make(chan master_pb.VolumeShortInformationMessage, 3)
 
@@ -83,16 +92,26 @@ func (s *Store) DeleteCollection(collection string) (e error) {
 }
 
 func (s *Store) findVolume(vid needle.VolumeId) *Volume {
+	v, _ := s.findVolumeLocation(vid)
+	return v
+}
+func (s *Store) findVolumeLocation(vid needle.VolumeId) (*Volume, *DiskLocation) {
 	for _, location := range s.Locations {
 		if v, found := location.FindVolume(vid); found {
-			return v
+			return v, location
 		}
 	}
-	return nil
+	return nil, nil
 }
 func (s *Store) FindFreeLocation() (ret *DiskLocation) {
+	if s.IsDraining() {
+		return nil
+	}
 	max := 0
 	for _, location := range s.Locations {
+		if location.IsDiskSpaceLow() {
+			continue
+		}
 		currentFreeCount := location.MaxVolumeCount - location.VolumesLen()
 		if currentFreeCount > max {
 			max = currentFreeCount
@@ -158,21 +177,43 @@ func (s *Store) SetDataCenter(dataCenter string) {
 func (s *Store) SetRack(rack string) {
 	s.rack = rack
 }
+func (s *Store) SetLabels(labels map[string]string) {
+	s.labels = labels
+}
 
 func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	var volumeMessages []*master_pb.VolumeInformationMessage
+	var lowDiskSpaceDirs []string
+	var freeDiskBytes, totalDiskBytes uint64
 	maxVolumeCount := 0
 	var maxFileKey NeedleId
 	collectionVolumeSize := make(map[string]uint64)
+	isDraining := s.IsDraining()
 	for _, location := range s.Locations {
-		maxVolumeCount = maxVolumeCount + location.MaxVolumeCount
+		isDiskSpaceLow := location.IsDiskSpaceLow()
+		// the directories on the same disk are counted once each
+		free, total := location.DiskSpace()
+		freeDiskBytes, totalDiskBytes = freeDiskBytes+free, totalDiskBytes+total
 		location.Lock()
+		if isDiskSpaceLow {
+			lowDiskSpaceDirs = append(lowDiskSpaceDirs, location.Directory)
+		}
+		if isDiskSpaceLow || isDraining {
+			// no free slots on a location low in disk space, or on a draining volume server
+			maxVolumeCount = maxVolumeCount + len(location.volumes)
+		} else {
+			maxVolumeCount = maxVolumeCount + location.MaxVolumeCount
+		}
 		for _, v := range location.volumes {
 			if maxFileKey < v.nm.MaxFileKey() {
 				maxFileKey = v.nm.MaxFileKey()
 			}
 			if !v.expired(s.GetVolumeSizeLimit()) {
-				volumeMessages = append(volumeMessages, v.ToVolumeInformationMessage())
+				volumeMessage := v.ToVolumeInformationMessage()
+				if isDiskSpaceLow {
+					volumeMessage.ReadOnly = true
+				}
+				volumeMessages = append(volumeMessages, volumeMessage)
 			} else {
 				if v.expiredLongEnough(MAX_TTL_VOLUME_REMOVAL_DELAY) {
 					location.deleteVolumeById(v.Id)
@@ -192,15 +233,19 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 	}
 
 	return &master_pb.Heartbeat{
-		Ip:             s.Ip,
-		Port:           uint32(s.Port),
-		PublicUrl:      s.PublicUrl,
-		MaxVolumeCount: uint32(maxVolumeCount),
-		MaxFileKey:     NeedleIdToUint64(maxFileKey),
-		DataCenter:     s.dataCenter,
-		Rack:           s.rack,
-		Volumes:        volumeMessages,
-		HasNoVolumes:   len(volumeMessages) == 0,
+		Ip:               s.Ip,
+		Port:             uint32(s.Port),
+		PublicUrl:        s.PublicUrl,
+		MaxVolumeCount:   uint32(maxVolumeCount),
+		MaxFileKey:       NeedleIdToUint64(maxFileKey),
+		DataCenter:       s.dataCenter,
+		Rack:             s.rack,
+		Volumes:          volumeMessages,
+		HasNoVolumes:     len(volumeMessages) == 0,
+		LowDiskSpaceDirs: lowDiskSpaceDirs,
+		Labels:           s.labels,
+		FreeDiskBytes:    freeDiskBytes,
+		TotalDiskBytes:   totalDiskBytes,
 	}
 
 }
@@ -212,13 +257,30 @@ func (s *Store) Close() {
 }
 
 func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle) (size uint32, isUnchanged bool, err error) {
-	if v := s.findVolume(i); v != nil {
-		if v.readOnly {
+	return s.writeVolumeNeedle(i, n, false)
+}
+
+// WriteReadOnlyVolumeNeedle also writes to a read only volume, e.g., the target volumes of volume.mirror
+func (s *Store) WriteReadOnlyVolumeNeedle(i needle.VolumeId, n *needle.Needle) (size uint32, isUnchanged bool, err error) {
+	return s.writeVolumeNeedle(i, n, true)
+}
+
+func (s *Store) writeVolumeNeedle(i needle.VolumeId, n *needle.Needle, ignoreReadOnly bool) (size uint32, isUnchanged bool, err error) {
+	if v, location := s.findVolumeLocation(i); v != nil {
+		if v.readOnly && !ignoreReadOnly {
 			err = fmt.Errorf("volume %d is read only", i)
 			return
 		}
+		if location.IsDiskSpaceLow() {
+			err = fmt.Errorf("volume %d is read only: low disk space in %s", i, location.Directory)
+			return
+		}
 		if MaxPossibleVolumeSize >= v.ContentSize()+uint64(needle.GetActualSize(size, v.version)) {
-			_, size, isUnchanged, err = v.writeNeedle(n)
+			if ignoreReadOnly {
+				_, size, isUnchanged, err = v.doWriteNeedle(n)
+			} else {
+				_, size, isUnchanged, err = v.writeNeedle(n)
+			}
 		} else {
 			err = fmt.Errorf("volume size limit %d exceeded! current size is %d", s.GetVolumeSizeLimit(), v.ContentSize())
 		}
@@ -230,11 +292,23 @@ func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle) (size uin
 }
 
 func (s *Store) DeleteVolumeNeedle(i needle.VolumeId, n *needle.Needle) (uint32, error) {
+	return s.deleteVolumeNeedle(i, n, false)
+}
+
+// DeleteReadOnlyVolumeNeedle also deletes from a read only volume, e.g., the target volumes of volume.mirror
+func (s *Store) DeleteReadOnlyVolumeNeedle(i needle.VolumeId, n *needle.Needle) (uint32, error) {
+	return s.deleteVolumeNeedle(i, n, true)
+}
+
+func (s *Store) deleteVolumeNeedle(i needle.VolumeId, n *needle.Needle, ignoreReadOnly bool) (uint32, error) {
 	if v := s.findVolume(i); v != nil {
-		if v.readOnly {
+		if v.readOnly && !ignoreReadOnly {
 			return 0, fmt.Errorf("volume %d is read only", i)
 		}
 		if MaxPossibleVolumeSize >= v.ContentSize()+uint64(needle.GetActualSize(0, v.version)) {
+			if ignoreReadOnly {
+				return v.doDeleteNeedle(n)
+			}
 			return v.deleteNeedle(n)
 		} else {
 			return 0, fmt.Errorf("volume size limit %d exceeded! current size is %d", s.GetVolumeSizeLimit(), v.ContentSize())
@@ -249,6 +323,14 @@ func (s *Store) ReadVolumeNeedle(i needle.VolumeId, n *needle.Needle) (int, erro
 	}
 	return 0, fmt.Errorf("volume %d not found", i)
 }
+func (s *Store) ReadVolumeNeedleBlob(i needle.VolumeId, id NeedleId) ([]byte, needle.Version, error) {
+	if v := s.findVolume(i); v != nil {
+		blob, err := v.readNeedleBlob(id)
+		return blob, v.Version(), err
+	}
+	return nil, 0, fmt.Errorf("volume %d not found", i)
+}
+
 func (s *Store) GetVolume(i needle.VolumeId) *Volume {
 	return s.findVolume(i)
 }
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// drainingMarkerFile is kept in each data directory of a draining volume server,
+// so the volume server stays drained across restarts.
+const drainingMarkerFile = "draining"
+
+// SetDraining stops or resumes accepting new volumes.
+// A draining volume server reports no free volume slots to the master.
+func (s *Store) SetDraining(isDraining bool) error {
+	for _, location := range s.Locations {
+		markerFile := filepath.Join(location.Directory, drainingMarkerFile)
+		if isDraining {
+			if err := ioutil.WriteFile(markerFile, []byte{}, 0644); err != nil {
+				return err
+			}
+		} else {
+			if err := os.Remove(markerFile); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	if isDraining {
+		atomic.StoreInt32(&s.draining, 1)
+		glog.V(0).Infof("volume server %s:%d is draining", s.Ip, s.Port)
+	} else {
+		atomic.StoreInt32(&s.draining, 0)
+		glog.V(0).Infof("volume server %s:%d stops draining", s.Ip, s.Port)
+	}
+	return nil
+}
+
+func (s *Store) IsDraining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
+func (s *Store) loadDraining() {
+	for _, location := range s.Locations {
+		if _, err := os.Stat(filepath.Join(location.Directory, drainingMarkerFile)); err == nil {
+			glog.V(0).Infof("found %s in %s, volume server %s:%d is draining", drainingMarkerFile, location.Directory, s.Ip, s.Port)
+			atomic.StoreInt32(&s.draining, 1)
+			return
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/karlseguin/ccache"
+)
+
+const ecRecoveryCacheTTL = time.Hour
+
+// recoveredInterval is sized by its bytes in the recovery cache
+type recoveredInterval []byte
+
+func (r recoveredInterval) Size() int64 {
+	return int64(len(r))
+}
+
+// SetEcRecovery bounds the cache of the ec shard intervals reconstructed from other shards,
+// and the time to fetch the other shards. Slow volume servers are skipped if enough shards are fetched.
+func (s *Store) SetEcRecovery(cacheSizeMB int, timeout time.Duration) {
+	if cacheSizeMB > 0 {
+		s.ecRecoveryCache = ccache.New(ccache.Configure().MaxSize(int64(cacheSizeMB) * 1024 * 1024).ItemsToPrune(100))
+	}
+	s.ecRecoveryTimeout = timeout
+}
+
+// ecRecoveryCacheKey identifies the recovered interval.
+// The volume id can be encoded again after being decoded, and the generation changes after vacuum.
+func ecRecoveryCacheKey(ecVolume *erasure_coding.EcVolume, shardIdToRecover erasure_coding.ShardId, offset int64, size int) string {
+	return fmt.Sprintf("%d.%d.%d.%d.%d.%d", ecVolume.VolumeId, ecVolume.CreatedAt().UnixNano(), ecVolume.Generation, shardIdToRecover, offset, size)
+}
+
+func (s *Store) cachedRecoverOneRemoteEcShardInterval(ctx context.Context, needleId types.NeedleId, ecVolume *erasure_coding.EcVolume, shardIdToRecover erasure_coding.ShardId, buf []byte, offset int64) (n int, is_deleted bool, err error) {
+
+	key := ecRecoveryCacheKey(ecVolume, shardIdToRecover, offset, len(buf))
+
+	if s.ecRecoveryCache != nil {
+		if item := s.ecRecoveryCache.Get(key); item != nil && !item.Expired() {
+			stats.VolumeServerEcRecoveryCounter.WithLabelValues("cached").Inc()
+			return copy(buf, item.Value().(recoveredInterval)), false, nil
+		}
+	}
+
+	start := time.Now()
+	n, is_deleted, err = s.recoverOneRemoteEcShardInterval(ctx, needleId, ecVolume, shardIdToRecover, buf, offset)
+	if err != nil {
+		stats.VolumeServerEcRecoveryCounter.WithLabelValues("failed").Inc()
+		return
+	}
+	stats.VolumeServerEcRecoveryCounter.WithLabelValues("recovered").Inc()
+	stats.VolumeServerEcRecoveryHistogram.Observe(time.Since(start).Seconds())
+
+	// the deletion is checked with the other shards' .ecx files, so the deleted needles are not cached
+	if s.ecRecoveryCache != nil && !is_deleted {
+		s.ecRecoveryCache.Set(key, recoveredInterval(append([]byte(nil), buf[:n]...)), ecRecoveryCacheTTL)
+	}
+
+	return
+}
+
+type recoveredShard struct {
+	shardId   erasure_coding.ShardId
+	data      []byte
+	isDeleted bool
+}
+
+func (s *Store) recoverOneRemoteEcShardInterval(ctx context.Context, needleId types.NeedleId, ecVolume *erasure_coding.EcVolume, shardIdToRecover erasure_coding.ShardId, buf []byte, offset int64) (n int, is_deleted bool, err error) {
+	glog.V(4).Infof("recover ec shard %d.%d from other locations", ecVolume.VolumeId, shardIdToRecover)
+
+	enc, err := ecVolume.Scheme.NewEncoder()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to create encoder: %v", err)
+	}
+
+	if s.ecRecoveryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.ecRecoveryTimeout)
+		defer cancel()
+	}
+
+	bufs := make([][]byte, ecVolume.Scheme.TotalShards())
+	receivedCount := 0
+
+	// read the local shards first
+	for shardId := range bufs {
+		if erasure_coding.ShardId(shardId) == shardIdToRecover {
+			continue
+		}
+		if shard, found := ecVolume.FindEcVolumeShard(erasure_coding.ShardId(shardId)); found {
+			data := make([]byte, len(buf))
+			if _, readErr := shard.ReadAt(data, offset); readErr != nil {
+				glog.V(3).Infof("recover: read local ec shard %d.%d: %v", ecVolume.VolumeId, shardId, readErr)
+				continue
+			}
+			bufs[shardId] = data
+			receivedCount++
+		}
+	}
+
+	// read the remote shards in parallel, and stop waiting once enough shards are received
+	results := make(chan recoveredShard, len(bufs))
+	pendingCount := 0
+	ecVolume.ShardLocationsLock.RLock()
+	for shardId, locations := range ecVolume.ShardLocations {
+
+		// skip current shard, local shard, or empty shard
+		if shardId == shardIdToRecover || int(shardId) >= len(bufs) || bufs[shardId] != nil {
+			continue
+		}
+		if len(locations) == 0 {
+			glog.V(3).Infof("readRemoteEcShardInterval missing %d.%d from %+v", ecVolume.VolumeId, shardId, locations)
+			continue
+		}
+
+		pendingCount++
+		go func(shardId erasure_coding.ShardId, locations []string) {
+			data := make([]byte, len(buf))
+			nRead, isDeleted, readErr := s.readRemoteEcShardInterval(ctx, locations, needleId, ecVolume.VolumeId, ecVolume.Generation, shardId, data, offset)
+			if readErr != nil {
+				glog.V(3).Infof("recover: readRemoteEcShardInterval %d.%d %d bytes from %+v: %v", ecVolume.VolumeId, shardId, nRead, locations, readErr)
+				if ctx.Err() == nil {
+					forgetShardId(ecVolume, shardId)
+				}
+			}
+			if readErr != nil || nRead != len(buf) {
+				data = nil
+			}
+			results <- recoveredShard{shardId: shardId, data: data, isDeleted: isDeleted}
+		}(shardId, locations)
+	}
+	ecVolume.ShardLocationsLock.RUnlock()
+
+	for ; pendingCount > 0 && receivedCount < ecVolume.Scheme.DataShards; pendingCount-- {
+		select {
+		case result := <-results:
+			if result.isDeleted {
+				is_deleted = true
+			}
+			if result.data != nil {
+				bufs[result.shardId] = result.data
+				receivedCount++
+			}
+		case <-ctx.Done():
+			return 0, false, fmt.Errorf("recover ec shard %d.%d: only %d shards received: %v", ecVolume.VolumeId, shardIdToRecover, receivedCount, ctx.Err())
+		}
+	}
+
+	if err = enc.ReconstructData(bufs); err != nil {
+		glog.V(3).Infof("recovered ec shard %d.%d failed: %v", ecVolume.VolumeId, shardIdToRecover, err)
+		return 0, false, err
+	}
+	glog.V(4).Infof("recovered ec shard %d.%d from other locations", ecVolume.VolumeId, shardIdToRecover)
+
+	copy(buf, bufs[shardIdToRecover])
+
+	return len(buf), is_deleted, nil
+}
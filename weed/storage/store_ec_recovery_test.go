@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"google.golang.org/grpc"
+)
+
+// setupEcRecoveryTest writes the shards of a 4+2 ec volume, and mounts the local ones
+func setupEcRecoveryTest(t *testing.T, dir string, shardSize int, localShardIds ...erasure_coding.ShardId) (*erasure_coding.EcVolume, [][]byte) {
+	scheme := erasure_coding.EcScheme{DataShards: 4, ParityShards: 2}
+	enc, err := scheme.NewEncoder()
+	if err != nil {
+		t.Fatalf("new encoder: %v", err)
+	}
+	shards := make([][]byte, scheme.TotalShards())
+	for i := range shards {
+		shards[i] = make([]byte, shardSize)
+		if i < scheme.DataShards {
+			rand.Read(shards[i])
+		}
+	}
+	if err = enc.Encode(shards); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	baseFileName := path.Join(dir, "1")
+	for shardId, shard := range shards {
+		if err = ioutil.WriteFile(baseFileName+erasure_coding.ToExt(shardId), shard, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = ioutil.WriteFile(baseFileName+".ecx", nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = erasure_coding.SaveEcScheme(baseFileName, scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ecVolume, err := erasure_coding.NewEcVolume(dir, "", 1)
+	if err != nil {
+		t.Fatalf("new ec volume: %v", err)
+	}
+	for _, shardId := range localShardIds {
+		shard, err := erasure_coding.NewEcVolumeShard(dir, "", 1, shardId)
+		if err != nil {
+			t.Fatalf("new ec volume shard: %v", err)
+		}
+		ecVolume.AddEcVolumeShard(shard)
+	}
+	return ecVolume, shards
+}
+
+func TestEcRecoveryCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ec_recovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	intervalSize := 600 * 1024
+	ecVolume, shards := setupEcRecoveryTest(t, dir, 2*intervalSize, 0, 1, 2, 4)
+	defer ecVolume.Close()
+
+	s := &Store{}
+	s.SetEcRecovery(1, 0)
+
+	buf := make([]byte, intervalSize)
+	if _, _, err = s.cachedRecoverOneRemoteEcShardInterval(context.Background(), 0, ecVolume, 3, buf, 0); err != nil {
+		t.Fatalf("recover: %v", err)
+	}
+	if !bytes.Equal(buf, shards[3][:intervalSize]) {
+		t.Fatalf("recovered data is different")
+	}
+
+	// the other local shards are gone, the interval is served from the cache
+	ecVolume.DeleteEcVolumeShard(4)
+	buf = make([]byte, intervalSize)
+	if _, _, err = s.cachedRecoverOneRemoteEcShardInterval(context.Background(), 0, ecVolume, 3, buf, 0); err != nil {
+		t.Fatalf("recover from cache: %v", err)
+	}
+	if !bytes.Equal(buf, shards[3][:intervalSize]) {
+		t.Fatalf("cached data is different")
+	}
+
+	// the vacuumed generation is not served from the cache
+	ecVolume.Generation++
+	if _, _, err = s.cachedRecoverOneRemoteEcShardInterval(context.Background(), 0, ecVolume, 3, buf, 0); err == nil {
+		t.Errorf("expected the recovery of another generation to fail without enough shards")
+	}
+	ecVolume.Generation--
+
+	// another interval makes the cache larger than 1MB, and the first one is evicted
+	key := ecRecoveryCacheKey(ecVolume, 3, 0, intervalSize)
+	if s.ecRecoveryCache.Get(key) == nil {
+		t.Fatalf("expected the recovered interval in the cache")
+	}
+	s.ecRecoveryCache.Set(ecRecoveryCacheKey(ecVolume, 3, int64(intervalSize), intervalSize), recoveredInterval(make([]byte, intervalSize)), ecRecoveryCacheTTL)
+	for i := 0; i < 100 && s.ecRecoveryCache.Get(key) != nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if s.ecRecoveryCache.Get(key) != nil {
+		t.Fatalf("expected the interval evicted from the cache")
+	}
+	if _, _, err = s.cachedRecoverOneRemoteEcShardInterval(context.Background(), 0, ecVolume, 3, buf, 0); err == nil {
+		t.Errorf("expected the evicted interval recovered again, and fail without enough shards")
+	}
+}
+
+func TestEcRecoveryTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ec_recovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ecVolume, _ := setupEcRecoveryTest(t, dir, 1024, 0, 1)
+	defer ecVolume.Close()
+
+	// a volume server accepting the connections but never answering
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	grpcPort := listener.Addr().(*net.TCPAddr).Port
+	if grpcPort <= 10000 {
+		t.Skipf("the grpc port %d is derived from the volume server port plus 10000", grpcPort)
+	}
+	slowServer := fmt.Sprintf("127.0.0.1:%d", grpcPort-10000)
+	ecVolume.ShardLocations[2] = []string{slowServer}
+	ecVolume.ShardLocations[4] = []string{slowServer}
+
+	s := &Store{grpcDialOption: grpc.WithInsecure()}
+	s.SetEcRecovery(0, 200*time.Millisecond)
+
+	start := time.Now()
+	_, _, err = s.cachedRecoverOneRemoteEcShardInterval(context.Background(), 0, ecVolume, 3, make([]byte, 1024), 0)
+	if err == nil || !strings.Contains(err.Error(), "only 2 shards received") {
+		t.Fatalf("expected the recovery to stop at the deadline, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the recovery took %v, longer than the deadline", elapsed)
+	}
+	// the slow volume server is not forgotten because of the deadline
+	ecVolume.ShardLocationsLock.RLock()
+	defer ecVolume.ShardLocationsLock.RUnlock()
+	if len(ecVolume.ShardLocations[2]) != 1 {
+		t.Errorf("unexpected shard locations %v", ecVolume.ShardLocations)
+	}
+}
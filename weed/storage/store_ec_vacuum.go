@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// findEcVolumeBaseFileName finds the ec volume by its .ecx file, which is kept with the shards
+func (s *Store) findEcVolumeBaseFileName(collection string, vid needle.VolumeId) (*DiskLocation, string, error) {
+	baseFileName := erasure_coding.EcShardBaseFileName(collection, int(vid))
+	for _, location := range s.Locations {
+		if util.FileExists(path.Join(location.Directory, baseFileName+".ecx")) {
+			return location, path.Join(location.Directory, baseFileName), nil
+		}
+	}
+	return nil, "", fmt.Errorf("ec volume %d .ecx file not found", vid)
+}
+
+func (s *Store) CheckCompactEcVolume(collection string, vid needle.VolumeId) (float64, error) {
+	_, baseFileName, err := s.findEcVolumeBaseFileName(collection, vid)
+	if err != nil {
+		return 0, err
+	}
+	garbageRatio, err := erasure_coding.FindEcGarbageRatio(baseFileName)
+	glog.V(3).Infof("ec volume %d garbage level: %f", vid, garbageRatio)
+	return garbageRatio, err
+}
+
+// CompactEcVolume writes the next generation of the ec files. All the data shards need to be local.
+func (s *Store) CompactEcVolume(collection string, vid needle.VolumeId) error {
+	_, baseFileName, err := s.findEcVolumeBaseFileName(collection, vid)
+	if err != nil {
+		return err
+	}
+	scheme, err := erasure_coding.LoadEcScheme(baseFileName)
+	if err != nil {
+		return err
+	}
+	for shardId := 0; shardId < scheme.DataShards; shardId++ {
+		if !util.FileExists(baseFileName + erasure_coding.ToExt(shardId)) {
+			return fmt.Errorf("ec volume %d missing data shard %d", vid, shardId)
+		}
+	}
+	if err = erasure_coding.CompactEcFiles(baseFileName); err != nil {
+		erasure_coding.CleanupCompactedEcFiles(baseFileName)
+		return fmt.Errorf("compact ec volume %d: %v", vid, err)
+	}
+	return nil
+}
+
+// PrepareCompactEcVolume checks the compacted files of the local ec shards are ready to commit.
+func (s *Store) PrepareCompactEcVolume(collection string, vid needle.VolumeId) error {
+	location, baseFileName, err := s.findEcVolumeBaseFileName(collection, vid)
+	if err != nil {
+		return err
+	}
+	return erasure_coding.PrepareCompactedEcFiles(baseFileName, findLocalEcShardIds(location, vid))
+}
+
+// CommitCompactEcVolume swaps the local ec shards to the compacted generation, and returns the remounted shard ids.
+// The previous generation is kept until CleanupCompactEcVolume, to roll back with RollbackCompactEcVolume.
+func (s *Store) CommitCompactEcVolume(collection string, vid needle.VolumeId) (shardIds []erasure_coding.ShardId, err error) {
+	return s.swapEcVolumeFiles(collection, vid, "commit", erasure_coding.CommitCompactedEcFiles)
+}
+
+// RollbackCompactEcVolume swaps the local ec shards back to the previous generation after a commit.
+func (s *Store) RollbackCompactEcVolume(collection string, vid needle.VolumeId) (shardIds []erasure_coding.ShardId, err error) {
+	return s.swapEcVolumeFiles(collection, vid, "rollback", erasure_coding.RollbackCompactedEcFiles)
+}
+
+func (s *Store) swapEcVolumeFiles(collection string, vid needle.VolumeId, action string, swapFn func(baseFileName string, shardIds []erasure_coding.ShardId) error) (shardIds []erasure_coding.ShardId, err error) {
+	location, baseFileName, err := s.findEcVolumeBaseFileName(collection, vid)
+	if err != nil {
+		return nil, err
+	}
+
+	shardIds = findLocalEcShardIds(location, vid)
+
+	for _, shardId := range shardIds {
+		if err = s.UnmountEcShards(vid, shardId); err != nil {
+			return nil, err
+		}
+	}
+
+	swapErr := swapFn(baseFileName, shardIds)
+	if swapErr != nil {
+		glog.Errorf("%s compacted ec volume %d: %v", action, vid, swapErr)
+	}
+
+	// mount the shards again, of whichever generation is in place
+	for _, shardId := range shardIds {
+		if err = s.MountEcShards(collection, vid, shardId); err != nil {
+			return nil, err
+		}
+	}
+
+	return shardIds, swapErr
+}
+
+func findLocalEcShardIds(location *DiskLocation, vid needle.VolumeId) (shardIds []erasure_coding.ShardId) {
+	if ecVolume, found := location.FindEcVolume(vid); found {
+		for _, shard := range ecVolume.Shards {
+			shardIds = append(shardIds, shard.ShardId)
+		}
+	}
+	return
+}
+
+// CleanupCompactEcVolume removes the compacted files, and the previous generation kept by the commit
+func (s *Store) CleanupCompactEcVolume(collection string, vid needle.VolumeId) error {
+	_, baseFileName, err := s.findEcVolumeBaseFileName(collection, vid)
+	if err != nil {
+		return err
+	}
+	erasure_coding.CleanupCompactedEcFiles(baseFileName)
+	erasure_coding.RemoveBackupEcFiles(baseFileName)
+	return nil
+}
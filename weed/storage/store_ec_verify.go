@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// VerifyEcShards reads all the ec shards of the volume stripe by stripe, from local disk or the remote volume servers,
+// and recomputes the parity. Each mismatched stripe is reported to fn, with the corrupted shard ids if they can be found.
+func (s *Store) VerifyEcShards(ctx context.Context, vid needle.VolumeId, fn func(offset, size int64, badShardIds []erasure_coding.ShardId) error) error {
+
+	ecVolume, found := s.FindEcVolume(vid)
+	if !found {
+		return fmt.Errorf("ec volume %d not found", vid)
+	}
+
+	if err := s.cachedLookupEcShardLocations(ctx, ecVolume); err != nil {
+		return fmt.Errorf("failed to locate shard via master grpc %s: %v", s.MasterAddress, err)
+	}
+
+	enc, err := ecVolume.Scheme.NewEncoder()
+	if err != nil {
+		return fmt.Errorf("failed to create encoder: %v", err)
+	}
+
+	// every shard is needed to check the parity
+	shardLocations := make([][]string, ecVolume.Scheme.TotalShards())
+	ecVolume.ShardLocationsLock.RLock()
+	for shardId := range shardLocations {
+		shardLocations[shardId] = ecVolume.ShardLocations[erasure_coding.ShardId(shardId)]
+	}
+	ecVolume.ShardLocationsLock.RUnlock()
+	for shardId, locations := range shardLocations {
+		if _, found := ecVolume.FindEcVolumeShard(erasure_coding.ShardId(shardId)); !found && len(locations) == 0 {
+			return fmt.Errorf("ec shard %d.%d is missing", vid, shardId)
+		}
+	}
+
+	shardSize := ecVolume.ShardSize()
+	stripeSize := int64(erasure_coding.ErasureCodingSmallBlockSize)
+	for offset := int64(0); offset < shardSize; offset += stripeSize {
+		size := stripeSize
+		if offset+size > shardSize {
+			size = shardSize - offset
+		}
+
+		shards, err := s.readEcStripe(ctx, ecVolume, shardLocations, offset, size)
+		if err != nil {
+			return err
+		}
+
+		ok, badShardIds, err := erasure_coding.VerifyEcStripe(enc, shards)
+		if err != nil {
+			return fmt.Errorf("verify ec volume %d at %d: %v", vid, offset, err)
+		}
+		if ok {
+			continue
+		}
+		glog.V(0).Infof("ec volume %d stripe at %d size %d mismatched, bad shards %v", vid, offset, size, badShardIds)
+		if err = fn(offset, size, badShardIds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readEcStripe reads all the shards at the same offset. A short shard is padded with zeros and fails the parity check.
+func (s *Store) readEcStripe(ctx context.Context, ecVolume *erasure_coding.EcVolume, shardLocations [][]string, offset, size int64) ([][]byte, error) {
+
+	shards := make([][]byte, len(shardLocations))
+	errs := make([]error, len(shardLocations))
+
+	var wg sync.WaitGroup
+	for shardId, locations := range shardLocations {
+		shards[shardId] = make([]byte, size)
+		if ecShard, found := ecVolume.FindEcVolumeShard(erasure_coding.ShardId(shardId)); found {
+			if _, err := ecShard.ReadAt(shards[shardId], offset); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("read ec shard %d.%d at %d: %v", ecVolume.VolumeId, shardId, offset, err)
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(shardId int, locations []string) {
+			defer wg.Done()
+			_, _, errs[shardId] = s.readRemoteEcShardInterval(ctx, locations, 0, ecVolume.VolumeId, ecVolume.Generation, erasure_coding.ShardId(shardId), shards[shardId], offset)
+		}(shardId, locations)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return shards, nil
+}
@@ -59,6 +59,11 @@ func (v *Volume) writeNeedle(n *needle.Needle) (offset uint64, size uint32, isUn
 		err = fmt.Errorf("%s is read-only", v.dataFile.Name())
 		return
 	}
+	return v.doWriteNeedle(n)
+}
+
+// doWriteNeedle appends the needle without checking whether the volume is read only
+func (v *Volume) doWriteNeedle(n *needle.Needle) (offset uint64, size uint32, isUnchanged bool, err error) {
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
 	if v.isFileUnchanged(n) {
@@ -111,6 +116,11 @@ func (v *Volume) deleteNeedle(n *needle.Needle) (uint32, error) {
 	if v.readOnly {
 		return 0, fmt.Errorf("%s is read-only", v.dataFile.Name())
 	}
+	return v.doDeleteNeedle(n)
+}
+
+// doDeleteNeedle appends the deletion without checking whether the volume is read only
+func (v *Volume) doDeleteNeedle(n *needle.Needle) (uint32, error) {
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
 	nv, ok := v.nm.Get(n.Id)
@@ -165,6 +175,18 @@ func (v *Volume) readNeedle(n *needle.Needle) (int, error) {
 	return -1, ErrorNotFound
 }
 
+// readNeedleBlob reads the raw bytes of the needle as stored in the .dat file
+func (v *Volume) readNeedleBlob(id NeedleId) ([]byte, error) {
+	nv, ok := v.nm.Get(id)
+	if !ok || nv.Offset.IsZero() {
+		return nil, ErrorNotFound
+	}
+	if nv.Size == TombstoneFileSize {
+		return nil, errors.New("already deleted")
+	}
+	return needle.ReadNeedleBlob(v.dataFile, nv.Offset.ToAcutalOffset(), nv.Size, v.Version())
+}
+
 type VolumeFileScanner interface {
 	VisitSuperBlock(SuperBlock) error
 	ReadNeedleBody() bool
@@ -3,6 +3,7 @@ package topology
 import (
 	"github.com/chrislusf/raft"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -29,3 +30,78 @@ func (c *MaxVolumeIdCommand) Apply(server raft.Server) (interface{}, error) {
 
 	return nil, nil
 }
+
+type MaxFileIdCommand struct {
+	MaxFileId uint64 `json:"maxFileId"`
+}
+
+func NewMaxFileIdCommand(value uint64) *MaxFileIdCommand {
+	return &MaxFileIdCommand{
+		MaxFileId: value,
+	}
+}
+
+func (c *MaxFileIdCommand) CommandName() string {
+	return "MaxFileId"
+}
+
+func (c *MaxFileIdCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	if raftSequencer, ok := topo.Sequence.(*sequence.RaftSequencer); ok {
+		raftSequencer.ApplyReservedMax(c.MaxFileId)
+	}
+
+	glog.V(1).Infoln("max file id reserved", c.MaxFileId)
+
+	return nil, nil
+}
+
+type CollectionQuotaCommand struct {
+	Collection string          `json:"collection"`
+	Quota      CollectionQuota `json:"quota"`
+}
+
+func NewCollectionQuotaCommand(collection string, quota CollectionQuota) *CollectionQuotaCommand {
+	return &CollectionQuotaCommand{
+		Collection: collection,
+		Quota:      quota,
+	}
+}
+
+func (c *CollectionQuotaCommand) CommandName() string {
+	return "CollectionQuota"
+}
+
+func (c *CollectionQuotaCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	topo.Quotas.set(c.Collection, c.Quota)
+
+	glog.V(0).Infof("collection %q quota: %d bytes, %d volumes", c.Collection, c.Quota.MaxBytes, c.Quota.MaxVolumes)
+
+	return nil, nil
+}
+
+type CollectionPlacementCommand struct {
+	Collection string              `json:"collection"`
+	Placement  PlacementConstraint `json:"placement"`
+}
+
+func NewCollectionPlacementCommand(collection string, placement PlacementConstraint) *CollectionPlacementCommand {
+	return &CollectionPlacementCommand{
+		Collection: collection,
+		Placement:  placement,
+	}
+}
+
+func (c *CollectionPlacementCommand) CommandName() string {
+	return "CollectionPlacement"
+}
+
+func (c *CollectionPlacementCommand) Apply(server raft.Server) (interface{}, error) {
+	topo := server.Context().(*Topology)
+	topo.Placements.set(c.Collection, c.Placement)
+
+	glog.V(0).Infof("collection %q placement: %s", c.Collection, c.Placement)
+
+	return nil, nil
+}
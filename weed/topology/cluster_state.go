@@ -0,0 +1,83 @@
+package topology
+
+import (
+	"encoding/json"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// ClusterState is the state applied from the raft log, saved in the raft snapshots so the log can be compacted
+type ClusterState struct {
+	MaxVolumeId needle.VolumeId                `json:"maxVolumeId"`
+	MaxFileId   uint64                         `json:"maxFileId"`
+	Quotas      map[string]CollectionQuota     `json:"quotas"`
+	Placements  map[string]PlacementConstraint `json:"placements"`
+}
+
+// StateMachine saves and recovers the topology state for the raft snapshots
+type StateMachine struct {
+	topo *Topology
+}
+
+func NewStateMachine(topo *Topology) *StateMachine {
+	return &StateMachine{topo: topo}
+}
+
+func (s *StateMachine) Save() ([]byte, error) {
+	state := ClusterState{
+		MaxVolumeId: s.topo.GetMaxVolumeId(),
+		Quotas:      make(map[string]CollectionQuota),
+		Placements:  make(map[string]PlacementConstraint),
+	}
+	if raftSequencer, ok := s.topo.Sequence.(*sequence.RaftSequencer); ok {
+		state.MaxFileId = raftSequencer.ReservedMax()
+	}
+
+	s.topo.Quotas.RLock()
+	for collection, quota := range s.topo.Quotas.quotas {
+		state.Quotas[collection] = quota
+	}
+	s.topo.Quotas.RUnlock()
+
+	s.topo.Placements.RLock()
+	for collection, placement := range s.topo.Placements.placements {
+		state.Placements[collection] = placement
+	}
+	s.topo.Placements.RUnlock()
+
+	return json.Marshal(state)
+}
+
+// Recovery replaces the quotas and the placements with the ones in the snapshot.
+// The max volume id and the max file id only go up.
+func (s *StateMachine) Recovery(data []byte) error {
+	var state ClusterState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	s.topo.UpAdjustMaxVolumeId(state.MaxVolumeId)
+	if raftSequencer, ok := s.topo.Sequence.(*sequence.RaftSequencer); ok {
+		raftSequencer.ApplyReservedMax(state.MaxFileId)
+	}
+
+	s.topo.Quotas.Lock()
+	s.topo.Quotas.quotas = make(map[string]CollectionQuota)
+	for collection, quota := range state.Quotas {
+		s.topo.Quotas.quotas[collection] = quota
+	}
+	s.topo.Quotas.Unlock()
+
+	s.topo.Placements.Lock()
+	s.topo.Placements.placements = make(map[string]PlacementConstraint)
+	for collection, placement := range state.Placements {
+		s.topo.Placements.placements[collection] = placement
+	}
+	s.topo.Placements.Unlock()
+
+	glog.V(0).Infof("recovered from raft snapshot: max volume id %d, max file id %d, %d quotas, %d placements",
+		state.MaxVolumeId, state.MaxFileId, len(state.Quotas), len(state.Placements))
+	return nil
+}
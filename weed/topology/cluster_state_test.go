@@ -0,0 +1,40 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+)
+
+func TestStateMachineSaveRecovery(t *testing.T) {
+	seq, _ := sequence.NewRaftSequencer(100, "")
+	topo := NewTopology("weedfs", seq, 32*1024, 5)
+	topo.UpAdjustMaxVolumeId(7)
+	seq.ApplyReservedMax(10100)
+	topo.Quotas.set("team1", CollectionQuota{MaxBytes: 2000, MaxVolumes: 2})
+	topo.Placements.set("team1", NewPlacementConstraint(map[string]string{"disk": "ssd"}, nil))
+
+	data, err := NewStateMachine(topo).Save()
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	newSeq, _ := sequence.NewRaftSequencer(100, "")
+	newTopo := NewTopology("weedfs", newSeq, 32*1024, 5)
+	newTopo.Quotas.set("team2", CollectionQuota{MaxVolumes: 1})
+	if err = NewStateMachine(newTopo).Recovery(data); err != nil {
+		t.Fatalf("recovery: %v", err)
+	}
+
+	assert(t, "maxVolumeId", int(newTopo.GetMaxVolumeId()), 7)
+	assert(t, "maxFileId", int(newSeq.ReservedMax()), 10100)
+	if quota, found := newTopo.Quotas.Get("team1"); !found || quota.MaxVolumes != 2 {
+		t.Errorf("unexpected quota %+v", quota)
+	}
+	if _, found := newTopo.Quotas.Get("team2"); found {
+		t.Errorf("expected the quotas replaced by the snapshot")
+	}
+	if placement := newTopo.Placements.Get("team1"); placement.RequiredLabels["disk"] != "ssd" {
+		t.Errorf("unexpected placement %v", placement)
+	}
+}
@@ -0,0 +1,151 @@
+package topology
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// CollectionQuota limits the bytes and the number of volumes of one collection. 0 means unlimited.
+type CollectionQuota struct {
+	MaxBytes   uint64 `json:"maxBytes"`
+	MaxVolumes uint64 `json:"maxVolumes"`
+}
+
+func (q CollectionQuota) IsUnlimited() bool {
+	return q.MaxBytes == 0 && q.MaxVolumes == 0
+}
+
+// CollectionUsage counts each volume once, no matter how many replicas it has.
+// The ec volumes are counted as volumes, but their bytes are not known to the master.
+type CollectionUsage struct {
+	VolumeCount uint64
+	UsedBytes   uint64
+}
+
+// CollectionQuotas are set through the raft log, so every master has the same quotas after restarts
+type CollectionQuotas struct {
+	sync.RWMutex
+	quotas map[string]CollectionQuota
+	usages map[string]CollectionUsage // refreshed periodically, to check the quotas on each assignment
+}
+
+func NewCollectionQuotas() *CollectionQuotas {
+	return &CollectionQuotas{
+		quotas: make(map[string]CollectionQuota),
+		usages: make(map[string]CollectionUsage),
+	}
+}
+
+func (q *CollectionQuotas) Get(collection string) (quota CollectionQuota, found bool) {
+	q.RLock()
+	defer q.RUnlock()
+	quota, found = q.quotas[collection]
+	return
+}
+
+func (q *CollectionQuotas) Collections() (collections []string) {
+	q.RLock()
+	defer q.RUnlock()
+	for collection := range q.quotas {
+		collections = append(collections, collection)
+	}
+	return
+}
+
+func (q *CollectionQuotas) set(collection string, quota CollectionQuota) {
+	q.Lock()
+	defer q.Unlock()
+	if quota.IsUnlimited() {
+		delete(q.quotas, collection)
+		delete(q.usages, collection)
+		return
+	}
+	q.quotas[collection] = quota
+}
+
+// SetCollectionQuota commits the quota to the raft log. A quota with both limits as 0 removes the quota.
+func (t *Topology) SetCollectionQuota(collection string, quota CollectionQuota) error {
+	if t.RaftServer == nil {
+		return fmt.Errorf("raft server is not ready")
+	}
+	_, err := t.RaftServer.Do(NewCollectionQuotaCommand(collection, quota))
+	return err
+}
+
+// CollectionUsage counts the volumes and bytes of the collection from the current topology
+func (t *Topology) CollectionUsage(collection string) (usage CollectionUsage) {
+
+	if c, found := t.FindCollection(collection); found {
+		for _, layout := range c.storageType2VolumeLayout.Items() {
+			if layout == nil {
+				continue
+			}
+			vl := layout.(*VolumeLayout)
+			vl.accessLock.RLock()
+			for vid, locationList := range vl.vid2location {
+				usage.VolumeCount++
+				usage.UsedBytes += maxReplicaSize(vid, locationList)
+			}
+			vl.accessLock.RUnlock()
+		}
+	}
+
+	t.ecShardMapLock.RLock()
+	for _, ecShardLocations := range t.ecShardMap {
+		if ecShardLocations.Collection == collection {
+			usage.VolumeCount++
+		}
+	}
+	t.ecShardMapLock.RUnlock()
+
+	return
+}
+
+func maxReplicaSize(vid needle.VolumeId, locationList *VolumeLocationList) (size uint64) {
+	for _, dn := range locationList.list {
+		if v, err := dn.GetVolumesById(vid); err == nil && v.Size > size {
+			size = v.Size
+		}
+	}
+	return
+}
+
+func (t *Topology) refreshCollectionUsages() {
+	for _, collection := range t.Quotas.Collections() {
+		usage := t.CollectionUsage(collection)
+		t.Quotas.Lock()
+		if _, found := t.Quotas.quotas[collection]; found {
+			t.Quotas.usages[collection] = usage
+		}
+		t.Quotas.Unlock()
+	}
+}
+
+// CheckCollectionQuota rejects the assignments to a collection over its byte quota.
+// The usage is refreshed every pulse, so the collection can go over the quota by what is written within one pulse.
+func (t *Topology) CheckCollectionQuota(collection string) error {
+	t.Quotas.RLock()
+	defer t.Quotas.RUnlock()
+	quota, found := t.Quotas.quotas[collection]
+	if !found || quota.MaxBytes == 0 {
+		return nil
+	}
+	if usage := t.Quotas.usages[collection]; usage.UsedBytes >= quota.MaxBytes {
+		return fmt.Errorf("collection %q exceeds its quota: %d bytes used, quota %d bytes", collection, usage.UsedBytes, quota.MaxBytes)
+	}
+	return nil
+}
+
+// checkVolumeCountQuota rejects growing one more volume for a collection at its volume count quota
+func (t *Topology) checkVolumeCountQuota(collection string) error {
+	quota, found := t.Quotas.Get(collection)
+	if !found || quota.MaxVolumes == 0 {
+		return nil
+	}
+	if usage := t.CollectionUsage(collection); usage.VolumeCount >= quota.MaxVolumes {
+		return fmt.Errorf("collection %q exceeds its quota: %d volumes, quota %d volumes", collection, usage.VolumeCount, quota.MaxVolumes)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestCollectionQuota(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+
+	dc := topo.GetOrCreateDataCenter("dc1")
+	rack := dc.GetOrCreateRack("rack1")
+	dn1 := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25)
+	dn2 := rack.GetOrCreateDataNode("127.0.0.1", 34535, "127.0.0.1", 25)
+
+	// volume 1 has two replicas, with different sizes
+	volumeMessage := func(vid uint32, size uint64) *master_pb.VolumeInformationMessage {
+		return &master_pb.VolumeInformationMessage{
+			Id:               vid,
+			Size:             size,
+			Collection:       "team1",
+			ReplicaPlacement: uint32(1),
+			Version:          uint32(needle.CurrentVersion),
+		}
+	}
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volumeMessage(1, 1000), volumeMessage(2, 500)}, dn1)
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volumeMessage(1, 1200), volumeMessage(2, 500)}, dn2)
+
+	usage := topo.CollectionUsage("team1")
+	assert(t, "volumeCount", int(usage.VolumeCount), 2)
+	assert(t, "usedBytes", int(usage.UsedBytes), 1700)
+
+	topo.Quotas.set("team1", CollectionQuota{MaxBytes: 2000, MaxVolumes: 2})
+	topo.refreshCollectionUsages()
+	if err := topo.CheckCollectionQuota("team1"); err != nil {
+		t.Errorf("unexpected byte quota error: %v", err)
+	}
+	if err := topo.checkVolumeCountQuota("team1"); err == nil {
+		t.Errorf("expected the volume count quota to be exceeded")
+	}
+
+	topo.Quotas.set("team1", CollectionQuota{MaxBytes: 1700})
+	topo.refreshCollectionUsages()
+	if err := topo.CheckCollectionQuota("team1"); err == nil {
+		t.Errorf("expected the byte quota to be exceeded")
+	}
+	if err := topo.CheckCollectionQuota("team2"); err != nil {
+		t.Errorf("unexpected quota error for a collection without quota: %v", err)
+	}
+
+	topo.Quotas.set("team1", CollectionQuota{})
+	if _, found := topo.Quotas.Get("team1"); found {
+		t.Errorf("expected the quota to be removed")
+	}
+}
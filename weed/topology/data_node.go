@@ -12,6 +12,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 type DataNode struct {
@@ -23,6 +24,11 @@ type DataNode struct {
 	LastSeen     int64 // unix time in seconds
 	ecShards     map[needle.VolumeId]*erasure_coding.EcVolumeInfo
 	ecShardsLock sync.RWMutex
+
+	lowDiskSpaceDirs []string
+	labels           map[string]string
+
+	load dataNodeLoad
 }
 
 func NewDataNode(id string) *DataNode {
@@ -41,10 +47,10 @@ func (dn *DataNode) String() string {
 	return fmt.Sprintf("Node:%s, volumes:%v, Ip:%s, Port:%d, PublicUrl:%s", dn.NodeImpl.String(), dn.volumes, dn.Ip, dn.Port, dn.PublicUrl)
 }
 
-func (dn *DataNode) AddOrUpdateVolume(v storage.VolumeInfo) (isNew bool) {
+func (dn *DataNode) AddOrUpdateVolume(v storage.VolumeInfo) (isNew, isChangedReadOnly bool) {
 	dn.Lock()
 	defer dn.Unlock()
-	if _, ok := dn.volumes[v.Id]; !ok {
+	if oldV, ok := dn.volumes[v.Id]; !ok {
 		dn.volumes[v.Id] = v
 		dn.UpAdjustVolumeCountDelta(1)
 		if !v.ReadOnly {
@@ -54,11 +60,19 @@ func (dn *DataNode) AddOrUpdateVolume(v storage.VolumeInfo) (isNew bool) {
 		isNew = true
 	} else {
 		dn.volumes[v.Id] = v
+		if oldV.ReadOnly != v.ReadOnly {
+			if v.ReadOnly {
+				dn.UpAdjustActiveVolumeCountDelta(-1)
+			} else {
+				dn.UpAdjustActiveVolumeCountDelta(1)
+			}
+			isChangedReadOnly = true
+		}
 	}
 	return
 }
 
-func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolumes, deletedVolumes []storage.VolumeInfo) {
+func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolumes, deletedVolumes, changedReadOnlyVolumes []storage.VolumeInfo) {
 	actualVolumeMap := make(map[needle.VolumeId]storage.VolumeInfo)
 	for _, v := range actualVolumes {
 		actualVolumeMap[v.Id] = v
@@ -75,10 +89,13 @@ func (dn *DataNode) UpdateVolumes(actualVolumes []storage.VolumeInfo) (newVolume
 	}
 	dn.Unlock()
 	for _, v := range actualVolumes {
-		isNew := dn.AddOrUpdateVolume(v)
+		isNew, isChangedReadOnly := dn.AddOrUpdateVolume(v)
 		if isNew {
 			newVolumes = append(newVolumes, v)
 		}
+		if isChangedReadOnly {
+			changedReadOnlyVolumes = append(changedReadOnlyVolumes, v)
+		}
 	}
 	return
 }
@@ -117,6 +134,46 @@ func (dn *DataNode) GetVolumesById(id needle.VolumeId) (storage.VolumeInfo, erro
 	}
 }
 
+// UpdateDiskSpace applies the max volume count and the directories low in disk space from a full heartbeat.
+// The volume server leaves no free slots on a directory low in disk space.
+func (dn *DataNode) UpdateDiskSpace(maxVolumeCount int64, lowDiskSpaceDirs []string) {
+	if delta := maxVolumeCount - dn.GetMaxVolumeCount(); delta != 0 {
+		dn.UpAdjustMaxVolumeCountDelta(delta)
+	}
+	dn.Lock()
+	defer dn.Unlock()
+	if len(lowDiskSpaceDirs) != len(dn.lowDiskSpaceDirs) {
+		if len(lowDiskSpaceDirs) > 0 {
+			glog.V(0).Infof("volume server %s is low in disk space: %v", dn.Id(), lowDiskSpaceDirs)
+		} else {
+			glog.V(0).Infof("volume server %s disk space is recovered", dn.Id())
+		}
+	}
+	dn.lowDiskSpaceDirs = lowDiskSpaceDirs
+}
+
+func (dn *DataNode) GetLowDiskSpaceDirs() []string {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.lowDiskSpaceDirs
+}
+
+// UpdateLabels applies the labels from a full heartbeat
+func (dn *DataNode) UpdateLabels(labels map[string]string) {
+	dn.Lock()
+	defer dn.Unlock()
+	if util.FormatLabels(labels) != util.FormatLabels(dn.labels) {
+		glog.V(0).Infof("volume server %s labels: %s", dn.Id(), util.FormatLabels(labels))
+	}
+	dn.labels = labels
+}
+
+func (dn *DataNode) GetLabels() map[string]string {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.labels
+}
+
 func (dn *DataNode) GetDataCenter() *DataCenter {
 	return dn.Parent().Parent().(*NodeImpl).value.(*DataCenter)
 }
@@ -150,16 +207,34 @@ func (dn *DataNode) ToMap() interface{} {
 	ret["Max"] = dn.GetMaxVolumeCount()
 	ret["Free"] = dn.FreeSpace()
 	ret["PublicUrl"] = dn.PublicUrl
+	if lowDiskSpaceDirs := dn.GetLowDiskSpaceDirs(); len(lowDiskSpaceDirs) > 0 {
+		ret["LowDiskSpace"] = lowDiskSpaceDirs
+	}
+	if labels := dn.GetLabels(); len(labels) > 0 {
+		ret["Labels"] = util.FormatLabels(labels)
+	}
+	if freeDiskBytes, totalDiskBytes := dn.DiskSpace(); totalDiskBytes > 0 {
+		ret["DiskFreeMB"] = freeDiskBytes / (1024 * 1024)
+		ret["DiskTotalMB"] = totalDiskBytes / (1024 * 1024)
+	}
+	ret["WriteBytesPerSecond"] = int64(dn.WriteBytesPerSecond())
 	return ret
 }
 
 func (dn *DataNode) ToDataNodeInfo() *master_pb.DataNodeInfo {
+	freeSpace := dn.FreeSpace()
+	if freeSpace < 0 {
+		// a drained or full volume server may still hold ec shards
+		freeSpace = 0
+	}
 	m := &master_pb.DataNodeInfo{
 		Id:                string(dn.Id()),
 		VolumeCount:       uint64(dn.GetVolumeCount()),
 		MaxVolumeCount:    uint64(dn.GetMaxVolumeCount()),
-		FreeVolumeCount:   uint64(dn.FreeSpace()),
+		FreeVolumeCount:   uint64(freeSpace),
 		ActiveVolumeCount: uint64(dn.GetActiveVolumeCount()),
+		LowDiskSpaceDirs:  dn.GetLowDiskSpaceDirs(),
+		Labels:            dn.GetLabels(),
 	}
 	for _, v := range dn.GetVolumes() {
 		m.VolumeInfos = append(m.VolumeInfos, v.ToVolumeInformationMessage())
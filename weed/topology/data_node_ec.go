@@ -56,6 +56,7 @@ func (dn *DataNode) UpdateEcShards(actualShards []*erasure_coding.EcVolumeInfo)
 		dn.ecShardsLock.Lock()
 		dn.ecShards = actualEcShardMap
 		dn.UpAdjustEcShardCountDelta(int64(newShardCount - deletedShardCount))
+		dn.adjustEcShardSlotCount()
 		dn.ecShardsLock.Unlock()
 	}
 
@@ -89,6 +90,7 @@ func (dn *DataNode) AddOrUpdateEcShard(s *erasure_coding.EcVolumeInfo) {
 	}
 
 	dn.UpAdjustEcShardCountDelta(int64(delta))
+	dn.adjustEcShardSlotCount()
 
 }
 
@@ -104,10 +106,29 @@ func (dn *DataNode) DeleteEcShard(s *erasure_coding.EcVolumeInfo) {
 		if existing.ShardBits.ShardIdCount() == 0 {
 			delete(dn.ecShards, s.VolumeId)
 		}
+		dn.adjustEcShardSlotCount()
 	}
 
 }
 
+// adjustEcShardSlotCount recounts the volume slots taken by the ec shards, with the ecShardsLock held.
+// Each shard takes 1/DataShards of a volume slot of its scheme, rounded up for each scheme.
+func (dn *DataNode) adjustEcShardSlotCount() {
+	shardCountByDataShards := make(map[int]int)
+	for _, ecShards := range dn.ecShards {
+		dataShards := ecShards.Scheme.DataShards
+		if dataShards == 0 {
+			dataShards = erasure_coding.DataShardsCount
+		}
+		shardCountByDataShards[dataShards] += ecShards.ShardBits.ShardIdCount()
+	}
+	var slotCount int64
+	for dataShards, shardCount := range shardCountByDataShards {
+		slotCount += int64((shardCount + dataShards - 1) / dataShards)
+	}
+	dn.UpAdjustEcShardSlotCountDelta(slotCount - dn.GetEcShardSlotCount())
+}
+
 func (dn *DataNode) HasVolumesById(id needle.VolumeId) (hasVolumeId bool) {
 
 	// check whether normal volumes has this volume id
@@ -0,0 +1,89 @@
+package topology
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// how much the latest write throughput sample counts in the moving average
+	writeRateSmoothing = 0.5
+	// the recent failures of a volume server are forgotten by half in this duration
+	failureHalfLife = 10 * time.Minute
+)
+
+// dataNodeLoad tracks the disk space, the write throughput, and the recent failures of a volume server,
+// to weight the volume placement
+type dataNodeLoad struct {
+	sync.RWMutex
+	freeDiskBytes       uint64
+	totalDiskBytes      uint64
+	volumesSize         uint64
+	volumesSizeAt       time.Time
+	writeBytesPerSecond float64
+	failureScore        float64
+	failureAt           time.Time
+}
+
+// UpdateLoad is called with each full heartbeat. The write throughput is estimated by how fast the volumes grow.
+func (dn *DataNode) UpdateLoad(freeDiskBytes, totalDiskBytes, volumesSize uint64) {
+	dn.updateLoadAt(time.Now(), freeDiskBytes, totalDiskBytes, volumesSize)
+}
+
+func (dn *DataNode) updateLoadAt(now time.Time, freeDiskBytes, totalDiskBytes, volumesSize uint64) {
+	dn.load.Lock()
+	defer dn.load.Unlock()
+	dn.load.freeDiskBytes, dn.load.totalDiskBytes = freeDiskBytes, totalDiskBytes
+	if !dn.load.volumesSizeAt.IsZero() && volumesSize >= dn.load.volumesSize {
+		// the volumes shrink after compaction or deletion, which tells nothing about the writes
+		if elapsed := now.Sub(dn.load.volumesSizeAt).Seconds(); elapsed > 0 {
+			rate := float64(volumesSize-dn.load.volumesSize) / elapsed
+			dn.load.writeBytesPerSecond = writeRateSmoothing*rate + (1-writeRateSmoothing)*dn.load.writeBytesPerSecond
+		}
+	}
+	dn.load.volumesSize, dn.load.volumesSizeAt = volumesSize, now
+}
+
+// RecordFailure counts a failed operation on the volume server, e.g., failing to allocate a volume
+func (dn *DataNode) RecordFailure() {
+	dn.recordFailureAt(time.Now())
+}
+
+func (dn *DataNode) recordFailureAt(now time.Time) {
+	dn.load.Lock()
+	defer dn.load.Unlock()
+	dn.load.failureScore = decayedFailureScore(dn.load.failureScore, now.Sub(dn.load.failureAt)) + 1
+	dn.load.failureAt = now
+}
+
+// DiskSpace returns the free and total disk bytes reported by the volume server, both 0 if not known
+func (dn *DataNode) DiskSpace() (free, total uint64) {
+	dn.load.RLock()
+	defer dn.load.RUnlock()
+	return dn.load.freeDiskBytes, dn.load.totalDiskBytes
+}
+
+func (dn *DataNode) WriteBytesPerSecond() float64 {
+	dn.load.RLock()
+	defer dn.load.RUnlock()
+	return dn.load.writeBytesPerSecond
+}
+
+// FailureScore is the number of recent failures, with the older failures counting less
+func (dn *DataNode) FailureScore() float64 {
+	return dn.failureScoreAt(time.Now())
+}
+
+func (dn *DataNode) failureScoreAt(now time.Time) float64 {
+	dn.load.RLock()
+	defer dn.load.RUnlock()
+	return decayedFailureScore(dn.load.failureScore, now.Sub(dn.load.failureAt))
+}
+
+func decayedFailureScore(score float64, elapsed time.Duration) float64 {
+	if score == 0 {
+		return 0
+	}
+	return score * math.Pow(0.5, float64(elapsed)/float64(failureHalfLife))
+}
@@ -8,7 +8,6 @@ import (
 	"sync/atomic"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
-	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -21,11 +20,13 @@ type Node interface {
 	UpAdjustMaxVolumeCountDelta(maxVolumeCountDelta int64)
 	UpAdjustVolumeCountDelta(volumeCountDelta int64)
 	UpAdjustEcShardCountDelta(ecShardCountDelta int64)
+	UpAdjustEcShardSlotCountDelta(ecShardSlotCountDelta int64)
 	UpAdjustActiveVolumeCountDelta(activeVolumeCountDelta int64)
 	UpAdjustMaxVolumeId(vid needle.VolumeId)
 
 	GetVolumeCount() int64
 	GetEcShardCount() int64
+	GetEcShardSlotCount() int64
 	GetActiveVolumeCount() int64
 	GetMaxVolumeCount() int64
 	GetMaxVolumeId() needle.VolumeId
@@ -46,6 +47,7 @@ type NodeImpl struct {
 	volumeCount       int64
 	activeVolumeCount int64
 	ecShardCount      int64
+	ecShardSlotCount  int64 // the volume slots taken by the ec shards
 	maxVolumeCount    int64
 	id                NodeId
 	parent            Node
@@ -132,10 +134,7 @@ func (n *NodeImpl) Id() NodeId {
 	return n.id
 }
 func (n *NodeImpl) FreeSpace() int64 {
-	if n.ecShardCount > 0 {
-		return n.maxVolumeCount - n.volumeCount - n.ecShardCount/erasure_coding.DataShardsCount - 1
-	}
-	return n.maxVolumeCount - n.volumeCount
+	return n.maxVolumeCount - n.volumeCount - n.ecShardSlotCount
 }
 func (n *NodeImpl) SetParent(node Node) {
 	n.parent = node
@@ -197,6 +196,12 @@ func (n *NodeImpl) UpAdjustEcShardCountDelta(ecShardCountDelta int64) { //can be
 		n.parent.UpAdjustEcShardCountDelta(ecShardCountDelta)
 	}
 }
+func (n *NodeImpl) UpAdjustEcShardSlotCountDelta(ecShardSlotCountDelta int64) { //can be negative
+	atomic.AddInt64(&n.ecShardSlotCount, ecShardSlotCountDelta)
+	if n.parent != nil {
+		n.parent.UpAdjustEcShardSlotCountDelta(ecShardSlotCountDelta)
+	}
+}
 func (n *NodeImpl) UpAdjustActiveVolumeCountDelta(activeVolumeCountDelta int64) { //can be negative
 	atomic.AddInt64(&n.activeVolumeCount, activeVolumeCountDelta)
 	if n.parent != nil {
@@ -220,6 +225,9 @@ func (n *NodeImpl) GetVolumeCount() int64 {
 func (n *NodeImpl) GetEcShardCount() int64 {
 	return n.ecShardCount
 }
+func (n *NodeImpl) GetEcShardSlotCount() int64 {
+	return n.ecShardSlotCount
+}
 func (n *NodeImpl) GetActiveVolumeCount() int64 {
 	return n.activeVolumeCount
 }
@@ -236,6 +244,7 @@ func (n *NodeImpl) LinkChildNode(node Node) {
 		n.UpAdjustMaxVolumeId(node.GetMaxVolumeId())
 		n.UpAdjustVolumeCountDelta(node.GetVolumeCount())
 		n.UpAdjustEcShardCountDelta(node.GetEcShardCount())
+		n.UpAdjustEcShardSlotCountDelta(node.GetEcShardSlotCount())
 		n.UpAdjustActiveVolumeCountDelta(node.GetActiveVolumeCount())
 		node.SetParent(n)
 		glog.V(0).Infoln(n, "adds child", node.Id())
@@ -251,6 +260,7 @@ func (n *NodeImpl) UnlinkChildNode(nodeId NodeId) {
 		delete(n.children, node.Id())
 		n.UpAdjustVolumeCountDelta(-node.GetVolumeCount())
 		n.UpAdjustEcShardCountDelta(-node.GetEcShardCount())
+		n.UpAdjustEcShardSlotCountDelta(-node.GetEcShardSlotCount())
 		n.UpAdjustActiveVolumeCountDelta(-node.GetActiveVolumeCount())
 		n.UpAdjustMaxVolumeCountDelta(-node.GetMaxVolumeCount())
 		glog.V(0).Infoln(n, "removes", node.Id())
@@ -0,0 +1,295 @@
+package topology
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// PlacementConstraint limits the volume servers for the volumes, by the labels the volume servers report in the heartbeats.
+// All the replicas must be on volume servers with the required labels.
+// The replicas must be on volume servers with different values of each anti affinity label,
+// and a volume server without the label counts as having an empty value.
+type PlacementConstraint struct {
+	RequiredLabels     map[string]string `json:"requiredLabels,omitempty"`
+	AntiAffinityLabels []string          `json:"antiAffinityLabels,omitempty"`
+}
+
+func NewPlacementConstraint(requiredLabels map[string]string, antiAffinityLabels []string) PlacementConstraint {
+	return PlacementConstraint{RequiredLabels: requiredLabels, AntiAffinityLabels: antiAffinityLabels}
+}
+
+func (p PlacementConstraint) IsEmpty() bool {
+	return len(p.RequiredLabels) == 0 && len(p.AntiAffinityLabels) == 0
+}
+
+// Merge adds the other constraint. The required label values of p take precedence.
+func (p PlacementConstraint) Merge(other PlacementConstraint) (merged PlacementConstraint) {
+	if other.IsEmpty() {
+		return p
+	}
+	if p.IsEmpty() {
+		return other
+	}
+	if len(p.RequiredLabels)+len(other.RequiredLabels) > 0 {
+		merged.RequiredLabels = make(map[string]string)
+		for key, value := range other.RequiredLabels {
+			merged.RequiredLabels[key] = value
+		}
+		for key, value := range p.RequiredLabels {
+			merged.RequiredLabels[key] = value
+		}
+	}
+	seen := make(map[string]bool)
+	for _, key := range append(append([]string{}, p.AntiAffinityLabels...), other.AntiAffinityLabels...) {
+		if !seen[key] {
+			seen[key] = true
+			merged.AntiAffinityLabels = append(merged.AntiAffinityLabels, key)
+		}
+	}
+	return
+}
+
+func (p PlacementConstraint) String() string {
+	var parts []string
+	if len(p.RequiredLabels) > 0 {
+		parts = append(parts, "labels:"+util.FormatLabels(p.RequiredLabels))
+	}
+	if len(p.AntiAffinityLabels) > 0 {
+		parts = append(parts, "antiAffinity:"+strings.Join(p.AntiAffinityLabels, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Matches checks the required labels of the volume server
+func (p PlacementConstraint) Matches(dn *DataNode) bool {
+	if len(p.RequiredLabels) == 0 {
+		return true
+	}
+	labels := dn.GetLabels()
+	for key, value := range p.RequiredLabels {
+		if actual, found := labels[key]; !found || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesAllLocations checks the required labels of all the replicas of one volume
+func (p PlacementConstraint) matchesAllLocations(locationList *VolumeLocationList) bool {
+	for _, dn := range locationList.list {
+		if !p.Matches(dn) {
+			return false
+		}
+	}
+	return true
+}
+
+// CollectionPlacements are set through the raft log, and apply to all the volumes created in the collection
+type CollectionPlacements struct {
+	sync.RWMutex
+	placements map[string]PlacementConstraint
+}
+
+func NewCollectionPlacements() *CollectionPlacements {
+	return &CollectionPlacements{
+		placements: make(map[string]PlacementConstraint),
+	}
+}
+
+func (c *CollectionPlacements) Get(collection string) PlacementConstraint {
+	c.RLock()
+	defer c.RUnlock()
+	return c.placements[collection]
+}
+
+func (c *CollectionPlacements) set(collection string, placement PlacementConstraint) {
+	c.Lock()
+	defer c.Unlock()
+	if placement.IsEmpty() {
+		delete(c.placements, collection)
+		return
+	}
+	c.placements[collection] = placement
+}
+
+// SetCollectionPlacement commits the placement constraint to the raft log. An empty constraint removes it.
+func (t *Topology) SetCollectionPlacement(collection string, placement PlacementConstraint) error {
+	if t.RaftServer == nil {
+		return fmt.Errorf("raft server is not ready")
+	}
+	_, err := t.RaftServer.Do(NewCollectionPlacementCommand(collection, placement))
+	return err
+}
+
+// placementPicker collects the volume servers for one volume, keeping the anti affinity labels distinct
+type placementPicker struct {
+	antiAffinityLabels []string
+	usedValues         map[string]map[string]bool
+	servers            []*DataNode
+}
+
+func newPlacementPicker(antiAffinityLabels []string) *placementPicker {
+	p := &placementPicker{antiAffinityLabels: antiAffinityLabels, usedValues: make(map[string]map[string]bool)}
+	for _, key := range antiAffinityLabels {
+		p.usedValues[key] = make(map[string]bool)
+	}
+	return p
+}
+
+func (p *placementPicker) canPick(dn *DataNode) bool {
+	labels := dn.GetLabels()
+	for _, key := range p.antiAffinityLabels {
+		if p.usedValues[key][labels[key]] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *placementPicker) pick(dn *DataNode) {
+	labels := dn.GetLabels()
+	for _, key := range p.antiAffinityLabels {
+		p.usedValues[key][labels[key]] = true
+	}
+	p.servers = append(p.servers, dn)
+}
+
+// pickFromOneGroup picks count volume servers, the first one must match firstDataNode if not empty
+func (p *placementPicker) pickFromOneGroup(dataNodes []*DataNode, count int, firstDataNode string) bool {
+	if firstDataNode != "" {
+		found := false
+		for _, dn := range dataNodes {
+			if dn.Id() == NodeId(firstDataNode) && p.canPick(dn) {
+				p.pick(dn)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+		count--
+	}
+	for _, dn := range dataNodes {
+		if count <= 0 {
+			break
+		}
+		if dn.Id() != NodeId(firstDataNode) && p.canPick(dn) {
+			p.pick(dn)
+			count--
+		}
+	}
+	return count <= 0
+}
+
+// pickOneFromEachGroup picks one volume server from each of count groups
+func (p *placementPicker) pickOneFromEachGroup(groups [][]*DataNode, count int) bool {
+	for _, dataNodes := range groups {
+		if count <= 0 {
+			break
+		}
+		if p.pickFromOneGroup(dataNodes, 1, "") {
+			count--
+		}
+	}
+	return count <= 0
+}
+
+// findEmptySlotsWithPlacement finds the volume servers for one volume with the replica placement and the placement constraint.
+// The main rack is tried in each data center, with the volume servers in random order, weighted by the placement strategy if any.
+func (vg *VolumeGrowth) findEmptySlotsWithPlacement(topo *Topology, option *VolumeGrowOption) (servers []*DataNode, err error) {
+	rp := option.ReplicaPlacement
+	placement := option.Placement
+
+	// the matching volume servers with free slots, by data center and rack
+	type rackNodes struct {
+		rack      *Rack
+		dataNodes []*DataNode
+	}
+	dcRacks := make(map[*DataCenter][]*rackNodes)
+	var dcs []*DataCenter
+	var dcWeights []float64
+	for _, d := range topo.Children() {
+		dc := d.(*DataCenter)
+		var racks []*rackNodes
+		var rackWeights []float64
+		for _, r := range dc.Children() {
+			var dataNodes []*DataNode
+			var weights []float64
+			for _, n := range r.Children() {
+				dn := n.(*DataNode)
+				if dn.FreeSpace() >= 1 && placement.Matches(dn) {
+					dataNodes = append(dataNodes, dn)
+					weights = append(weights, vg.weight(topo, dn))
+				}
+			}
+			rn := &rackNodes{rack: r.(*Rack)}
+			rackWeight := 0.0
+			for _, i := range weightedOrder(weights) {
+				rn.dataNodes = append(rn.dataNodes, dataNodes[i])
+				rackWeight += weights[i]
+			}
+			racks = append(racks, rn)
+			rackWeights = append(rackWeights, rackWeight)
+		}
+		dcWeight := 0.0
+		for _, i := range weightedOrder(rackWeights) {
+			dcRacks[dc] = append(dcRacks[dc], racks[i])
+			dcWeight += rackWeights[i]
+		}
+		dcs = append(dcs, dc)
+		dcWeights = append(dcWeights, dcWeight)
+	}
+	var orderedDcs []*DataCenter
+	for _, i := range weightedOrder(dcWeights) {
+		orderedDcs = append(orderedDcs, dcs[i])
+	}
+	dcs = orderedDcs
+
+	for _, mainDc := range dcs {
+		if option.DataCenter != "" && mainDc.Id() != NodeId(option.DataCenter) {
+			continue
+		}
+		var otherDcGroups [][]*DataNode
+		for _, dc := range dcs {
+			if dc == mainDc {
+				continue
+			}
+			var dataNodes []*DataNode
+			for _, rn := range dcRacks[dc] {
+				dataNodes = append(dataNodes, rn.dataNodes...)
+			}
+			otherDcGroups = append(otherDcGroups, dataNodes)
+		}
+		for _, mainRack := range dcRacks[mainDc] {
+			if option.Rack != "" && mainRack.rack.Id() != NodeId(option.Rack) {
+				continue
+			}
+			var otherRackGroups [][]*DataNode
+			for _, rn := range dcRacks[mainDc] {
+				if rn != mainRack {
+					otherRackGroups = append(otherRackGroups, rn.dataNodes)
+				}
+			}
+			picker := newPlacementPicker(placement.AntiAffinityLabels)
+			if picker.pickFromOneGroup(mainRack.dataNodes, rp.SameRackCount+1, option.DataNode) &&
+				picker.pickOneFromEachGroup(otherRackGroups, rp.DiffRackCount) &&
+				picker.pickOneFromEachGroup(otherDcGroups, rp.DiffDataCenterCount) {
+				return picker.servers, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no volume servers for replication %s matching %s", rp, placement)
+}
+
+// weight of the volume server by the placement strategy, or the same for all without a strategy
+func (vg *VolumeGrowth) weight(topo *Topology, dn *DataNode) float64 {
+	if vg.strategy == nil {
+		return 1
+	}
+	return vg.strategy.Weight(topo, dn)
+}
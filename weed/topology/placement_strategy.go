@@ -0,0 +1,123 @@
+package topology
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// PlacementStrategy weights the volume servers to create new volumes on.
+// The racks and data centers are weighted by the sum of their volume servers.
+type PlacementStrategy interface {
+	Name() string
+	// Weight of a volume server with free slots. A higher weight is more likely to be picked, 0 skips the volume server.
+	Weight(topo *Topology, dn *DataNode) float64
+}
+
+var (
+	placementStrategies     = make(map[string]PlacementStrategy)
+	placementStrategiesLock sync.RWMutex
+)
+
+func init() {
+	RegisterPlacementStrategy(&FreeSlotsPlacementStrategy{})
+	RegisterPlacementStrategy(NewWeightedPlacementStrategy(DefaultWriteLoadReference))
+}
+
+func RegisterPlacementStrategy(strategy PlacementStrategy) {
+	placementStrategiesLock.Lock()
+	defer placementStrategiesLock.Unlock()
+	placementStrategies[strategy.Name()] = strategy
+}
+
+func GetPlacementStrategy(name string) (PlacementStrategy, error) {
+	placementStrategiesLock.RLock()
+	defer placementStrategiesLock.RUnlock()
+	if strategy, found := placementStrategies[name]; found {
+		return strategy, nil
+	}
+	var names []string
+	for n := range placementStrategies {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown placement strategy %q, should be one of %s", name, strings.Join(names, ", "))
+}
+
+// FreeSlotsPlacementStrategy weights the volume servers by their free volume slots
+type FreeSlotsPlacementStrategy struct {
+}
+
+func (s *FreeSlotsPlacementStrategy) Name() string {
+	return "free_slots"
+}
+
+func (s *FreeSlotsPlacementStrategy) Weight(topo *Topology, dn *DataNode) float64 {
+	if freeSlots := dn.FreeSpace(); freeSlots > 0 {
+		return float64(freeSlots)
+	}
+	return 0
+}
+
+// DefaultWriteLoadReference halves the weight of a volume server taking 10MB/s of writes
+const DefaultWriteLoadReference = 10 * 1024 * 1024
+
+// WeightedPlacementStrategy weights the volume servers by the free disk bytes,
+// lowered by the recent write throughput and the recent failures.
+// The weight is halved when the write throughput reaches WriteLoadReference bytes per second, or after one recent failure.
+type WeightedPlacementStrategy struct {
+	WriteLoadReference float64
+}
+
+func NewWeightedPlacementStrategy(writeLoadReference float64) *WeightedPlacementStrategy {
+	return &WeightedPlacementStrategy{WriteLoadReference: writeLoadReference}
+}
+
+func (s *WeightedPlacementStrategy) Name() string {
+	return "weighted"
+}
+
+func (s *WeightedPlacementStrategy) Weight(topo *Topology, dn *DataNode) float64 {
+	freeSlots := dn.FreeSpace()
+	if freeSlots <= 0 {
+		return 0
+	}
+	// the free slots can hold more than the disk, e.g., with a large -max
+	freeBytes := float64(freeSlots) * float64(topo.volumeSizeLimit)
+	if freeDiskBytes, totalDiskBytes := dn.DiskSpace(); totalDiskBytes > 0 {
+		freeBytes = math.Min(freeBytes, float64(freeDiskBytes))
+	}
+	weight := freeBytes
+	if s.WriteLoadReference > 0 {
+		weight = weight / (1 + dn.WriteBytesPerSecond()/s.WriteLoadReference)
+	}
+	return weight / (1 + dn.FailureScore())
+}
+
+// weightedOrder returns the indexes in a random order, the ones with higher weights more likely to be in front.
+// The indexes with weights of 0 or less are left out.
+func weightedOrder(weights []float64) []int {
+	type weightedIndex struct {
+		index int
+		key   float64
+	}
+	var keys []weightedIndex
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		// sorting by u^(1/w) is the same as weighted sampling without replacement, and log(u)/w keeps the precision
+		keys = append(keys, weightedIndex{index: i, key: math.Log(rand.Float64()) / w})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].key > keys[j].key
+	})
+	order := make([]int, len(keys))
+	for i, k := range keys {
+		order[i] = k.index
+	}
+	return order
+}
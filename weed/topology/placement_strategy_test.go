@@ -0,0 +1,95 @@
+package topology
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+)
+
+func TestWeightedOrder(t *testing.T) {
+	firstCounts := make([]int, 3)
+	for i := 0; i < 10000; i++ {
+		order := weightedOrder([]float64{1, 0, 3})
+		if len(order) != 2 {
+			t.Fatalf("the index with weight 0 should be left out: %v", order)
+		}
+		firstCounts[order[0]]++
+	}
+	// the index 2 should be first about 3/4 of the time
+	if firstCounts[2] < 7000 || firstCounts[2] > 8000 {
+		t.Errorf("unexpected first counts: %v", firstCounts)
+	}
+}
+
+func TestDataNodeLoad(t *testing.T) {
+	dn := NewDataNode("server")
+	now := time.Now()
+
+	dn.updateLoadAt(now, 1000, 2000, 100)
+	dn.updateLoadAt(now.Add(10*time.Second), 1000, 2000, 1100)
+	if rate := dn.WriteBytesPerSecond(); rate != 50 {
+		t.Errorf("write rate %v, expected 50", rate)
+	}
+	// a compaction does not count as writes
+	dn.updateLoadAt(now.Add(20*time.Second), 1000, 2000, 500)
+	if rate := dn.WriteBytesPerSecond(); rate != 50 {
+		t.Errorf("write rate %v after compaction, expected 50", rate)
+	}
+
+	dn.recordFailureAt(now)
+	dn.recordFailureAt(now)
+	if score := dn.failureScoreAt(now.Add(failureHalfLife)); math.Abs(score-1) > 0.001 {
+		t.Errorf("failure score %v after one half life, expected 1", score)
+	}
+}
+
+func TestWeightedPlacementStrategy(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 1024, 5)
+	dc := topo.GetOrCreateDataCenter("dc1")
+	rack := dc.GetOrCreateRack("rack1")
+	busy := rack.GetOrCreateDataNode("127.0.0.1", 8080, "127.0.0.1", 10)
+	idle := rack.GetOrCreateDataNode("127.0.0.1", 8081, "127.0.0.1", 10)
+	full := rack.GetOrCreateDataNode("127.0.0.1", 8082, "127.0.0.1", 10)
+
+	now := time.Now()
+	for _, dn := range []*DataNode{busy, idle, full} {
+		dn.updateLoadAt(now.Add(-time.Second), 100*1024, 200*1024, 0)
+	}
+	busy.updateLoadAt(now, 100*1024, 200*1024, 2000)
+	idle.updateLoadAt(now, 100*1024, 200*1024, 0)
+	full.updateLoadAt(now, 100, 200*1024, 0)
+
+	strategy := NewWeightedPlacementStrategy(1000)
+	// 10 free slots of 1024 bytes, less than the free disk space
+	if w := strategy.Weight(topo, idle); w != 10*1024 {
+		t.Errorf("idle weight %v", w)
+	}
+	// 1000 bytes per second of writes halves the weight
+	if w := strategy.Weight(topo, busy); w != 5*1024 {
+		t.Errorf("busy weight %v", w)
+	}
+	// limited by the free disk space
+	if w := strategy.Weight(topo, full); w != 100 {
+		t.Errorf("full weight %v", w)
+	}
+
+	vg := NewVolumeGrowth(strategy)
+	rp, _ := storage.NewReplicaPlacementFromString("000")
+	idleCount := 0
+	for i := 0; i < 1000; i++ {
+		servers, err := vg.findEmptySlotsForOneVolume(topo, &VolumeGrowOption{ReplicaPlacement: rp})
+		if err != nil {
+			t.Fatalf("find empty slots: %v", err)
+		}
+		if servers[0] == idle {
+			idleCount++
+		}
+	}
+	// the idle volume server should get about 2/3 of the volumes
+	if idleCount < 600 || idleCount > 730 {
+		t.Errorf("idle volume server got %d of 1000 volumes", idleCount)
+	}
+}
@@ -0,0 +1,72 @@
+package topology
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage"
+)
+
+func TestFindEmptySlotsWithPlacement(t *testing.T) {
+	topo := setup(topologyLayout)
+	labels := map[string]map[string]string{
+		"server112": {"disk": "ssd", "zone": "a"},
+		"server121": {"disk": "hdd", "zone": "b"},
+		"server122": {"disk": "ssd", "zone": "a"},
+		"server123": {"disk": "ssd", "zone": "b"},
+	}
+	for _, dc := range topo.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				n.(*DataNode).UpdateLabels(labels[string(n.Id())])
+			}
+		}
+	}
+
+	vg := NewDefaultVolumeGrowth()
+	findServers := func(replication string, placement PlacementConstraint) (string, error) {
+		rp, _ := storage.NewReplicaPlacementFromString(replication)
+		servers, err := vg.findEmptySlotsForOneVolume(topo, &VolumeGrowOption{
+			ReplicaPlacement: rp,
+			DataCenter:       "dc1",
+			Placement:        placement,
+		})
+		var ids []string
+		for _, server := range servers {
+			ids = append(ids, string(server.Id()))
+		}
+		sort.Strings(ids)
+		return strings.Join(ids, ","), err
+	}
+
+	ssdInDifferentZones := NewPlacementConstraint(map[string]string{"disk": "ssd"}, []string{"zone"})
+	for i := 0; i < 10; i++ {
+		if servers, err := findServers("001", ssdInDifferentZones); err != nil || servers != "server122,server123" {
+			t.Fatalf("same rack: servers %s, error %v", servers, err)
+		}
+		if servers, err := findServers("010", ssdInDifferentZones); err != nil || servers != "server112,server123" {
+			t.Fatalf("different racks: servers %s, error %v", servers, err)
+		}
+	}
+
+	if servers, err := findServers("002", ssdInDifferentZones); err == nil {
+		t.Fatalf("only two zones, but found servers %s", servers)
+	}
+	if servers, err := findServers("000", NewPlacementConstraint(map[string]string{"disk": "nvme"}, nil)); err == nil {
+		t.Fatalf("no nvme disks, but found servers %s", servers)
+	}
+}
+
+func TestPlacementConstraintMerge(t *testing.T) {
+	collectionPlacement := NewPlacementConstraint(map[string]string{"disk": "ssd"}, []string{"zone"})
+	requestPlacement := NewPlacementConstraint(map[string]string{"disk": "hdd", "region": "us"}, []string{"zone", "rack"})
+
+	merged := collectionPlacement.Merge(requestPlacement)
+	if merged.String() != "labels:disk=ssd,region=us antiAffinity:zone,rack" {
+		t.Errorf("unexpected merged placement: %s", merged)
+	}
+	if collectionPlacement.Merge(PlacementConstraint{}).Matches(NewDataNode("server")) {
+		t.Errorf("a volume server without labels should not match the required labels")
+	}
+}
@@ -2,6 +2,7 @@ package topology
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,16 +10,21 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
 )
 
-func ReplicatedWrite(masterNode string, s *storage.Store,
+func ReplicatedWrite(masterNode string, s *storage.Store, writeQuorum int, hints *HintedHandoff,
 	volumeId needle.VolumeId, n *needle.Needle,
 	r *http.Request) (size uint32, isUnchanged bool, err error) {
 
@@ -39,50 +45,59 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 	if needToReplicate { //send to other replica locations
 		if r.FormValue("type") != "replicate" {
 
-			if err = distributedOperation(masterNode, s, volumeId, func(location operation.Location) error {
-				u := url.URL{
-					Scheme: "http",
-					Host:   location.Url,
-					Path:   r.URL.Path,
-				}
-				q := url.Values{
-					"type": {"replicate"},
-					"ttl":  {n.Ttl.String()},
-				}
-				if n.LastModified > 0 {
-					q.Set("ts", strconv.FormatUint(n.LastModified, 10))
-				}
-				if n.IsChunkedManifest() {
-					q.Set("cm", "true")
-				}
-				u.RawQuery = q.Encode()
-
-				pairMap := make(map[string]string)
-				if n.HasPairs() {
-					tmpMap := make(map[string]string)
-					err := json.Unmarshal(n.Pairs, &tmpMap)
-					if err != nil {
-						glog.V(0).Infoln("Unmarshal pairs error:", err)
-					}
-					for k, v := range tmpMap {
-						pairMap[needle.PairNamePrefix+k] = v
-					}
-				}
-
-				_, err := operation.Upload(u.String(),
-					string(n.Name), bytes.NewReader(n.Data), n.IsGzipped(), string(n.Mime),
-					pairMap, jwt)
-				return err
-			}); err != nil {
+			missedLocations, quorumErr := quorumOperation(masterNode, s, volumeId, writeQuorum, hints, func(location operation.Location) error {
+				return replicateNeedle(location.Url, r.URL.Path, n, jwt)
+			})
+			if quorumErr != nil {
 				size = 0
-				err = fmt.Errorf("failed to write to replicas for volume %d: %v", volumeId, err)
+				err = fmt.Errorf("failed to write to replicas for volume %d: %v", volumeId, quorumErr)
+				return
+			}
+			for _, location := range missedLocations {
+				hints.addHint(location, needle.NewFileIdFromNeedle(volumeId, n), hintWrite)
 			}
 		}
 	}
 	return
 }
 
-func ReplicatedDelete(masterNode string, store *storage.Store,
+func replicateNeedle(location string, path string, n *needle.Needle, jwt security.EncodedJwt) error {
+	u := url.URL{
+		Scheme: "http",
+		Host:   location,
+		Path:   path,
+	}
+	q := url.Values{
+		"type": {"replicate"},
+		"ttl":  {n.Ttl.String()},
+	}
+	if n.LastModified > 0 {
+		q.Set("ts", strconv.FormatUint(n.LastModified, 10))
+	}
+	if n.IsChunkedManifest() {
+		q.Set("cm", "true")
+	}
+	u.RawQuery = q.Encode()
+
+	pairMap := make(map[string]string)
+	if n.HasPairs() {
+		tmpMap := make(map[string]string)
+		err := json.Unmarshal(n.Pairs, &tmpMap)
+		if err != nil {
+			glog.V(0).Infoln("Unmarshal pairs error:", err)
+		}
+		for k, v := range tmpMap {
+			pairMap[needle.PairNamePrefix+k] = v
+		}
+	}
+
+	_, err := operation.Upload(u.String(),
+		string(n.Name), bytes.NewReader(n.Data), n.IsGzipped(), string(n.Mime),
+		pairMap, jwt)
+	return err
+}
+
+func ReplicatedDelete(masterNode string, store *storage.Store, writeQuorum int, hints *HintedHandoff,
 	volumeId needle.VolumeId, n *needle.Needle,
 	r *http.Request) (uint32, error) {
 
@@ -101,16 +116,125 @@ func ReplicatedDelete(masterNode string, store *storage.Store,
 	}
 	if needToReplicate { //send to other replica locations
 		if r.FormValue("type") != "replicate" {
-			if err = distributedOperation(masterNode, store, volumeId, func(location operation.Location) error {
+			var missedLocations []string
+			if missedLocations, err = quorumOperation(masterNode, store, volumeId, writeQuorum, hints, func(location operation.Location) error {
 				return util.Delete("http://"+location.Url+r.URL.Path+"?type=replicate", string(jwt))
 			}); err != nil {
 				ret = 0
 			}
+			for _, location := range missedLocations {
+				hints.addHint(location, needle.NewFileIdFromNeedle(volumeId, n), hintDelete)
+			}
 		}
 	}
 	return ret, err
 }
 
+const (
+	readRepairMissTtl     = 10 * time.Minute
+	readRepairMissMaxSize = 10000
+)
+
+// readRepairMisses remembers the file ids no other replica has,
+// so the repeated requests for missing files do not go to the other replicas again.
+var readRepairMisses = &readRepairMissCache{misses: make(map[string]time.Time)}
+
+type readRepairMissCache struct {
+	sync.Mutex
+	misses map[string]time.Time
+}
+
+func (c *readRepairMissCache) isMissing(fid string) bool {
+	c.Lock()
+	defer c.Unlock()
+	missedAt, found := c.misses[fid]
+	if !found {
+		return false
+	}
+	if time.Since(missedAt) > readRepairMissTtl {
+		delete(c.misses, fid)
+		return false
+	}
+	return true
+}
+
+func (c *readRepairMissCache) setMissing(fid string) {
+	c.Lock()
+	defer c.Unlock()
+	if len(c.misses) >= readRepairMissMaxSize {
+		c.misses = make(map[string]time.Time)
+	}
+	c.misses[fid] = time.Now()
+}
+
+// ReadRepair copies the needle missing on the local replica from one of the other replicas.
+// Needles deleted locally are not repaired, and the needles no other replica has are
+// remembered for a while to avoid asking the other replicas again.
+func ReadRepair(masterNode string, grpcDialOption grpc.DialOption, s *storage.Store, volumeId needle.VolumeId, n *needle.Needle) error {
+	v := s.GetVolume(volumeId)
+	if v == nil || v.ReplicaPlacement.GetCopyCount() <= 1 {
+		return storage.ErrorNotFound
+	}
+	if _, _, err := s.ReadVolumeNeedleBlob(volumeId, n.Id); err != storage.ErrorNotFound {
+		return storage.ErrorNotFound
+	}
+	fid := needle.NewFileIdFromNeedle(volumeId, n)
+	if readRepairMisses.isMissing(fid.String()) {
+		return storage.ErrorNotFound
+	}
+
+	lookupResult, lookupErr := operation.Lookup(masterNode, volumeId.String())
+	if lookupErr != nil {
+		return fmt.Errorf("Failed to lookup for %d: %v", volumeId, lookupErr)
+	}
+
+	selfUrl := s.Ip + ":" + strconv.Itoa(s.Port)
+	allAnswered := true
+	for _, location := range lookupResult.Locations {
+		if location.Url == selfUrl {
+			continue
+		}
+		var resp *volume_server_pb.ReadNeedleBlobResponse
+		err := operation.WithVolumeServerClient(location.Url, grpcDialOption, func(client volume_server_pb.VolumeServerClient) (readErr error) {
+			resp, readErr = client.ReadNeedleBlob(context.Background(), &volume_server_pb.ReadNeedleBlobRequest{
+				VolumeId: uint32(volumeId),
+				NeedleId: uint64(n.Id),
+			})
+			return readErr
+		})
+		if err != nil {
+			if !strings.Contains(err.Error(), storage.ErrorNotFound.Error()) {
+				allAnswered = false
+			}
+			glog.V(1).Infof("read repair %s from %s: %v", fid, location.Url, err)
+			continue
+		}
+		if len(resp.NeedleBlob) < types.NeedleHeaderSize {
+			continue
+		}
+		found := new(needle.Needle)
+		found.ParseNeedleHeader(resp.NeedleBlob)
+		if err = found.ReadBytes(resp.NeedleBlob, 0, found.Size, needle.Version(resp.Version)); err != nil {
+			glog.V(0).Infof("read repair %s from %s: %v", fid, location.Url, err)
+			continue
+		}
+		if found.Cookie != n.Cookie {
+			readRepairMisses.setMissing(fid.String())
+			return storage.ErrorNotFound
+		}
+		if _, _, err = s.WriteVolumeNeedle(volumeId, found); err != nil {
+			return fmt.Errorf("read repair %s: %v", fid, err)
+		}
+		glog.V(0).Infof("read repair %s from %s", fid, location.Url)
+		return nil
+	}
+
+	if allAnswered {
+		readRepairMisses.setMissing(fid.String())
+	}
+	return storage.ErrorNotFound
+}
+
 type DistributedOperationResult map[string]error
 
 func (dr DistributedOperationResult) Error() error {
@@ -131,32 +255,58 @@ type RemoteResult struct {
 	Error error
 }
 
-func distributedOperation(masterNode string, store *storage.Store, volumeId needle.VolumeId, op func(location operation.Location) error) error {
-	if lookupResult, lookupErr := operation.Lookup(masterNode, volumeId.String()); lookupErr == nil {
-		length := 0
-		selfUrl := (store.Ip + ":" + strconv.Itoa(store.Port))
-		results := make(chan RemoteResult)
-		for _, location := range lookupResult.Locations {
-			if location.Url != selfUrl {
-				length++
-				go func(location operation.Location, results chan RemoteResult) {
-					results <- RemoteResult{location.Url, op(location)}
-				}(location, results)
-			}
+// quorumOperation runs the operation on the other replicas. It succeeds if the local copy
+// and the successful replicas reach the write quorum, and returns the replicas which missed it,
+// including the replicas known to the hints but missing from the lookup result.
+// A writeQuorum of 0 requires all copies.
+func quorumOperation(masterNode string, store *storage.Store, volumeId needle.VolumeId, writeQuorum int, hints *HintedHandoff, op func(location operation.Location) error) (missedLocations []string, err error) {
+	lookupResult, lookupErr := operation.Lookup(masterNode, volumeId.String())
+	if lookupErr != nil {
+		return nil, fmt.Errorf("Failed to lookup for %d: %v", volumeId, lookupErr)
+	}
+
+	length := 0
+	selfUrl := (store.Ip + ":" + strconv.Itoa(store.Port))
+	results := make(chan RemoteResult)
+	var otherLocations []string
+	for _, location := range lookupResult.Locations {
+		if location.Url != selfUrl {
+			length++
+			otherLocations = append(otherLocations, location.Url)
+			go func(location operation.Location, results chan RemoteResult) {
+				results <- RemoteResult{location.Url, op(location)}
+			}(location, results)
 		}
-		ret := DistributedOperationResult(make(map[string]error))
-		for i := 0; i < length; i++ {
-			result := <-results
-			ret[result.Host] = result.Error
+	}
+	missedLocations = hints.missingReplicas(volumeId, otherLocations)
+	ret := DistributedOperationResult(make(map[string]error))
+	succeeded := 1
+	for i := 0; i < length; i++ {
+		result := <-results
+		ret[result.Host] = result.Error
+		if result.Error == nil {
+			succeeded++
+		} else {
+			missedLocations = append(missedLocations, result.Host)
 		}
-		if volume := store.GetVolume(volumeId); volume != nil {
-			if length+1 < volume.ReplicaPlacement.GetCopyCount() {
-				return fmt.Errorf("replicating opetations [%d] is less than volume's replication copy count [%d]", length+1, volume.ReplicaPlacement.GetCopyCount())
-			}
+	}
+
+	copyCount := length + 1
+	if volume := store.GetVolume(volumeId); volume != nil {
+		copyCount = volume.ReplicaPlacement.GetCopyCount()
+	}
+	required := copyCount
+	if writeQuorum > 0 && writeQuorum < copyCount {
+		required = writeQuorum
+	}
+	if succeeded < required {
+		if length+1 < required {
+			return nil, fmt.Errorf("replicating opetations [%d] is less than volume's replication copy count [%d]", length+1, required)
 		}
-		return ret.Error()
-	} else {
-		glog.V(0).Infoln()
-		return fmt.Errorf("Failed to lookup for %d: %v", volumeId, lookupErr)
+		return nil, ret.Error()
+	}
+	if succeeded < copyCount {
+		glog.V(0).Infof("volume %d: written %d of %d copies, missed %v: %v", volumeId, succeeded, copyCount, missedLocations, ret.Error())
 	}
+	return missedLocations, nil
 }
@@ -0,0 +1,183 @@
+package topology
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const (
+	hintWrite  = byte('w')
+	hintDelete = byte('d')
+
+	hintedHandoffReplayInterval = 30 * time.Second
+	// after this, the replica is most likely lost and re-replicated by the master
+	hintedHandoffMaxAge = 24 * time.Hour
+)
+
+// HintedHandoff remembers the writes and deletes missed by the replicas
+// when the write quorum is reached, and replays them when the replicas are back.
+// The hints are kept in leveldb, keyed by "replica url/file id",
+// so only the last operation on a file is replayed.
+type HintedHandoff struct {
+	db              *leveldb.DB
+	store           *storage.Store
+	signingKey      security.SigningKey
+	expiresAfterSec int
+
+	// a hint is only deleted after the replay if no newer hint replaced it meanwhile
+	hintsLock    sync.Mutex
+	replayHintFn func(location string, fid *needle.FileId, op byte) error
+
+	// the replicas seen in the lookups, so the replicas missing from the later lookups also get the hints
+	replicasLock sync.Mutex
+	replicas     map[needle.VolumeId]map[string]time.Time
+}
+
+func NewHintedHandoff(dir string, store *storage.Store, signingKey security.SigningKey, expiresAfterSec int) (*HintedHandoff, error) {
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	h := &HintedHandoff{
+		db:              db,
+		store:           store,
+		signingKey:      signingKey,
+		expiresAfterSec: expiresAfterSec,
+		replicas:        make(map[needle.VolumeId]map[string]time.Time),
+	}
+	h.replayHintFn = h.replayHint
+	return h, nil
+}
+
+func (h *HintedHandoff) Close() {
+	h.db.Close()
+}
+
+func (h *HintedHandoff) addHint(location string, fid *needle.FileId, op byte) {
+	if h == nil {
+		return
+	}
+	value := make([]byte, 9)
+	value[0] = op
+	util.Uint64toBytes(value[1:], uint64(time.Now().UnixNano()))
+	h.hintsLock.Lock()
+	err := h.db.Put([]byte(location+"/"+fid.String()), value, nil)
+	h.hintsLock.Unlock()
+	if err != nil {
+		glog.Errorf("add hint %s for %s: %v", fid, location, err)
+		return
+	}
+	glog.V(1).Infof("add hint %c %s for %s", op, fid, location)
+}
+
+// missingReplicas remembers the replicas of the volume in the lookup result,
+// and returns the replicas seen within hintedHandoffMaxAge but missing from the lookup result,
+// which are most likely down and dropped by the master.
+func (h *HintedHandoff) missingReplicas(volumeId needle.VolumeId, locations []string) (missing []string) {
+	if h == nil {
+		return nil
+	}
+	h.replicasLock.Lock()
+	defer h.replicasLock.Unlock()
+
+	seen, found := h.replicas[volumeId]
+	if !found {
+		seen = make(map[string]time.Time)
+		h.replicas[volumeId] = seen
+	}
+	now := time.Now()
+	current := make(map[string]bool)
+	for _, location := range locations {
+		seen[location] = now
+		current[location] = true
+	}
+	for location, lastSeen := range seen {
+		if current[location] {
+			continue
+		}
+		if now.Sub(lastSeen) > hintedHandoffMaxAge {
+			delete(seen, location)
+			continue
+		}
+		missing = append(missing, location)
+	}
+	return missing
+}
+
+// Replay keeps replaying the hints to the replicas.
+func (h *HintedHandoff) Replay() {
+	for {
+		h.replay()
+		time.Sleep(hintedHandoffReplayInterval)
+	}
+}
+
+// deleteHint deletes the hint only if it is not replaced by a newer hint for the same replica and file
+func (h *HintedHandoff) deleteHint(key string, value []byte) {
+	h.hintsLock.Lock()
+	defer h.hintsLock.Unlock()
+	stored, err := h.db.Get([]byte(key), nil)
+	if err != nil || !bytes.Equal(stored, value) {
+		return
+	}
+	if err = h.db.Delete([]byte(key), nil); err != nil {
+		glog.Errorf("delete hint %s: %v", key, err)
+	}
+}
+
+func (h *HintedHandoff) replay() {
+	unreachable := make(map[string]bool)
+	iter := h.db.NewIterator(nil, nil)
+	defer iter.Release()
+	for iter.Next() {
+		key, value := string(iter.Key()), append([]byte(nil), iter.Value()...)
+		slash := strings.Index(key, "/")
+		if slash < 0 || len(value) != 9 {
+			h.deleteHint(key, value)
+			continue
+		}
+		location := key[:slash]
+		if unreachable[location] {
+			continue
+		}
+		fid, err := needle.ParseFileIdFromString(key[slash+1:])
+		if err != nil {
+			h.deleteHint(key, value)
+			continue
+		}
+		if time.Since(time.Unix(0, int64(util.BytesToUint64(value[1:])))) > hintedHandoffMaxAge {
+			glog.V(0).Infof("drop expired hint %s for %s", fid, location)
+			h.deleteHint(key, value)
+			continue
+		}
+		if err = h.replayHintFn(location, fid, value[0]); err != nil {
+			glog.V(1).Infof("replay hint %s to %s: %v", fid, location, err)
+			unreachable[location] = true
+			continue
+		}
+		glog.V(1).Infof("replayed hint %c %s to %s", value[0], fid, location)
+		h.deleteHint(key, value)
+	}
+}
+
+func (h *HintedHandoff) replayHint(location string, fid *needle.FileId, op byte) error {
+	jwt := security.GenJwt(h.signingKey, h.expiresAfterSec, fid.String())
+	if op == hintDelete {
+		return util.Delete("http://"+location+"/"+fid.String()+"?type=replicate", string(jwt))
+	}
+	n := &needle.Needle{Id: fid.Key, Cookie: fid.Cookie}
+	if _, err := h.store.ReadVolumeNeedle(fid.VolumeId, n); err != nil {
+		// deleted or moved away since, nothing to hand off
+		return nil
+	}
+	return replicateNeedle(location, "/"+fid.String(), n, jwt)
+}
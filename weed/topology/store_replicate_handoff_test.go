@@ -0,0 +1,160 @@
+package topology
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func countHints(h *HintedHandoff) (count int) {
+	iter := h.db.NewIterator(nil, nil)
+	defer iter.Release()
+	for iter.Next() {
+		count++
+	}
+	return
+}
+
+func TestHintedHandoff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted_handoff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	h, err := NewHintedHandoff(dir, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	fid := needle.NewFileId(3, 0x1234, 0x5678)
+	h.addHint("127.0.0.1:1", fid, hintWrite)
+	h.addHint("127.0.0.1:1", fid, hintDelete)
+	h.addHint("127.0.0.1:2", fid, hintDelete)
+	if count := countHints(h); count != 2 {
+		t.Fatalf("expected the last operation per replica, got %d hints", count)
+	}
+
+	// the replicas are not reachable, the hints are kept
+	h.replay()
+	if count := countHints(h); count != 2 {
+		t.Fatalf("expected 2 hints kept, got %d", count)
+	}
+
+	value := make([]byte, 9)
+	value[0] = hintDelete
+	util.Uint64toBytes(value[1:], uint64(time.Now().Add(-hintedHandoffMaxAge-time.Minute).UnixNano()))
+	h.db.Put([]byte("127.0.0.1:1/"+fid.String()), value, nil)
+	h.replay()
+	if count := countHints(h); count != 1 {
+		t.Fatalf("expected the expired hint dropped, got %d hints", count)
+	}
+
+	var nilHints *HintedHandoff
+	nilHints.addHint("127.0.0.1:1", fid, hintWrite)
+}
+
+func TestHintedHandoffNewerHintDuringReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted_handoff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	h, err := NewHintedHandoff(dir, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	fid := needle.NewFileId(3, 0x1234, 0x5678)
+	h.addHint("127.0.0.1:1", fid, hintWrite)
+
+	// the file is deleted while the write is replayed
+	var replayed []byte
+	h.replayHintFn = func(location string, fid *needle.FileId, op byte) error {
+		replayed = append(replayed, op)
+		if op == hintWrite {
+			h.addHint(location, fid, hintDelete)
+		}
+		return nil
+	}
+	h.replay()
+	if count := countHints(h); count != 1 {
+		t.Fatalf("expected the newer delete hint kept, got %d hints", count)
+	}
+
+	h.replay()
+	if count := countHints(h); count != 0 {
+		t.Fatalf("expected the delete hint replayed, got %d hints", count)
+	}
+	if string(replayed) != string([]byte{hintWrite, hintDelete}) {
+		t.Errorf("replayed %q, expected the write and then the delete", replayed)
+	}
+}
+
+func TestHintedHandoffMissingReplicas(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted_handoff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	h, err := NewHintedHandoff(dir, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	if missing := h.missingReplicas(3, []string{"127.0.0.1:1", "127.0.0.1:2"}); len(missing) != 0 {
+		t.Fatalf("unexpected missing replicas %v", missing)
+	}
+	// the second replica is dropped from the lookup result
+	missing := h.missingReplicas(3, []string{"127.0.0.1:1"})
+	if len(missing) != 1 || missing[0] != "127.0.0.1:2" {
+		t.Fatalf("expected the second replica missing, got %v", missing)
+	}
+	if missing := h.missingReplicas(4, []string{"127.0.0.1:3"}); len(missing) != 0 {
+		t.Fatalf("unexpected missing replicas %v of another volume", missing)
+	}
+
+	// the replicas not seen for too long are forgotten
+	h.replicas[3]["127.0.0.1:2"] = time.Now().Add(-hintedHandoffMaxAge - time.Minute)
+	if missing := h.missingReplicas(3, []string{"127.0.0.1:1"}); len(missing) != 0 {
+		t.Fatalf("expected the old replica forgotten, got %v", missing)
+	}
+
+	var nilHints *HintedHandoff
+	if missing := nilHints.missingReplicas(3, []string{"127.0.0.1:1"}); missing != nil {
+		t.Fatalf("unexpected missing replicas %v without hints", missing)
+	}
+}
+
+func TestReadRepairMissCache(t *testing.T) {
+	c := &readRepairMissCache{misses: make(map[string]time.Time)}
+	if c.isMissing("3,01637037d6") {
+		t.Fatalf("unexpected miss")
+	}
+	c.setMissing("3,01637037d6")
+	if !c.isMissing("3,01637037d6") {
+		t.Fatalf("expected the miss remembered")
+	}
+
+	c.misses["3,01637037d6"] = time.Now().Add(-readRepairMissTtl - time.Minute)
+	if c.isMissing("3,01637037d6") || len(c.misses) != 0 {
+		t.Fatalf("expected the expired miss dropped")
+	}
+
+	for i := 0; i < readRepairMissMaxSize; i++ {
+		c.setMissing(needle.NewFileId(3, uint64(i), 1).String())
+	}
+	c.setMissing("3,01637037d6")
+	if len(c.misses) != 1 {
+		t.Fatalf("expected the full cache cleared, got %d entries", len(c.misses))
+	}
+}
@@ -34,6 +34,14 @@ type Topology struct {
 	Configuration *Configuration
 
 	RaftServer raft.Server
+
+	Repair *VolumeRepair
+
+	Quotas *CollectionQuotas
+
+	Placements *CollectionPlacements
+
+	watchers topologyWatchers
 }
 
 func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, pulse int) *Topology {
@@ -53,6 +61,14 @@ func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, puls
 
 	t.Configuration = &Configuration{}
 
+	t.Repair = NewVolumeRepair()
+
+	t.Quotas = NewCollectionQuotas()
+
+	t.Placements = NewCollectionPlacements()
+
+	t.watchers.watchers = make(map[string]chan *master_pb.TopologyEvent)
+
 	return t
 }
 
@@ -112,6 +128,27 @@ func (t *Topology) NextVolumeId() (needle.VolumeId, error) {
 	return next, nil
 }
 
+// RaiseMaxVolumeId commits a larger max volume id to the raft log, so the new volumes are created after it
+func (t *Topology) RaiseMaxVolumeId(vid needle.VolumeId) error {
+	if vid <= t.GetMaxVolumeId() {
+		return nil
+	}
+	if t.RaftServer == nil {
+		return fmt.Errorf("raft server is not ready")
+	}
+	_, err := t.RaftServer.Do(NewMaxVolumeIdCommand(vid))
+	return err
+}
+
+// ReserveFileIds commits the end of a file id range to the raft log, so the ids are not reused by the next leader
+func (t *Topology) ReserveFileIds(max uint64) error {
+	if t.RaftServer == nil {
+		return fmt.Errorf("raft server is not ready")
+	}
+	_, err := t.RaftServer.Do(NewMaxFileIdCommand(max))
+	return err
+}
+
 func (t *Topology) HasWritableVolume(option *VolumeGrowOption) bool {
 	vl := t.GetVolumeLayout(option.Collection, option.ReplicaPlacement, option.Ttl)
 	return vl.GetActiveVolumeCount(option) > 0
@@ -126,6 +163,9 @@ func (t *Topology) PickForWrite(count uint64, option *VolumeGrowOption) (string,
 		return "", 0, nil, fmt.Errorf("no writable volumes available for for collectio:%s replication:%s ttl:%s", option.Collection, option.ReplicaPlacement.String(), option.Ttl.String())
 	}
 	fileId, count := t.Sequence.NextFileId(count)
+	if count == 0 {
+		return "", 0, nil, fmt.Errorf("failed to generate file id")
+	}
 	return needle.NewFileId(*vid, fileId, rand.Uint32()).String(), count, datanodes.Head(), nil
 }
 
@@ -203,12 +243,23 @@ func (t *Topology) SyncDataNodeRegistration(volumes []*master_pb.VolumeInformati
 		}
 	}
 	// find out the delta volumes
-	newVolumes, deletedVolumes = dn.UpdateVolumes(volumeInfos)
+	newVolumes, deletedVolumes, changedReadOnlyVolumes := dn.UpdateVolumes(volumeInfos)
 	for _, v := range newVolumes {
 		t.RegisterVolumeLayout(v, dn)
+		t.PublishEvent(newVolumeEvent(EventVolumeCreated, dn, v.Id, v.Collection))
+	}
+	for _, v := range changedReadOnlyVolumes {
+		// re-evaluate the writables, e.g., when the volume server is low in disk space
+		t.RegisterVolumeLayout(v, dn)
+		if v.ReadOnly {
+			t.PublishEvent(newVolumeEvent(EventVolumeReadOnly, dn, v.Id, v.Collection))
+		} else {
+			t.PublishEvent(newVolumeEvent(EventVolumeWritable, dn, v.Id, v.Collection))
+		}
 	}
 	for _, v := range deletedVolumes {
 		t.UnRegisterVolumeLayout(v, dn)
+		t.PublishEvent(newVolumeEvent(EventVolumeDeleted, dn, v.Id, v.Collection))
 	}
 	return
 }
@@ -231,6 +282,13 @@ func (t *Topology) IncrementalSyncDataNodeRegistration(newVolumes, deletedVolume
 		}
 		oldVis = append(oldVis, vi)
 	}
+	// the volumes created by the master are already known
+	var createdVis []storage.VolumeInfo
+	for _, vi := range newVis {
+		if _, err := dn.GetVolumesById(vi.Id); err != nil {
+			createdVis = append(createdVis, vi)
+		}
+	}
 	dn.DeltaUpdateVolumes(newVis, oldVis)
 
 	for _, vi := range newVis {
@@ -239,6 +297,12 @@ func (t *Topology) IncrementalSyncDataNodeRegistration(newVolumes, deletedVolume
 	for _, vi := range oldVis {
 		t.UnRegisterVolumeLayout(vi, dn)
 	}
+	for _, vi := range createdVis {
+		t.PublishEvent(newVolumeEvent(EventVolumeCreated, dn, vi.Id, vi.Collection))
+	}
+	for _, vi := range oldVis {
+		t.PublishEvent(newVolumeEvent(EventVolumeDeleted, dn, vi.Id, vi.Collection))
+	}
 
 	return
 }
@@ -9,7 +9,8 @@ import (
 
 type EcShardLocations struct {
 	Collection string
-	Locations  [erasure_coding.TotalShardsCount][]*DataNode
+	Scheme     erasure_coding.EcScheme
+	Locations  [erasure_coding.MaxShardCount][]*DataNode
 }
 
 func (t *Topology) SyncDataNodeEcShards(shardInfos []*master_pb.VolumeEcShardInformationMessage, dn *DataNode) (newShards, deletedShards []*erasure_coding.EcVolumeInfo) {
@@ -20,15 +21,18 @@ func (t *Topology) SyncDataNodeEcShards(shardInfos []*master_pb.VolumeEcShardInf
 			erasure_coding.NewEcVolumeInfo(
 				shardInfo.Collection,
 				needle.VolumeId(shardInfo.Id),
-				erasure_coding.ShardBits(shardInfo.EcIndexBits)))
+				erasure_coding.ShardBits(shardInfo.EcIndexBits),
+				erasure_coding.ToEcScheme(shardInfo.DataShards, shardInfo.ParityShards)))
 	}
 	// find out the delta volumes
 	newShards, deletedShards = dn.UpdateEcShards(shards)
 	for _, v := range newShards {
 		t.RegisterEcShards(v, dn)
+		t.PublishEvent(newEcShardsEvent(EventEcShardsAdded, dn, v))
 	}
 	for _, v := range deletedShards {
 		t.UnRegisterEcShards(v, dn)
+		t.PublishEvent(newEcShardsEvent(EventEcShardsRemoved, dn, v))
 	}
 	return
 }
@@ -41,33 +45,43 @@ func (t *Topology) IncrementalSyncDataNodeEcShards(newEcShards, deletedEcShards
 			erasure_coding.NewEcVolumeInfo(
 				shardInfo.Collection,
 				needle.VolumeId(shardInfo.Id),
-				erasure_coding.ShardBits(shardInfo.EcIndexBits)))
+				erasure_coding.ShardBits(shardInfo.EcIndexBits),
+				erasure_coding.ToEcScheme(shardInfo.DataShards, shardInfo.ParityShards)))
 	}
 	for _, shardInfo := range deletedEcShards {
 		deletedShards = append(deletedShards,
 			erasure_coding.NewEcVolumeInfo(
 				shardInfo.Collection,
 				needle.VolumeId(shardInfo.Id),
-				erasure_coding.ShardBits(shardInfo.EcIndexBits)))
+				erasure_coding.ShardBits(shardInfo.EcIndexBits),
+				erasure_coding.ToEcScheme(shardInfo.DataShards, shardInfo.ParityShards)))
 	}
 
 	dn.DeltaUpdateEcShards(newShards, deletedShards)
 
 	for _, v := range newShards {
 		t.RegisterEcShards(v, dn)
+		t.PublishEvent(newEcShardsEvent(EventEcShardsAdded, dn, v))
 	}
 	for _, v := range deletedShards {
 		t.UnRegisterEcShards(v, dn)
+		t.PublishEvent(newEcShardsEvent(EventEcShardsRemoved, dn, v))
 	}
 	return
 }
 
-func NewEcShardLocations(collection string) *EcShardLocations {
+func NewEcShardLocations(collection string, scheme erasure_coding.EcScheme) *EcShardLocations {
 	return &EcShardLocations{
 		Collection: collection,
+		Scheme:     scheme,
 	}
 }
 
+// ShardLocations returns the locations of the shards in the ec scheme
+func (loc *EcShardLocations) ShardLocations() [][]*DataNode {
+	return loc.Locations[:loc.Scheme.TotalShards()]
+}
+
 func (loc *EcShardLocations) AddShard(shardId erasure_coding.ShardId, dn *DataNode) (added bool) {
 	dataNodes := loc.Locations[shardId]
 	for _, n := range dataNodes {
@@ -101,7 +115,7 @@ func (t *Topology) RegisterEcShards(ecShardInfos *erasure_coding.EcVolumeInfo, d
 
 	locations, found := t.ecShardMap[ecShardInfos.VolumeId]
 	if !found {
-		locations = NewEcShardLocations(ecShardInfos.Collection)
+		locations = NewEcShardLocations(ecShardInfos.Collection, ecShardInfos.Scheme)
 		t.ecShardMap[ecShardInfos.VolumeId] = locations
 	}
 	for _, shardId := range ecShardInfos.ShardIds() {
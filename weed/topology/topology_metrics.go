@@ -0,0 +1,52 @@
+package topology
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/stats"
+)
+
+// UpdateMetrics sets the gauges from the current topology. Only the leader knows the volumes and the free slots.
+func (t *Topology) UpdateMetrics() {
+
+	stats.MasterVolumeCountGauge.Reset()
+	stats.MasterFreeSlotsGauge.Reset()
+
+	if t.RaftServer != nil {
+		stats.MasterRaftTermGauge.Set(float64(t.RaftServer.Term()))
+	}
+	if !t.IsLeader() {
+		stats.MasterLeaderGauge.Set(0)
+		return
+	}
+	stats.MasterLeaderGauge.Set(1)
+
+	for _, col := range t.collectionMap.Items() {
+		c := col.(*Collection)
+		var volumeCount, writableCount int
+		for _, layout := range c.storageType2VolumeLayout.Items() {
+			if layout == nil {
+				continue
+			}
+			vl := layout.(*VolumeLayout)
+			vl.accessLock.RLock()
+			volumeCount += len(vl.vid2location)
+			writableCount += len(vl.writables)
+			vl.accessLock.RUnlock()
+		}
+		stats.MasterVolumeCountGauge.WithLabelValues(c.Name, "volume").Set(float64(volumeCount))
+		stats.MasterVolumeCountGauge.WithLabelValues(c.Name, "writable").Set(float64(writableCount))
+	}
+
+	t.ecShardMapLock.RLock()
+	for _, ecShardLocations := range t.ecShardMap {
+		stats.MasterVolumeCountGauge.WithLabelValues(ecShardLocations.Collection, "ec_volume").Inc()
+	}
+	t.ecShardMapLock.RUnlock()
+
+	for _, c := range t.Children() {
+		dc := c.(*DataCenter)
+		for _, r := range dc.Children() {
+			rack := r.(*Rack)
+			stats.MasterFreeSlotsGauge.WithLabelValues(string(dc.Id()), string(rack.Id())).Set(float64(rack.FreeSpace()))
+		}
+	}
+}
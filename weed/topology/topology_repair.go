@@ -0,0 +1,474 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"google.golang.org/grpc"
+)
+
+// lostDataNode remembers what was on a disconnected volume server,
+// until it comes back or the grace period is over.
+type lostDataNode struct {
+	url       string
+	lostAt    time.Time
+	volumes   []storage.VolumeInfo
+	ecVolumes []needle.VolumeId
+}
+
+type repairTask struct {
+	vid        needle.VolumeId
+	isEcVolume bool
+	volume     storage.VolumeInfo
+}
+
+func (task *repairTask) String() string {
+	if task.isEcVolume {
+		return fmt.Sprintf("rebuild ec volume %d", task.vid)
+	}
+	return fmt.Sprintf("replicate volume %d", task.vid)
+}
+
+// VolumeRepair re-replicates volumes and rebuilds ec volumes which lost copies on dead volume servers.
+type VolumeRepair struct {
+	enabled       bool
+	gracePeriod   time.Duration
+	maxConcurrent int
+	paused        int32
+
+	sync.Mutex
+	lostDataNodes  map[string]*lostDataNode
+	pendingTasks   []*repairTask
+	scheduledTasks map[needle.VolumeId]bool
+	runningTasks   map[needle.VolumeId]*repairTask
+	lastError      string
+
+	succeededCount uint64
+	skippedCount   uint64
+	failedCount    uint64
+}
+
+func NewVolumeRepair() *VolumeRepair {
+	return &VolumeRepair{
+		lostDataNodes:  make(map[string]*lostDataNode),
+		scheduledTasks: make(map[needle.VolumeId]bool),
+		runningTasks:   make(map[needle.VolumeId]*repairTask),
+		maxConcurrent:  1,
+	}
+}
+
+func (r *VolumeRepair) Configure(enabled bool, gracePeriod time.Duration, maxConcurrent int) {
+	r.Lock()
+	defer r.Unlock()
+	r.enabled = enabled
+	r.gracePeriod = gracePeriod
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+	r.maxConcurrent = maxConcurrent
+	if enabled {
+		glog.V(0).Infof("volume repair after %v, with %d concurrent tasks", gracePeriod, maxConcurrent)
+	}
+}
+
+// SetPaused is the kill switch. Running tasks are finished, but no new tasks are started.
+func (r *VolumeRepair) SetPaused(paused bool) {
+	if paused {
+		atomic.StoreInt32(&r.paused, 1)
+		glog.V(0).Infof("volume repair is paused")
+	} else {
+		atomic.StoreInt32(&r.paused, 0)
+		glog.V(0).Infof("volume repair is resumed")
+	}
+}
+
+func (r *VolumeRepair) IsPaused() bool {
+	return atomic.LoadInt32(&r.paused) == 1
+}
+
+func (r *VolumeRepair) dataNodeLost(dn *DataNode) {
+	r.Lock()
+	defer r.Unlock()
+	if !r.enabled {
+		return
+	}
+	lost := &lostDataNode{
+		url:     dn.Url(),
+		lostAt:  time.Now(),
+		volumes: dn.GetVolumes(),
+	}
+	for _, ecShards := range dn.GetEcShards() {
+		lost.ecVolumes = append(lost.ecVolumes, ecShards.VolumeId)
+	}
+	if len(lost.volumes) == 0 && len(lost.ecVolumes) == 0 {
+		return
+	}
+	glog.V(0).Infof("volume server %s is lost with %d volumes and %d ec volumes, repair in %v",
+		lost.url, len(lost.volumes), len(lost.ecVolumes), r.gracePeriod)
+	r.lostDataNodes[lost.url] = lost
+}
+
+// DataNodeFound cancels the repair of a volume server coming back within the grace period.
+func (r *VolumeRepair) DataNodeFound(url string) {
+	r.Lock()
+	defer r.Unlock()
+	if _, found := r.lostDataNodes[url]; found {
+		glog.V(0).Infof("volume server %s is back, no repair needed", url)
+		delete(r.lostDataNodes, url)
+	}
+}
+
+// scheduleRepairTasks turns the volume servers lost longer than the grace period into repair tasks.
+func (r *VolumeRepair) scheduleRepairTasks() {
+	r.Lock()
+	defer r.Unlock()
+	for url, lost := range r.lostDataNodes {
+		if time.Since(lost.lostAt) < r.gracePeriod {
+			continue
+		}
+		glog.V(0).Infof("volume server %s has been lost since %v, repairing its volumes", url, lost.lostAt)
+		for _, v := range lost.volumes {
+			if v.ReplicaPlacement != nil && v.ReplicaPlacement.GetCopyCount() > 1 && !r.scheduledTasks[v.Id] {
+				r.scheduledTasks[v.Id] = true
+				r.pendingTasks = append(r.pendingTasks, &repairTask{vid: v.Id, volume: v})
+			}
+		}
+		for _, vid := range lost.ecVolumes {
+			if !r.scheduledTasks[vid] {
+				r.scheduledTasks[vid] = true
+				r.pendingTasks = append(r.pendingTasks, &repairTask{vid: vid, isEcVolume: true})
+			}
+		}
+		delete(r.lostDataNodes, url)
+	}
+}
+
+func (r *VolumeRepair) nextTask() *repairTask {
+	r.Lock()
+	defer r.Unlock()
+	if len(r.pendingTasks) == 0 || len(r.runningTasks) >= r.maxConcurrent {
+		return nil
+	}
+	task := r.pendingTasks[0]
+	r.pendingTasks = r.pendingTasks[1:]
+	r.runningTasks[task.vid] = task
+	return task
+}
+
+func (r *VolumeRepair) finishTask(task *repairTask, skipped bool, err error) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.runningTasks, task.vid)
+	delete(r.scheduledTasks, task.vid)
+	if err != nil {
+		glog.Errorf("%s: %v", task, err)
+		r.lastError = fmt.Sprintf("%s: %v", task, err)
+		r.failedCount++
+	} else if skipped {
+		r.skippedCount++
+	} else {
+		glog.V(0).Infof("%s: done", task)
+		r.succeededCount++
+	}
+}
+
+func (t *Topology) startVolumeRepair(grpcDialOption grpc.DialOption) {
+	for {
+		if t.IsLeader() && !t.Repair.IsPaused() {
+			t.Repair.scheduleRepairTasks()
+			for task := t.Repair.nextTask(); task != nil; task = t.Repair.nextTask() {
+				go func(task *repairTask) {
+					skipped, err := t.repairVolume(grpcDialOption, task)
+					t.Repair.finishTask(task, skipped, err)
+				}(task)
+			}
+		}
+		time.Sleep(time.Duration(t.pulse) * time.Second)
+	}
+}
+
+func (t *Topology) repairVolume(grpcDialOption grpc.DialOption, task *repairTask) (skipped bool, err error) {
+	if task.isEcVolume {
+		return t.rebuildEcVolume(grpcDialOption, task.vid)
+	}
+	return t.replicateVolume(grpcDialOption, task.volume)
+}
+
+func (t *Topology) replicateVolume(grpcDialOption grpc.DialOption, v storage.VolumeInfo) (skipped bool, err error) {
+
+	existingLocations := t.Lookup(v.Collection, v.Id)
+	if len(existingLocations) >= v.ReplicaPlacement.GetCopyCount() {
+		return true, nil
+	}
+	if len(existingLocations) == 0 {
+		return false, fmt.Errorf("no replica left")
+	}
+
+	dst := pickReplicaDestination(t.allDataNodes(), existingLocations, v.ReplicaPlacement)
+	if dst == nil {
+		return false, fmt.Errorf("no volume server satisfies replica placement %s", v.ReplicaPlacement)
+	}
+	src := existingLocations[0]
+
+	glog.V(0).Infof("replicate volume %d from %s to %s", v.Id, src.Url(), dst.Url())
+	err = operation.WithVolumeServerClient(dst.Url(), grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		_, copyErr := client.VolumeCopy(context.Background(), &volume_server_pb.VolumeCopyRequest{
+			VolumeId:       uint32(v.Id),
+			SourceDataNode: src.Url(),
+		})
+		return copyErr
+	})
+	return false, err
+}
+
+func (t *Topology) allDataNodes() (dataNodes []*DataNode) {
+	for _, dc := range t.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dataNodes = append(dataNodes, n.(*DataNode))
+			}
+		}
+	}
+	return
+}
+
+// pickReplicaDestination picks the volume server with the most free slots,
+// which satisfies the replica placement together with the existing replicas.
+func pickReplicaDestination(candidates []*DataNode, existingLocations []*DataNode, rp *storage.ReplicaPlacement) *DataNode {
+
+	existingDataNodes := make(map[NodeId]bool)
+	for _, dn := range existingLocations {
+		existingDataNodes[dn.Id()] = true
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].FreeSpace() > candidates[j].FreeSpace()
+	})
+
+	for _, dn := range candidates {
+		if dn.FreeSpace() <= 0 || existingDataNodes[dn.Id()] {
+			continue
+		}
+		if isPartialReplicaPlacement(append(existingLocations[:len(existingLocations):len(existingLocations)], dn), rp) {
+			return dn
+		}
+	}
+
+	return nil
+}
+
+// isPartialReplicaPlacement checks whether the volume servers can be a part of the replica placement:
+// rp.SameRackCount+1 servers in the main rack, one server in each of rp.DiffRackCount other racks of the main data center,
+// and one server in each of rp.DiffDataCenterCount other data centers.
+func isPartialReplicaPlacement(dataNodes []*DataNode, rp *storage.ReplicaPlacement) bool {
+
+	dataCenters := make(map[*DataCenter][]*DataNode)
+	for _, dn := range dataNodes {
+		dataCenters[dn.GetDataCenter()] = append(dataCenters[dn.GetDataCenter()], dn)
+	}
+	if len(dataCenters) > rp.DiffDataCenterCount+1 {
+		return false
+	}
+
+	for mainDataCenter, mainDataNodes := range dataCenters {
+		otherDataCentersFit := true
+		for dc, dcDataNodes := range dataCenters {
+			if dc != mainDataCenter && len(dcDataNodes) > 1 {
+				otherDataCentersFit = false
+			}
+		}
+		if otherDataCentersFit && isPartialRackPlacement(mainDataNodes, rp) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPartialRackPlacement checks the volume servers in the main data center
+func isPartialRackPlacement(dataNodes []*DataNode, rp *storage.ReplicaPlacement) bool {
+
+	racks := make(map[*Rack]int)
+	for _, dn := range dataNodes {
+		racks[dn.GetRack()]++
+	}
+	if len(racks) > rp.DiffRackCount+1 {
+		return false
+	}
+
+	for mainRack, mainCount := range racks {
+		if mainCount > rp.SameRackCount+1 {
+			continue
+		}
+		otherRacksFit := true
+		for rack, count := range racks {
+			if rack != mainRack && count > 1 {
+				otherRacksFit = false
+			}
+		}
+		if otherRacksFit {
+			return true
+		}
+	}
+	return false
+}
+
+// rebuildEcVolume collects enough shards to one volume server, rebuilds the missing shards there, and mounts them.
+// The rebuilt shards can be spread out by ec.balance later.
+func (t *Topology) rebuildEcVolume(grpcDialOption grpc.DialOption, vid needle.VolumeId) (skipped bool, err error) {
+
+	t.ecShardMapLock.RLock()
+	ecLocations, found := t.ecShardMap[vid]
+	var locations [][]*DataNode
+	var collection string
+	var scheme erasure_coding.EcScheme
+	if found {
+		locations = append(locations, ecLocations.ShardLocations()...)
+		collection = ecLocations.Collection
+		scheme = ecLocations.Scheme
+	}
+	t.ecShardMapLock.RUnlock()
+	if !found {
+		return false, fmt.Errorf("no ec shards left")
+	}
+
+	shardCount := 0
+	shardCountByDataNode := make(map[*DataNode]int)
+	for _, dataNodes := range locations {
+		if len(dataNodes) > 0 {
+			shardCount++
+		}
+		for _, dn := range dataNodes {
+			shardCountByDataNode[dn]++
+		}
+	}
+	if shardCount == scheme.TotalShards() {
+		return true, nil
+	}
+	if shardCount < scheme.DataShards {
+		return false, fmt.Errorf("unrepairable with %d shards", shardCount)
+	}
+
+	// prefer the volume server with the most shards, to copy less
+	var rebuilder *DataNode
+	for _, dn := range t.allDataNodes() {
+		if dn.FreeSpace() < 2 {
+			continue
+		}
+		if rebuilder == nil || shardCountByDataNode[dn] > shardCountByDataNode[rebuilder] ||
+			shardCountByDataNode[dn] == shardCountByDataNode[rebuilder] && dn.FreeSpace() > rebuilder.FreeSpace() {
+			rebuilder = dn
+		}
+	}
+	if rebuilder == nil {
+		return false, fmt.Errorf("no volume server has enough free slots to rebuild")
+	}
+
+	ctx := context.Background()
+	glog.V(0).Infof("rebuild ec volume %d on %s", vid, rebuilder.Url())
+
+	err = operation.WithVolumeServerClient(rebuilder.Url(), grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		needEcxFile := shardCountByDataNode[rebuilder] == 0
+		var copiedShardIds []uint32
+		defer func() {
+			if len(copiedShardIds) == 0 {
+				return
+			}
+			// clean up the shards only copied for rebuilding
+			if _, deleteErr := client.VolumeEcShardsDelete(ctx, &volume_server_pb.VolumeEcShardsDeleteRequest{
+				VolumeId:   uint32(vid),
+				Collection: collection,
+				ShardIds:   copiedShardIds,
+			}); deleteErr != nil {
+				glog.Errorf("delete copied ec shards %d.%v on %s: %v", vid, copiedShardIds, rebuilder.Url(), deleteErr)
+			}
+		}()
+
+		for shardId, dataNodes := range locations {
+			if len(dataNodes) == 0 || hasDataNode(dataNodes, rebuilder) {
+				continue
+			}
+			if _, copyErr := client.VolumeEcShardsCopy(ctx, &volume_server_pb.VolumeEcShardsCopyRequest{
+				VolumeId:       uint32(vid),
+				Collection:     collection,
+				ShardIds:       []uint32{uint32(shardId)},
+				CopyEcxFile:    needEcxFile,
+				SourceDataNode: dataNodes[0].Url(),
+			}); copyErr != nil {
+				return fmt.Errorf("copy shard %d.%d from %s: %v", vid, shardId, dataNodes[0].Url(), copyErr)
+			}
+			needEcxFile = false
+			copiedShardIds = append(copiedShardIds, uint32(shardId))
+		}
+
+		resp, rebuildErr := client.VolumeEcShardsRebuild(ctx, &volume_server_pb.VolumeEcShardsRebuildRequest{
+			VolumeId:   uint32(vid),
+			Collection: collection,
+		})
+		if rebuildErr != nil {
+			return fmt.Errorf("rebuild: %v", rebuildErr)
+		}
+
+		if _, mountErr := client.VolumeEcShardsMount(ctx, &volume_server_pb.VolumeEcShardsMountRequest{
+			VolumeId:   uint32(vid),
+			Collection: collection,
+			ShardIds:   resp.RebuiltShardIds,
+		}); mountErr != nil {
+			return fmt.Errorf("mount rebuilt shards %v: %v", resp.RebuiltShardIds, mountErr)
+		}
+
+		return nil
+	})
+
+	return false, err
+}
+
+func hasDataNode(dataNodes []*DataNode, dn *DataNode) bool {
+	for _, d := range dataNodes {
+		if d == dn {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *VolumeRepair) ToVolumeRepairResponse() *master_pb.VolumeRepairResponse {
+	r.Lock()
+	defer r.Unlock()
+
+	resp := &master_pb.VolumeRepairResponse{
+		IsEnabled:          r.enabled,
+		IsPaused:           r.IsPaused(),
+		GracePeriodSeconds: uint32(r.gracePeriod.Seconds()),
+		PendingTaskCount:   uint32(len(r.pendingTasks)),
+		SucceededTaskCount: r.succeededCount,
+		SkippedTaskCount:   r.skippedCount,
+		FailedTaskCount:    r.failedCount,
+		LastError:          r.lastError,
+	}
+	for _, lost := range r.lostDataNodes {
+		resp.LostDataNodes = append(resp.LostDataNodes, &master_pb.VolumeRepairResponse_LostDataNode{
+			Url:           lost.url,
+			LostAtNs:      lost.lostAt.UnixNano(),
+			VolumeCount:   uint32(len(lost.volumes)),
+			EcVolumeCount: uint32(len(lost.ecVolumes)),
+		})
+	}
+	for _, task := range r.runningTasks {
+		resp.RunningTasks = append(resp.RunningTasks, task.String())
+	}
+	sort.Strings(resp.RunningTasks)
+
+	return resp
+}
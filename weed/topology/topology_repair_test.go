@@ -0,0 +1,88 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func findDataNode(topo *Topology, id string) *DataNode {
+	for _, dn := range topo.allDataNodes() {
+		if string(dn.Id()) == id {
+			return dn
+		}
+	}
+	return nil
+}
+
+func TestPickReplicaDestination(t *testing.T) {
+	topo := setup(topologyLayout)
+
+	tests := []struct {
+		replication string
+		existing    []string
+		expected    string
+	}{
+		{"010", []string{"server111"}, "server122"},
+		{"100", []string{"server111"}, "server321"},
+		{"001", []string{"server111"}, "server112"},
+		// the same rack, not the one with the most free slots
+		{"001", []string{"server121"}, "server122"},
+		// the lost copy was in the same rack as one of the existing copies
+		{"011", []string{"server111", "server121"}, "server112"},
+		{"011", []string{"server111", "server112"}, "server122"},
+		// no other rack in dc3, and the other data centers are not allowed
+		{"010", []string{"server321"}, ""},
+		{"100", []string{"server111", "server321"}, ""},
+	}
+
+	for _, tt := range tests {
+		rp, _ := storage.NewReplicaPlacementFromString(tt.replication)
+		var existing []*DataNode
+		for _, id := range tt.existing {
+			existing = append(existing, findDataNode(topo, id))
+		}
+		dst := pickReplicaDestination(topo.allDataNodes(), existing, rp)
+		if tt.expected == "" {
+			if dst != nil {
+				t.Errorf("replication %s with %v: expected none, got %s", tt.replication, tt.existing, dst.Id())
+			}
+			continue
+		}
+		if dst == nil || string(dst.Id()) != tt.expected {
+			t.Errorf("replication %s with %v: expected %s, got %v", tt.replication, tt.existing, tt.expected, dst)
+		}
+	}
+}
+
+func TestVolumeRepairLostDataNode(t *testing.T) {
+	topo := setup(topologyLayout)
+	topo.Repair.Configure(true, 0, 2)
+
+	rp, _ := storage.NewReplicaPlacementFromString("001")
+	dn := findDataNode(topo, "server112")
+	dn.AddOrUpdateVolume(storage.VolumeInfo{Id: needle.VolumeId(7), ReplicaPlacement: rp, Version: needle.CurrentVersion})
+
+	topo.Repair.dataNodeLost(dn)
+	topo.Repair.DataNodeFound(dn.Url())
+	topo.Repair.scheduleRepairTasks()
+	if len(topo.Repair.pendingTasks) != 0 {
+		t.Fatalf("unexpected repair tasks for a volume server back in time: %d", len(topo.Repair.pendingTasks))
+	}
+
+	topo.Repair.dataNodeLost(dn)
+	topo.Repair.scheduleRepairTasks()
+	if len(topo.Repair.pendingTasks) != 1 || topo.Repair.pendingTasks[0].vid != 7 {
+		t.Fatalf("expected one repair task for volume 7, got %+v", topo.Repair.pendingTasks)
+	}
+
+	task := topo.Repair.nextTask()
+	if task == nil || topo.Repair.nextTask() != nil {
+		t.Fatalf("expected exactly one task to run")
+	}
+	topo.Repair.finishTask(task, false, nil)
+	if resp := topo.Repair.ToVolumeRepairResponse(); resp.SucceededTaskCount != 1 || len(resp.RunningTasks) != 0 {
+		t.Fatalf("unexpected repair status %+v", resp)
+	}
+}
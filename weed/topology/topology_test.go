@@ -4,6 +4,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/sequence"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 
 	"testing"
@@ -173,3 +174,66 @@ func TestAddRemoveVolume(t *testing.T) {
 	}
 
 }
+
+func TestVolumeServerLowDiskSpace(t *testing.T) {
+
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+
+	dc := topo.GetOrCreateDataCenter("dc1")
+	rack := dc.GetOrCreateRack("rack1")
+	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25)
+
+	volume := &master_pb.VolumeInformationMessage{
+		Id:               1,
+		Size:             100,
+		Collection:       "",
+		Version:          uint32(needle.CurrentVersion),
+		ReplicaPlacement: 0,
+	}
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volume}, dn)
+	dn.UpdateDiskSpace(25, nil)
+
+	vl := topo.GetVolumeLayout("", &storage.ReplicaPlacement{}, needle.EMPTY_TTL)
+	assert(t, "writables", len(vl.writables), 1)
+	assert(t, "freeSpace", int(topo.FreeSpace()), 24)
+
+	volume.ReadOnly = true
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volume}, dn)
+	dn.UpdateDiskSpace(1, []string{"/data"})
+
+	assert(t, "writables", len(vl.writables), 0)
+	assert(t, "activeVolumeCount", int(topo.activeVolumeCount), 0)
+	assert(t, "freeSpace", int(topo.FreeSpace()), 0)
+	assert(t, "lowDiskSpaceDirs", len(dn.ToDataNodeInfo().LowDiskSpaceDirs), 1)
+
+	volume.ReadOnly = false
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volume}, dn)
+	dn.UpdateDiskSpace(25, nil)
+
+	assert(t, "writables", len(vl.writables), 1)
+	assert(t, "activeVolumeCount", int(topo.activeVolumeCount), 1)
+	assert(t, "freeSpace", int(topo.FreeSpace()), 24)
+	assert(t, "lowDiskSpaceDirs", len(dn.ToDataNodeInfo().LowDiskSpaceDirs), 0)
+
+}
+
+func TestEcShardsFreeSpace(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+
+	dc := topo.GetOrCreateDataCenter("dc1")
+	rack := dc.GetOrCreateRack("rack1")
+	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25)
+
+	// 6 shards of a 4+2 volume take 2 slots, 10 shards of a 10+4 volume take 1 slot
+	dn.AddOrUpdateEcShard(erasure_coding.NewEcVolumeInfo("small", 1, erasure_coding.ShardBits(0x3f), erasure_coding.EcScheme{DataShards: 4, ParityShards: 2}))
+	dn.AddOrUpdateEcShard(erasure_coding.NewEcVolumeInfo("", 2, erasure_coding.ShardBits(0x3ff), erasure_coding.DefaultEcScheme))
+	assert(t, "dn free space", int(dn.FreeSpace()), 25-3)
+	assert(t, "topo free space", int(topo.FreeSpace()), 25-3)
+
+	dn.DeleteEcShard(erasure_coding.NewEcVolumeInfo("small", 1, erasure_coding.ShardBits(0x3c), erasure_coding.EcScheme{DataShards: 4, ParityShards: 2}))
+	assert(t, "dn free space after deleting shards", int(dn.FreeSpace()), 25-2)
+	assert(t, "rack free space after deleting shards", int(rack.FreeSpace()), 25-2)
+
+	dc.UnlinkChildNode(rack.Id())
+	assert(t, "topo free space after removing the rack", int(topo.FreeSpace()), 0)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/stats"
 )
 
 func batchVacuumVolumeCheck(grpcDialOption grpc.DialOption, vl *VolumeLayout, vid needle.VolumeId, locationlist *VolumeLocationList, garbageThreshold float64) bool {
@@ -138,14 +139,15 @@ func (t *Topology) Vacuum(grpcDialOption grpc.DialOption, garbageThreshold float
 		for _, vl := range c.storageType2VolumeLayout.Items() {
 			if vl != nil {
 				volumeLayout := vl.(*VolumeLayout)
-				vacuumOneVolumeLayout(grpcDialOption, volumeLayout, c, garbageThreshold, preallocate)
+				t.vacuumOneVolumeLayout(grpcDialOption, volumeLayout, c, garbageThreshold, preallocate)
 			}
 		}
 	}
+	t.vacuumEcVolumes(grpcDialOption, garbageThreshold)
 	return 0
 }
 
-func vacuumOneVolumeLayout(grpcDialOption grpc.DialOption, volumeLayout *VolumeLayout, c *Collection, garbageThreshold float64, preallocate int64) {
+func (t *Topology) vacuumOneVolumeLayout(grpcDialOption grpc.DialOption, volumeLayout *VolumeLayout, c *Collection, garbageThreshold float64, preallocate int64) {
 
 	volumeLayout.accessLock.RLock()
 	tmpMap := make(map[needle.VolumeId]*VolumeLocationList)
@@ -166,10 +168,19 @@ func vacuumOneVolumeLayout(grpcDialOption grpc.DialOption, volumeLayout *VolumeL
 
 		glog.V(2).Infof("check vacuum on collection:%s volume:%d", c.Name, vid)
 		if batchVacuumVolumeCheck(grpcDialOption, volumeLayout, vid, locationList, garbageThreshold) {
+			t.publishVacuumEvent(EventVacuumStarted, vid, c.Name, "volume")
 			if batchVacuumVolumeCompact(grpcDialOption, volumeLayout, vid, locationList, preallocate) {
-				batchVacuumVolumeCommit(grpcDialOption, volumeLayout, vid, locationList)
+				if batchVacuumVolumeCommit(grpcDialOption, volumeLayout, vid, locationList) {
+					stats.MasterVacuumCounter.WithLabelValues("volume", "committed").Inc()
+					t.publishVacuumEvent(EventVacuumFinished, vid, c.Name, "volume committed")
+				} else {
+					stats.MasterVacuumCounter.WithLabelValues("volume", "failed").Inc()
+					t.publishVacuumEvent(EventVacuumFinished, vid, c.Name, "volume failed")
+				}
 			} else {
+				stats.MasterVacuumCounter.WithLabelValues("volume", "failed").Inc()
 				batchVacuumVolumeCleanup(grpcDialOption, volumeLayout, vid, locationList)
+				t.publishVacuumEvent(EventVacuumFinished, vid, c.Name, "volume failed")
 			}
 		}
 	}
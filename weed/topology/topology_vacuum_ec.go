@@ -0,0 +1,285 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"google.golang.org/grpc"
+)
+
+func (t *Topology) vacuumEcVolumes(grpcDialOption grpc.DialOption, garbageThreshold float64) {
+
+	t.ecShardMapLock.RLock()
+	tmpMap := make(map[needle.VolumeId]*EcShardLocations)
+	for vid, ecLocations := range t.ecShardMap {
+		snapshot := NewEcShardLocations(ecLocations.Collection, ecLocations.Scheme)
+		for shardId, dataNodes := range ecLocations.Locations {
+			snapshot.Locations[shardId] = append([]*DataNode(nil), dataNodes...)
+		}
+		tmpMap[vid] = snapshot
+	}
+	t.ecShardMapLock.RUnlock()
+
+	for vid, ecLocations := range tmpMap {
+		glog.V(2).Infof("check vacuum on collection:%s ec volume:%d", ecLocations.Collection, vid)
+		t.vacuumOneEcVolume(grpcDialOption, vid, ecLocations, garbageThreshold)
+	}
+}
+
+func (t *Topology) vacuumOneEcVolume(grpcDialOption grpc.DialOption, vid needle.VolumeId, ecLocations *EcShardLocations, garbageThreshold float64) {
+
+	// group the shards by the volume servers
+	var dataNodes []*DataNode
+	nodeShardIds := make(map[*DataNode][]uint32)
+	for shardId, shardLocations := range ecLocations.ShardLocations() {
+		for _, dn := range shardLocations {
+			if _, found := nodeShardIds[dn]; !found {
+				dataNodes = append(dataNodes, dn)
+			}
+			nodeShardIds[dn] = append(nodeShardIds[dn], uint32(shardId))
+		}
+	}
+	if len(dataNodes) == 0 {
+		return
+	}
+
+	if !batchVacuumEcVolumeCheck(grpcDialOption, vid, ecLocations.Collection, dataNodes[0], garbageThreshold) {
+		return
+	}
+
+	t.publishVacuumEvent(EventVacuumStarted, vid, ecLocations.Collection, "ec volume")
+	result := "failed"
+	defer func() {
+		t.publishVacuumEvent(EventVacuumFinished, vid, ecLocations.Collection, "ec volume "+result)
+	}()
+
+	// the volume server with the most data shards compacts the ec volume
+	compactor := dataNodes[0]
+	for _, dn := range dataNodes {
+		if countDataShards(nodeShardIds[dn], ecLocations.Scheme) > countDataShards(nodeShardIds[compactor], ecLocations.Scheme) {
+			compactor = dn
+		}
+	}
+
+	copiedShardIds, err := copyMissingDataShards(grpcDialOption, vid, ecLocations, compactor, nodeShardIds[compactor])
+	defer deleteCopiedDataShards(grpcDialOption, vid, ecLocations.Collection, compactor, copiedShardIds)
+	if err != nil {
+		glog.Errorf("Error when collecting data shards of ec volume %d to %s: %v", vid, compactor.Url(), err)
+		return
+	}
+
+	// the compacted files are committed on all the volume servers, or on none of them
+	if batchVacuumEcVolumeCompact(grpcDialOption, vid, ecLocations.Collection, compactor, dataNodes, nodeShardIds) &&
+		batchVacuumEcVolumePrepare(grpcDialOption, vid, ecLocations.Collection, dataNodes) &&
+		batchVacuumEcVolumeCommit(grpcDialOption, vid, ecLocations.Collection, dataNodes) {
+		stats.MasterVacuumCounter.WithLabelValues("ec_volume", "committed").Inc()
+		result = "committed"
+	} else {
+		stats.MasterVacuumCounter.WithLabelValues("ec_volume", "failed").Inc()
+	}
+	batchVacuumEcVolumeCleanup(grpcDialOption, vid, ecLocations.Collection, dataNodes)
+}
+
+func countDataShards(shardIds []uint32, scheme erasure_coding.EcScheme) (count int) {
+	for _, shardId := range shardIds {
+		if int(shardId) < scheme.DataShards {
+			count++
+		}
+	}
+	return
+}
+
+func batchVacuumEcVolumeCheck(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, dn *DataNode, garbageThreshold float64) (isNeeded bool) {
+	err := operation.WithVolumeServerClient(dn.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		resp, err := volumeServerClient.VacuumEcVolumeCheck(context.Background(), &volume_server_pb.VacuumEcVolumeCheckRequest{
+			VolumeId:   uint32(vid),
+			Collection: collection,
+		})
+		if err != nil {
+			return err
+		}
+		isNeeded = resp.GarbageRatio > garbageThreshold
+		return nil
+	})
+	if err != nil {
+		glog.V(0).Infof("Checking vacuuming ec volume %d on %s: %v", vid, dn.Url(), err)
+		return false
+	}
+	return
+}
+
+func copyMissingDataShards(grpcDialOption grpc.DialOption, vid needle.VolumeId, ecLocations *EcShardLocations, compactor *DataNode, localShardIds []uint32) (copiedShardIds []uint32, err error) {
+
+	localShards := make(map[uint32]bool)
+	for _, shardId := range localShardIds {
+		localShards[shardId] = true
+	}
+
+	for shardId := 0; shardId < ecLocations.Scheme.DataShards; shardId++ {
+		if localShards[uint32(shardId)] {
+			continue
+		}
+		shardLocations := ecLocations.Locations[shardId]
+		if len(shardLocations) == 0 {
+			return copiedShardIds, fmt.Errorf("missing data shard %d.%d", vid, shardId)
+		}
+		err = operation.WithVolumeServerClient(compactor.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, copyErr := volumeServerClient.VolumeEcShardsCopy(context.Background(), &volume_server_pb.VolumeEcShardsCopyRequest{
+				VolumeId:       uint32(vid),
+				Collection:     ecLocations.Collection,
+				ShardIds:       []uint32{uint32(shardId)},
+				SourceDataNode: shardLocations[0].Url(),
+			})
+			return copyErr
+		})
+		if err != nil {
+			return copiedShardIds, err
+		}
+		copiedShardIds = append(copiedShardIds, uint32(shardId))
+	}
+
+	return
+}
+
+func deleteCopiedDataShards(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, compactor *DataNode, copiedShardIds []uint32) {
+	if len(copiedShardIds) == 0 {
+		return
+	}
+	err := operation.WithVolumeServerClient(compactor.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		_, err := volumeServerClient.VolumeEcShardsDelete(context.Background(), &volume_server_pb.VolumeEcShardsDeleteRequest{
+			VolumeId:   uint32(vid),
+			Collection: collection,
+			ShardIds:   copiedShardIds,
+		})
+		return err
+	})
+	if err != nil {
+		glog.Errorf("Error when deleting copied ec shards %d.%v on %s: %v", vid, copiedShardIds, compactor.Url(), err)
+	}
+}
+
+func batchVacuumEcVolumeCompact(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, compactor *DataNode, dataNodes []*DataNode, nodeShardIds map[*DataNode][]uint32) bool {
+
+	glog.V(0).Infoln("Start vacuuming ec volume", vid, "on", compactor.Url())
+	err := operation.WithVolumeServerClient(compactor.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		_, err := volumeServerClient.VacuumEcVolumeCompact(context.Background(), &volume_server_pb.VacuumEcVolumeCompactRequest{
+			VolumeId:   uint32(vid),
+			Collection: collection,
+		})
+		return err
+	})
+	if err != nil {
+		glog.Errorf("Error when vacuuming ec volume %d on %s: %v", vid, compactor.Url(), err)
+		return false
+	}
+
+	// the other volume servers copy the compacted files for their shards
+	for _, dn := range dataNodes {
+		if dn == compactor {
+			continue
+		}
+		err := operation.WithVolumeServerClient(dn.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, err := volumeServerClient.VolumeEcShardsCopy(context.Background(), &volume_server_pb.VolumeEcShardsCopyRequest{
+				VolumeId:           uint32(vid),
+				Collection:         collection,
+				ShardIds:           nodeShardIds[dn],
+				SourceDataNode:     compactor.Url(),
+				CopyCompactedFiles: true,
+			})
+			return err
+		})
+		if err != nil {
+			glog.Errorf("Error when copying vacuumed ec volume %d from %s to %s: %v", vid, compactor.Url(), dn.Url(), err)
+			return false
+		}
+	}
+
+	glog.V(0).Infof("Complete vacuuming ec volume %d on %s", vid, compactor.Url())
+	return true
+}
+
+// batchVacuumEcVolumePrepare checks all the volume servers have the compacted files before any of them commits
+func batchVacuumEcVolumePrepare(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, dataNodes []*DataNode) bool {
+	for _, dn := range dataNodes {
+		err := operation.WithVolumeServerClient(dn.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, err := volumeServerClient.VacuumEcVolumePrepare(context.Background(), &volume_server_pb.VacuumEcVolumePrepareRequest{
+				VolumeId:   uint32(vid),
+				Collection: collection,
+			})
+			return err
+		})
+		if err != nil {
+			glog.Errorf("Error when preparing to commit vacuum ec volume %d on %s: %v", vid, dn.Url(), err)
+			return false
+		}
+	}
+	return true
+}
+
+// batchVacuumEcVolumeCommit commits on each volume server. The volume servers keep serving the previous
+// generation of the shards until the cleanup, so the shards can be read across the servers in the meantime.
+// If any volume server fails, the committed ones are rolled back.
+func batchVacuumEcVolumeCommit(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, dataNodes []*DataNode) bool {
+	var committed []*DataNode
+	for _, dn := range dataNodes {
+		glog.V(0).Infoln("Start Committing vacuum ec volume", vid, "on", dn.Url())
+		err := operation.WithVolumeServerClient(dn.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, err := volumeServerClient.VacuumEcVolumeCommit(context.Background(), &volume_server_pb.VacuumEcVolumeCommitRequest{
+				VolumeId:   uint32(vid),
+				Collection: collection,
+			})
+			return err
+		})
+		// a failed commit may have swapped some of the files
+		committed = append(committed, dn)
+		if err != nil {
+			glog.Errorf("Error when committing vacuum ec volume %d on %s: %v", vid, dn.Url(), err)
+			batchVacuumEcVolumeRollback(grpcDialOption, vid, collection, committed)
+			return false
+		}
+		glog.V(0).Infof("Complete Committing vacuum ec volume %d on %s", vid, dn.Url())
+	}
+	return true
+}
+
+func batchVacuumEcVolumeRollback(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, dataNodes []*DataNode) {
+	for _, dn := range dataNodes {
+		glog.V(0).Infoln("Start rolling back vacuum ec volume", vid, "on", dn.Url())
+		err := operation.WithVolumeServerClient(dn.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, err := volumeServerClient.VacuumEcVolumeRollback(context.Background(), &volume_server_pb.VacuumEcVolumeRollbackRequest{
+				VolumeId:   uint32(vid),
+				Collection: collection,
+			})
+			return err
+		})
+		if err != nil {
+			glog.Errorf("Error when rolling back vacuum ec volume %d on %s: %v", vid, dn.Url(), err)
+		} else {
+			glog.V(0).Infof("Complete rolling back vacuum ec volume %d on %s", vid, dn.Url())
+		}
+	}
+}
+
+func batchVacuumEcVolumeCleanup(grpcDialOption grpc.DialOption, vid needle.VolumeId, collection string, dataNodes []*DataNode) {
+	for _, dn := range dataNodes {
+		glog.V(0).Infoln("Start cleaning up vacuum ec volume", vid, "on", dn.Url())
+		err := operation.WithVolumeServerClient(dn.Url(), grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			_, err := volumeServerClient.VacuumEcVolumeCleanup(context.Background(), &volume_server_pb.VacuumEcVolumeCleanupRequest{
+				VolumeId:   uint32(vid),
+				Collection: collection,
+			})
+			return err
+		})
+		if err != nil {
+			glog.Errorf("Error when cleaning up vacuum ec volume %d on %s: %v", vid, dn.Url(), err)
+		} else {
+			glog.V(0).Infof("Complete cleaning up vacuum ec volume %d on %s", vid, dn.Url())
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package topology
+
+import (
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+const (
+	EventNodeJoined      = "node_joined"
+	EventNodeLeft        = "node_left"
+	EventVolumeCreated   = "volume_created"
+	EventVolumeDeleted   = "volume_deleted"
+	EventVolumeReadOnly  = "volume_read_only"
+	EventVolumeWritable  = "volume_writable"
+	EventEcShardsAdded   = "ec_shards_added"
+	EventEcShardsRemoved = "ec_shards_removed"
+	EventLeaderChanged   = "leader_changed"
+	EventVacuumStarted   = "vacuum_started"
+	EventVacuumFinished  = "vacuum_finished"
+)
+
+// the events to a slow watcher are dropped when its buffer is full
+const topologyWatcherBufferSize = 1024
+
+type topologyWatchers struct {
+	sync.RWMutex
+	watchers map[string]chan *master_pb.TopologyEvent
+}
+
+// WatchEvents subscribes to the topology events. Call cancel to unsubscribe.
+func (t *Topology) WatchEvents(clientName string) (events <-chan *master_pb.TopologyEvent, cancel func()) {
+	ch := make(chan *master_pb.TopologyEvent, topologyWatcherBufferSize)
+	t.watchers.Lock()
+	t.watchers.watchers[clientName] = ch
+	t.watchers.Unlock()
+	return ch, func() {
+		t.watchers.Lock()
+		delete(t.watchers.watchers, clientName)
+		t.watchers.Unlock()
+	}
+}
+
+// PublishEvent sends the event to all the watchers, without waiting for slow watchers
+func (t *Topology) PublishEvent(event *master_pb.TopologyEvent) {
+	if event.TsNs == 0 {
+		event.TsNs = time.Now().UnixNano()
+	}
+	glog.V(2).Infof("topology event: %v", event)
+	t.watchers.RLock()
+	defer t.watchers.RUnlock()
+	for clientName, ch := range t.watchers.watchers {
+		select {
+		case ch <- event:
+		default:
+			glog.V(0).Infof("drop topology event to slow watcher %s: %v", clientName, event)
+		}
+	}
+}
+
+func newDataNodeEvent(eventType string, dn *DataNode) *master_pb.TopologyEvent {
+	event := &master_pb.TopologyEvent{
+		Type:     eventType,
+		DataNode: dn.Url(),
+	}
+	// the data node is unlinked from the rack after it leaves
+	if rack := dn.Parent(); rack != nil {
+		event.Rack = string(rack.Id())
+		if dc := rack.Parent(); dc != nil {
+			event.DataCenter = string(dc.Id())
+		}
+	}
+	return event
+}
+
+func newVolumeEvent(eventType string, dn *DataNode, vid needle.VolumeId, collection string) *master_pb.TopologyEvent {
+	event := newDataNodeEvent(eventType, dn)
+	event.VolumeId = uint32(vid)
+	event.Collection = collection
+	return event
+}
+
+func newEcShardsEvent(eventType string, dn *DataNode, ecVolumeInfo *erasure_coding.EcVolumeInfo) *master_pb.TopologyEvent {
+	event := newVolumeEvent(eventType, dn, ecVolumeInfo.VolumeId, ecVolumeInfo.Collection)
+	for _, shardId := range ecVolumeInfo.ShardIds() {
+		event.EcShardIds = append(event.EcShardIds, uint32(shardId))
+	}
+	return event
+}
+
+// PublishDataNodeEvent sends node_joined or node_left events
+func (t *Topology) PublishDataNodeEvent(eventType string, dn *DataNode) {
+	t.PublishEvent(newDataNodeEvent(eventType, dn))
+}
+
+// PublishLeaderEvent sends leader_changed events
+func (t *Topology) PublishLeaderEvent(leader string) {
+	t.PublishEvent(&master_pb.TopologyEvent{
+		Type:   EventLeaderChanged,
+		Leader: leader,
+	})
+}
+
+func (t *Topology) publishVacuumEvent(eventType string, vid needle.VolumeId, collection string, message string) {
+	t.PublishEvent(&master_pb.TopologyEvent{
+		Type:       eventType,
+		VolumeId:   uint32(vid),
+		Collection: collection,
+		Message:    message,
+	})
+}
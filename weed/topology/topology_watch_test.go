@@ -0,0 +1,70 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/sequence"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestTopologyEvents(t *testing.T) {
+	topo := NewTopology("weedfs", sequence.NewMemorySequencer(), 32*1024, 5)
+	dc := topo.GetOrCreateDataCenter("dc1")
+	rack := dc.GetOrCreateRack("rack1")
+	dn := rack.GetOrCreateDataNode("127.0.0.1", 34534, "127.0.0.1", 25)
+
+	events, cancel := topo.WatchEvents("test")
+	defer cancel()
+
+	volumeMessage := func(vid uint32, readOnly bool) *master_pb.VolumeInformationMessage {
+		return &master_pb.VolumeInformationMessage{
+			Id:         vid,
+			Collection: "c1",
+			ReadOnly:   readOnly,
+			Version:    uint32(needle.CurrentVersion),
+		}
+	}
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volumeMessage(1, false), volumeMessage(2, false)}, dn)
+	topo.SyncDataNodeRegistration([]*master_pb.VolumeInformationMessage{volumeMessage(1, true)}, dn)
+
+	expected := []struct {
+		eventType string
+		vid       uint32
+	}{
+		{EventVolumeCreated, 1},
+		{EventVolumeCreated, 2},
+		{EventVolumeReadOnly, 1},
+		{EventVolumeDeleted, 2},
+	}
+	var received []*master_pb.TopologyEvent
+	for len(received) < len(expected) {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		default:
+			t.Fatalf("received %d events, expected %d", len(received), len(expected))
+		}
+	}
+	// the volumes in one heartbeat can be in any order
+	if received[0].VolumeId > received[1].VolumeId {
+		received[0], received[1] = received[1], received[0]
+	}
+	for i, e := range expected {
+		event := received[i]
+		if event.Type != e.eventType || event.VolumeId != e.vid {
+			t.Errorf("event %d: %s volume %d, expected %s volume %d", i, event.Type, event.VolumeId, e.eventType, e.vid)
+		}
+		if event.DataCenter != "dc1" || event.Rack != "rack1" || event.DataNode != "127.0.0.1:34534" || event.Collection != "c1" || event.TsNs == 0 {
+			t.Errorf("event %d: unexpected %v", i, event)
+		}
+	}
+
+	cancel()
+	topo.PublishLeaderEvent("localhost:9333")
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event after cancel: %v", event)
+	default:
+	}
+}
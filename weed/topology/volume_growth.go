@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 )
 
@@ -28,20 +29,26 @@ type VolumeGrowOption struct {
 	DataCenter       string
 	Rack             string
 	DataNode         string
+	Placement        PlacementConstraint
 }
 
 type VolumeGrowth struct {
 	accessLock sync.Mutex
+	strategy   PlacementStrategy // nil to pick randomly by the free slots
 }
 
 func (o *VolumeGrowOption) String() string {
-	return fmt.Sprintf("Collection:%s, ReplicaPlacement:%v, Ttl:%v, DataCenter:%s, Rack:%s, DataNode:%s", o.Collection, o.ReplicaPlacement, o.Ttl, o.DataCenter, o.Rack, o.DataNode)
+	return fmt.Sprintf("Collection:%s, ReplicaPlacement:%v, Ttl:%v, DataCenter:%s, Rack:%s, DataNode:%s, Placement:%s", o.Collection, o.ReplicaPlacement, o.Ttl, o.DataCenter, o.Rack, o.DataNode, o.Placement)
 }
 
 func NewDefaultVolumeGrowth() *VolumeGrowth {
 	return &VolumeGrowth{}
 }
 
+func NewVolumeGrowth(strategy PlacementStrategy) *VolumeGrowth {
+	return &VolumeGrowth{strategy: strategy}
+}
+
 // one replication type may need rp.GetCopyCount() actual volumes
 // given copyCount, how many logical volumes to create
 func (vg *VolumeGrowth) findVolumeCount(copyCount int) (count int) {
@@ -70,9 +77,16 @@ func (vg *VolumeGrowth) GrowByCountAndType(grpcDialOption grpc.DialOption, targe
 	defer vg.accessLock.Unlock()
 
 	for i := 0; i < targetCount; i++ {
+		if e := topo.CheckCollectionQuota(option.Collection); e != nil {
+			return counter, e
+		}
+		if e := topo.checkVolumeCountQuota(option.Collection); e != nil {
+			return counter, e
+		}
 		if c, e := vg.findAndGrow(grpcDialOption, topo, option); e == nil {
 			counter += c
 		} else {
+			stats.MasterVolumeGrowFailureCounter.WithLabelValues(option.Collection).Inc()
 			return counter, e
 		}
 	}
@@ -98,6 +112,10 @@ func (vg *VolumeGrowth) findAndGrow(grpcDialOption grpc.DialOption, topo *Topolo
 // 2.2 collect all data centers that have DiffRackCount+rp.SameRackCount+1
 // 2. find rest data nodes
 func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *VolumeGrowOption) (servers []*DataNode, err error) {
+	if !option.Placement.IsEmpty() || vg.strategy != nil {
+		return vg.findEmptySlotsWithPlacement(topo, option)
+	}
+
 	//find main datacenter and other data centers
 	rp := option.ReplicaPlacement
 	mainDataCenter, otherDataCenters, dc_err := topo.RandomlyPickNodes(rp.DiffDataCenterCount+1, func(node Node) error {
@@ -208,9 +226,11 @@ func (vg *VolumeGrowth) grow(grpcDialOption grpc.DialOption, topo *Topology, vid
 			}
 			server.AddOrUpdateVolume(vi)
 			topo.RegisterVolumeLayout(vi, server)
+			topo.PublishEvent(newVolumeEvent(EventVolumeCreated, server, vid, option.Collection))
 			glog.V(0).Infoln("Created Volume", vid, "on", server.NodeImpl.String())
 		} else {
 			glog.V(0).Infoln("Failed to assign volume", vid, "to", servers, "error", err)
+			server.RecordFailure()
 			return fmt.Errorf("Failed to assign %d: %v", vid, err)
 		}
 	}
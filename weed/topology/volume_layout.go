@@ -170,7 +170,7 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 		glog.V(0).Infoln("No more writable volumes!")
 		return nil, 0, nil, errors.New("No more writable volumes!")
 	}
-	if option.DataCenter == "" {
+	if option.DataCenter == "" && len(option.Placement.RequiredLabels) == 0 {
 		vid := vl.writables[rand.Intn(lenWriters)]
 		locationList := vl.vid2location[vid]
 		if locationList != nil {
@@ -183,6 +183,16 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 	counter := 0
 	for _, v := range vl.writables {
 		volumeLocationList := vl.vid2location[v]
+		if !option.Placement.matchesAllLocations(volumeLocationList) {
+			continue
+		}
+		if option.DataCenter == "" {
+			counter++
+			if rand.Intn(counter) < 1 {
+				vid, locationList = v, volumeLocationList
+			}
+			continue
+		}
 		for _, dn := range volumeLocationList.list {
 			if dn.GetDataCenter().Id() == NodeId(option.DataCenter) {
 				if option.Rack != "" && dn.GetRack().Id() != NodeId(option.Rack) {
@@ -198,6 +208,9 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 			}
 		}
 	}
+	if locationList == nil {
+		return nil, 0, nil, errors.New("No writable volumes matching " + option.String())
+	}
 	return &vid, count, locationList, nil
 }
 
@@ -205,11 +218,18 @@ func (vl *VolumeLayout) GetActiveVolumeCount(option *VolumeGrowOption) int {
 	vl.accessLock.RLock()
 	defer vl.accessLock.RUnlock()
 
-	if option.DataCenter == "" {
+	if option.DataCenter == "" && len(option.Placement.RequiredLabels) == 0 {
 		return len(vl.writables)
 	}
 	counter := 0
 	for _, v := range vl.writables {
+		if !option.Placement.matchesAllLocations(vl.vid2location[v]) {
+			continue
+		}
+		if option.DataCenter == "" {
+			counter++
+			continue
+		}
 		for _, dn := range vl.vid2location[v].list {
 			if dn.GetDataCenter().Id() == NodeId(option.DataCenter) {
 				if option.Rack != "" && dn.GetRack().Id() != NodeId(option.Rack) {
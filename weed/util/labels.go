@@ -0,0 +1,44 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseLabels parses labels as "key1=value1,key2=value2"
+func ParseLabels(text string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(text, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		sepIndex := strings.Index(pair, "=")
+		if sepIndex <= 0 {
+			return nil, fmt.Errorf("label %q should be as key=value", pair)
+		}
+		labels[strings.TrimSpace(pair[:sepIndex])] = strings.TrimSpace(pair[sepIndex+1:])
+	}
+	return labels, nil
+}
+
+// FormatLabels formats labels as "key1=value1,key2=value2", sorted by the keys
+func FormatLabels(labels map[string]string) string {
+	var pairs []string
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// ParseLabelKeys parses label keys as "key1,key2"
+func ParseLabelKeys(text string) (keys []string) {
+	for _, key := range strings.Split(text, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return
+}